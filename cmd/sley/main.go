@@ -7,12 +7,20 @@ import (
 	"os"
 
 	"github.com/indaco/sley/internal/cli"
+	"github.com/indaco/sley/internal/clix"
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/extensionmgr"
 	"github.com/indaco/sley/internal/hooks"
 	"github.com/indaco/sley/internal/plugins"
 )
 
 func main() {
+	// Extensions with declared permissions are started through sley itself,
+	// which sets up the sandbox before handing over to the extension script.
+	if extensionmgr.IsSandboxHelper() {
+		extensionmgr.RunSandboxHelper()
+	}
+
 	if err := runCLI(os.Args); err != nil {
 		log.Fatal(err)
 	}
@@ -37,10 +45,17 @@ func runCLI(args []string) error {
 	registry := plugins.NewPluginRegistry()
 	plugins.RegisterBuiltinPlugins(cfg, registry)
 
+	// Derive versions from git tags instead of the version file when configured,
+	// scoped to the release line of a maintenance branch
+	defer clix.ApplyVersionSource(cfg, registry.ReleaseLine())()
+
 	if err := hooks.LoadPreReleaseHooksFromConfig(cfg); err != nil {
 		return fmt.Errorf("failed to load pre-release hooks: %w", err)
 	}
 
+	// Long-running rpc extensions are started on first use and live until sley exits.
+	defer extensionmgr.CloseRPCSessions()
+
 	app := cli.New(cfg, registry)
 	return app.Run(context.Background(), args)
 }
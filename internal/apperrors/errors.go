@@ -73,6 +73,16 @@ func (e *InvalidBumpTypeError) Error() string {
 	return fmt.Sprintf("invalid bump type: %s (expected: patch, minor, or major)", e.BumpType)
 }
 
+// VersionOverflowError indicates that a bump would overflow a version component.
+type VersionOverflowError struct {
+	Component string
+	Version   string
+}
+
+func (e *VersionOverflowError) Error() string {
+	return fmt.Sprintf("cannot bump %s of %s: value would overflow", e.Component, e.Version)
+}
+
 // ConfigError indicates a configuration-related error.
 type ConfigError struct {
 	Operation string
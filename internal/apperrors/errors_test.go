@@ -50,6 +50,16 @@ func TestInvalidBumpTypeError(t *testing.T) {
 	}
 }
 
+func TestVersionOverflowError(t *testing.T) {
+	t.Parallel()
+	err := &VersionOverflowError{Component: "patch", Version: "1.2.18446744073709551615"}
+	expected := "cannot bump patch of 1.2.18446744073709551615: value would overflow"
+
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
 func TestConfigError(t *testing.T) {
 	t.Parallel()
 	inner := errors.New("file not found")
@@ -11,11 +11,14 @@ import (
 	heraldurfave "github.com/indaco/herald-help/urfave"
 	"github.com/indaco/sley/internal/commands/bump"
 	"github.com/indaco/sley/internal/commands/changelog"
+	"github.com/indaco/sley/internal/commands/depsync"
 	"github.com/indaco/sley/internal/commands/discover"
 	"github.com/indaco/sley/internal/commands/doctor"
 	"github.com/indaco/sley/internal/commands/extension"
+	"github.com/indaco/sley/internal/commands/history"
 	"github.com/indaco/sley/internal/commands/initialize"
 	"github.com/indaco/sley/internal/commands/pre"
+	"github.com/indaco/sley/internal/commands/semvercmd"
 	"github.com/indaco/sley/internal/commands/set"
 	"github.com/indaco/sley/internal/commands/show"
 	"github.com/indaco/sley/internal/commands/tag"
@@ -102,8 +105,12 @@ func New(cfg *config.Config, registry *plugins.PluginRegistry) *urfavecli.Comman
 			bump.Run(cfg, registry),
 			pre.Run(cfg, registry),
 			doctor.Run(cfg),
+			depsync.RunCheck(cfg, registry),
+			depsync.RunSync(cfg, registry),
 			tag.Run(cfg),
 			changelog.Run(cfg),
+			history.Run(cfg),
+			semvercmd.Run(cfg),
 			extension.Run(),
 		},
 	}
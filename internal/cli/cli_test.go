@@ -27,7 +27,7 @@ func TestNew_BasicStructure(t *testing.T) {
 	registry := plugins.NewPluginRegistry()
 	app := New(cfg, registry)
 
-	wantCommands := []string{"show", "set", "bump", "pre", "doctor", "init"}
+	wantCommands := []string{"show", "set", "bump", "pre", "doctor", "check", "sync", "init"}
 	for _, name := range wantCommands {
 		found := false
 		for _, cmd := range app.Commands {
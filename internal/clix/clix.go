@@ -10,6 +10,7 @@ import (
 	"github.com/indaco/sley/internal/apperrors"
 	"github.com/indaco/sley/internal/config"
 	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/printer"
 	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/tui"
@@ -28,12 +29,40 @@ func FromCommand(cmd *cli.Command) (bool, error) {
 // It returns true if the file was created, false if it already existed.
 // Returns a typed error (*apperrors.VersionFileNotFoundError) instead of cli.Exit.
 func GetOrInitVersionFile(path string, strict bool) (bool, error) {
-	return getOrInitVersionFileWith(path, strict, semver.DefaultVersionManager())
+	return getOrInitVersionFileWith(path, strict, semver.CurrentManager())
+}
+
+// ApplyVersionSource switches the package-level version manager to git tags
+// when the configuration sets source: git-tags, using the tag-manager prefix.
+// A non-nil line restricts the current version to tags of that maintenance
+// release line. It returns a function that restores the previous manager.
+func ApplyVersionSource(cfg *config.Config, line *semver.ReleaseLine) func() {
+	if cfg == nil || !cfg.UsesGitTags() {
+		return func() {}
+	}
+
+	prefix := "v"
+	if cfg.Plugins != nil && cfg.Plugins.TagManager != nil {
+		prefix = cfg.Plugins.TagManager.GetPrefix()
+	}
+
+	src := semver.DefaultTagSource(prefix)
+	if cfg.Describe != nil && cfg.Describe.Enabled {
+		src.Describe = true
+		src.DescribeLabel = cfg.Describe.Label
+		src.DescribeBump = cfg.Describe.Bump
+	}
+	src.Line = line
+	return semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(src))
 }
 
 // getOrInitVersionFileWith is the internal implementation that accepts a
 // VersionManager, avoiding reliance on package-level mutable state.
 func getOrInitVersionFileWith(path string, strict bool, mgr *semver.VersionManager) (bool, error) {
+	if mgr.UsesGitTags() {
+		return false, nil // Versions come from git tags, there is no file to check
+	}
+
 	if strict {
 		if _, err := os.Stat(path); err != nil {
 			return false, &apperrors.VersionFileNotFoundError{Path: path}
@@ -51,6 +80,21 @@ func getOrInitVersionFileWith(path string, strict bool, mgr *semver.VersionManag
 	return created, nil
 }
 
+// ResolveMetadata returns the build metadata for a version-changing command.
+// An explicit --meta value wins; otherwise, unless existing metadata is being
+// preserved, the configured metadata template is resolved from the git and
+// CI context. Returns "" when neither applies.
+func ResolveMetadata(cfg *config.Config, meta string, preserve bool) string {
+	if meta != "" || preserve {
+		return meta
+	}
+	template := cfg.GetMetadataTemplate()
+	if template == "" {
+		return ""
+	}
+	return tagmanager.ResolveMetadata(template)
+}
+
 // ExecutionMode indicates whether to operate on a single module or multiple modules.
 type ExecutionMode int
 
@@ -88,6 +88,67 @@ func TestGetOrInitVersionFile_InitError(t *testing.T) {
 	}
 }
 
+func TestGetOrInitVersionFile_GitTags(t *testing.T) {
+	t.Parallel()
+
+	mockFS := core.NewMockFileSystem()
+	src := semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.0.0"}})
+	mgr := semver.NewVersionManager(mockFS, nil).WithTagSource(src)
+
+	for _, strict := range []bool{true, false} {
+		created, err := getOrInitVersionFileWith("/test/.version", strict, mgr)
+		if err != nil {
+			t.Fatalf("strict=%v: unexpected error: %v", strict, err)
+		}
+		if created {
+			t.Errorf("strict=%v: expected created=false", strict)
+		}
+	}
+	if _, err := mockFS.Stat(t.Context(), "/test/.version"); err == nil {
+		t.Error("expected no version file to be created")
+	}
+}
+
+func TestApplyVersionSource(t *testing.T) {
+	restore := ApplyVersionSource(&config.Config{}, nil)
+	if semver.UsesGitTags() {
+		t.Error("expected file source by default")
+	}
+	restore()
+
+	restore = ApplyVersionSource(&config.Config{
+		Source:   config.SourceGitTags,
+		Describe: &config.DescribeConfig{Enabled: true, Label: "dev", Bump: "minor"},
+	}, &semver.ReleaseLine{Major: 1})
+	if !semver.UsesGitTags() {
+		t.Error("expected git-tags source")
+	}
+	restore()
+
+	if semver.UsesGitTags() {
+		t.Error("expected restore to bring back the file source")
+	}
+}
+
+func TestResolveMetadata(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Metadata: &config.MetadataConfig{Template: "ci.{ci_run}"}}
+
+	if got := ResolveMetadata(cfg, "explicit", false); got != "explicit" {
+		t.Errorf("explicit meta = %q, want explicit", got)
+	}
+	if got := ResolveMetadata(cfg, "", true); got != "" {
+		t.Errorf("preserve = %q, want empty", got)
+	}
+	if got := ResolveMetadata(&config.Config{}, "", false); got != "" {
+		t.Errorf("no template = %q, want empty", got)
+	}
+	if got := ResolveMetadata(cfg, "", false); !strings.HasPrefix(got, "ci") {
+		t.Errorf("template = %q, want ci prefix", got)
+	}
+}
+
 func TestFromCommand(t *testing.T) {
 	t.Parallel()
 	t.Run("path exists, strict false", func(t *testing.T) {
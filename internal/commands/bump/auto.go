@@ -13,6 +13,7 @@ import (
 	"github.com/indaco/sley/internal/plugins"
 	"github.com/indaco/sley/internal/plugins/changelogparser"
 	"github.com/indaco/sley/internal/plugins/commitparser/gitlog"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/printer"
 	"github.com/indaco/sley/internal/semver"
 	"github.com/urfave/cli/v3"
@@ -24,6 +25,7 @@ type bumpDeps struct {
 	inferFromChangelog func(registry *plugins.PluginRegistry) string
 	newBumper          func() semver.VersionBumper
 	getCommits         gitlog.GetCommitsFn
+	buildInfo          func() tagmanager.BuildInfo
 }
 
 // bumpDepsKey is used to inject bumpDeps via context (for testing).
@@ -35,6 +37,7 @@ func newBumpDeps() *bumpDeps {
 		inferFromChangelog: tryInferBumpTypeFromChangelogParserPlugin,
 		newBumper:          func() semver.VersionBumper { return semver.NewDefaultBumper() },
 		getCommits:         gitlog.DefaultGetCommitsFn(),
+		buildInfo:          tagmanager.ReadBuildInfo,
 	}
 }
 
@@ -99,10 +102,10 @@ You can override this behavior with the --label flag, disable it explicitly with
 // runBumpAuto performs smart bumping (e.g. promote, patch, infer).
 func runBumpAuto(ctx context.Context, cfg *config.Config, registry *plugins.PluginRegistry, cmd *cli.Command) error {
 	label := cmd.String("label")
-	meta := cmd.String("meta")
 	since := cmd.String("since")
 	until := cmd.String("until")
 	isPreserveMeta := cmd.Bool("preserve-meta")
+	meta := clix.ResolveMetadata(cfg, cmd.String("meta"), isPreserveMeta)
 	isNoInferFlag := cmd.Bool("no-infer")
 	isSkipHooks := cmd.Bool("skip-hooks")
 
@@ -241,11 +244,21 @@ func runSingleModuleAuto(ctx context.Context, cmd *cli.Command, cfg *config.Conf
 		return err
 	}
 
+	// Record the audit log entry
+	if err := recordAuditLogEntry(registry, next, current, "auto", newAuditDetails(registry, next, "", path, "", cfg)); err != nil {
+		return err
+	}
+
 	// Create tag after successful bump
 	if err := createTagAfterBump(registry, next, "auto", cfg); err != nil {
 		return err
 	}
 
+	// Attach the audit log entry to the release commit
+	if err := flushAuditLog(registry); err != nil {
+		return err
+	}
+
 	printer.PrintFaint(fmt.Sprintf("Bumped version from %s to %s", current.String(), printer.Info(next.String())))
 	return nil
 }
@@ -348,6 +361,7 @@ func tryInferBumpTypeFromCommitParserPlugin(registry *plugins.PluginRegistry, si
 	} else {
 		gl = gitlog.NewGitLog()
 	}
+	gl.Line = registry.ReleaseLine()
 	commits, err := gl.GetCommits(since, until)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to read commits: %v\n", err)
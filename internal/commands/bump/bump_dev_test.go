@@ -0,0 +1,130 @@
+package bump
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+func TestDevVersion(t *testing.T) {
+	info := tagmanager.BuildInfo{CommitCount: 42, CommitTime: time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name     string
+		current  string
+		bumpType string
+		label    string
+		want     string
+	}{
+		{"minor from stable", "1.4.2", "minor", "dev", "1.5.0-dev.20261016.42"},
+		{"patch from stable", "1.4.2", "patch", "dev", "1.4.3-dev.20261016.42"},
+		{"major from stable", "1.4.2", "major", "nightly", "2.0.0-nightly.20261016.42"},
+		{"pre-release keeps core", "1.5.0-rc.1", "minor", "dev", "1.5.0-dev.20261016.42"},
+		{"drops build metadata", "1.4.2+old", "minor", "dev", "1.5.0-dev.20261016.42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, err := semver.ParseVersion(tt.current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := devVersion(current, tt.label, tt.bumpType, info)
+			if err != nil {
+				t.Fatalf("devVersion() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("devVersion() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDevVersion_Errors(t *testing.T) {
+	current := semver.SemVersion{Major: 1}
+
+	if _, err := devVersion(current, "dev", "minor", tagmanager.BuildInfo{}); err == nil {
+		t.Error("expected error without commits")
+	}
+	if _, err := devVersion(current, "dev", "build", tagmanager.BuildInfo{CommitCount: 1}); err == nil {
+		t.Error("expected error for invalid bump type")
+	}
+}
+
+func TestCLI_BumpDevCmd(t *testing.T) {
+	deps := defaultTestDeps()
+	deps.buildInfo = func() tagmanager.BuildInfo {
+		return tagmanager.BuildInfo{CommitCount: 7, CommitTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+	}
+	ctx := testContext(deps)
+
+	t.Run("prints without writing", func(t *testing.T) {
+		tmp := t.TempDir()
+		versionPath := testutils.WriteTempVersionFile(t, tmp, "1.4.2")
+		cfg := &config.Config{Path: versionPath}
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg, plugins.NewPluginRegistry())})
+
+		output, _ := testutils.CaptureStdout(func() {
+			if err := appCli.Run(ctx, []string{"sley", "bump", "--meta", "ci.9", "dev", "--path", versionPath}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+
+		if output != "1.5.0-dev.20260102.7+ci.9" {
+			t.Errorf("output = %q, want 1.5.0-dev.20260102.7+ci.9", output)
+		}
+		if got := testutils.ReadTempVersionFile(t, tmp); got != "1.4.2" {
+			t.Errorf("version file changed to %q", got)
+		}
+	})
+
+	t.Run("config defaults and write", func(t *testing.T) {
+		tmp := t.TempDir()
+		versionPath := testutils.WriteTempVersionFile(t, tmp, "1.4.2")
+		cfg := &config.Config{Path: versionPath, Dev: &config.DevConfig{Label: "snapshot", Bump: "patch"}}
+		appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg, plugins.NewPluginRegistry())})
+
+		_, _ = testutils.CaptureStdout(func() {
+			if err := appCli.Run(ctx, []string{"sley", "bump", "dev", "--write", "--path", versionPath}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+
+		if got := testutils.ReadTempVersionFile(t, tmp); got != "1.4.3-snapshot.20260102.7" {
+			t.Errorf("version file = %q, want 1.4.3-snapshot.20260102.7", got)
+		}
+	})
+}
+
+func TestCLI_BumpDevCmd_WriteGitTagSource(t *testing.T) {
+	restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(
+		semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.4.2"}})))
+	defer restore()
+
+	deps := defaultTestDeps()
+	deps.buildInfo = func() tagmanager.BuildInfo {
+		return tagmanager.BuildInfo{CommitCount: 7, CommitTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+	}
+	ctx := testContext(deps)
+
+	tmp := t.TempDir()
+	versionPath := filepath.Join(tmp, ".version")
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg, plugins.NewPluginRegistry())})
+
+	var err error
+	_, _ = testutils.CaptureStdout(func() {
+		err = appCli.Run(ctx, []string{"sley", "bump", "dev", "--write", "--path", versionPath})
+	})
+	if err == nil || !strings.Contains(err.Error(), "cannot record version 1.5.0-dev.20260102.7: source is git-tags") {
+		t.Fatalf("expected git-tags error, got %v", err)
+	}
+}
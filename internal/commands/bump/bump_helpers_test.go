@@ -29,6 +29,7 @@ func (m *mockTagManager) GetLatestTag() (semver.SemVersion, error)            {
 func (m *mockTagManager) IsAutoCreateEnabled() bool                           { return m.autoCreateEnabled }
 func (m *mockTagManager) GetConfig() *tagmanager.Config                       { return tagmanager.DefaultConfig() }
 func (m *mockTagManager) CommitChanges(_ semver.SemVersion, _ []string) error { return nil }
+func (m *mockTagManager) UpdateAliases(_ semver.SemVersion) ([]string, error) { return nil, nil }
 
 // mockVersionValidator implements versionvalidator.VersionValidator for testing
 type mockVersionValidator struct {
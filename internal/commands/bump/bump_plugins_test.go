@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/extensionmgr"
 	"github.com/indaco/sley/internal/operations"
 	"github.com/indaco/sley/internal/plugins"
+	"github.com/indaco/sley/internal/plugins/auditlog"
 	"github.com/indaco/sley/internal/plugins/changeloggenerator"
 	"github.com/indaco/sley/internal/plugins/dependencycheck"
 	"github.com/indaco/sley/internal/plugins/tagmanager"
@@ -48,6 +50,23 @@ func TestValidateTagAvailable(t *testing.T) {
 			t.Error("expected error, got nil")
 		}
 	})
+
+	t.Run("git-tags source without auto-create returns error", func(t *testing.T) {
+		restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(semver.DefaultTagSource("v")))
+		defer restore()
+
+		registry := plugins.NewPluginRegistry()
+		if err := validateTagAvailable(registry, version); err == nil {
+			t.Error("expected error without tag manager, got nil")
+		}
+
+		if err := registry.RegisterTagManager(&mockTagManager{autoCreateEnabled: false}); err != nil {
+			t.Fatalf("failed to register tag manager: %v", err)
+		}
+		if err := validateTagAvailable(registry, version); err == nil {
+			t.Error("expected error with auto-create disabled, got nil")
+		}
+	})
 }
 
 /* ------------------------------------------------------------------------- */
@@ -211,7 +230,7 @@ func TestRecordAuditLogEntry(t *testing.T) {
 	t.Run("nil audit log returns nil", func(t *testing.T) {
 
 		registry := plugins.NewPluginRegistry()
-		err := recordAuditLogEntry(registry, version, prevVersion, "major")
+		err := recordAuditLogEntry(registry, version, prevVersion, "major", auditDetails{})
 		if err != nil {
 			t.Errorf("expected nil error, got %v", err)
 		}
@@ -219,6 +238,42 @@ func TestRecordAuditLogEntry(t *testing.T) {
 
 	// Note: recordAuditLogEntry uses type assertion to *AuditLogPlugin
 	// so mock implementations will be treated as disabled and return nil
+
+	t.Run("records module, tag and executed extensions", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), ".version-history.json")
+		registry := plugins.NewPluginRegistry()
+		if err := registry.RegisterAuditLog(auditlog.NewAuditLog(&auditlog.Config{Enabled: true, Path: path})); err != nil {
+			t.Fatal(err)
+		}
+
+		details := auditDetails{
+			module: "api",
+			tag:    "api/v2.0.0",
+			executions: []extensionmgr.HookExecution{
+				{Hook: "pre-bump", Extension: "lint"},
+				{Hook: "post-bump", Extension: "lint"},
+				{Hook: "post-bump", Extension: "notify"},
+			},
+		}
+		if err := recordAuditLogEntry(registry, version, prevVersion, "major", details); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		logFile, err := auditlog.ReadLogFile(path, "json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(logFile.Entries) != 1 {
+			t.Fatalf("expected one entry, got %d", len(logFile.Entries))
+		}
+		entry := logFile.Entries[0]
+		if entry.Module != "api" || entry.Tag != "api/v2.0.0" {
+			t.Errorf("unexpected module/tag: %q %q", entry.Module, entry.Tag)
+		}
+		if strings.Join(entry.Hooks, ",") != "pre-bump,post-bump" || strings.Join(entry.Extensions, ",") != "lint,notify" {
+			t.Errorf("unexpected hooks/extensions: %v %v", entry.Hooks, entry.Extensions)
+		}
+	})
 }
 
 /* ------------------------------------------------------------------------- */
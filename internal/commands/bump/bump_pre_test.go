@@ -62,6 +62,33 @@ func TestCLI_BumpPreCmd(t *testing.T) {
 	}
 }
 
+func TestCLI_BumpPreCmd_NextChannel(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	cfg := &config.Config{Path: versionPath, PreReleaseChannels: []string{"alpha", "beta", "rc"}}
+	registry := plugins.NewPluginRegistry()
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg, registry)})
+
+	testutils.WriteTempVersionFile(t, tmpDir, "1.4.0-alpha.3")
+	testutils.RunCLITest(t, appCli, []string{"sley", "bump", "pre", "--next-channel"}, tmpDir)
+	if got := testutils.ReadTempVersionFile(t, tmpDir); got != "1.4.0-beta.1" {
+		t.Errorf("expected %q, got %q", "1.4.0-beta.1", got)
+	}
+
+	testutils.WriteTempVersionFile(t, tmpDir, "1.4.0-rc.2")
+	err := appCli.Run(context.Background(), []string{"sley", "bump", "pre", "--next-channel"})
+	if err == nil || !strings.Contains(err.Error(), "last channel") {
+		t.Fatalf("expected last channel error, got: %v", err)
+	}
+
+	err = appCli.Run(context.Background(), []string{"sley", "bump", "pre", "--next-channel", "--label", "rc"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be used together") {
+		t.Fatalf("expected flag conflict error, got: %v", err)
+	}
+}
+
 func TestCLI_BumpPreCmd_ErrorNoPreRelease(t *testing.T) {
 
 	tmpDir := t.TempDir()
@@ -37,6 +37,7 @@ func Run(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
 			preCmd(cfg, registry),
 			releaseCmd(cfg, registry),
 			autoCmd(cfg, registry),
+			devCmd(cfg, registry),
 		},
 	}
 }
@@ -22,6 +22,7 @@ type bumpParams struct {
 	bumpType     string
 	opBumpType   operations.BumpType
 	newBumper    func() semver.VersionBumper // nil uses default
+	channels     []string                    // set for "bump pre --next-channel"
 }
 
 // executeSingleModuleBump is the unified execution pipeline for single-module bump operations.
@@ -50,7 +51,7 @@ func executeSingleModuleBump(
 	op := operations.NewBumpOperation(
 		fs, bumper, params.opBumpType,
 		params.pre, params.meta, params.preserveMeta,
-	)
+	).WithNextChannel(params.channels)
 
 	// Preview: calculate new version without writing
 	result, err := op.Preview(ctx, execCtx.Path)
@@ -91,8 +92,19 @@ func executeSingleModuleBump(
 		return err
 	}
 
+	// Record the audit log entry once every hook has run, before the release commit
+	details := newAuditDetails(registry, result.NewVersion, "", execCtx.Path, execCtx.Path, cfg)
+	if err := recordAuditLogEntry(registry, result.NewVersion, result.PreviousVersion, params.bumpType, details); err != nil {
+		return err
+	}
+
 	// Commit (if auto-commit enabled) and create tag after successful bump
-	return commitAndTagAfterBump(registry, result.NewVersion, params.bumpType, execCtx.Path, cfg)
+	if err := commitAndTagAfterBump(registry, result.NewVersion, params.bumpType, execCtx.Path, cfg); err != nil {
+		return err
+	}
+
+	// Attach the audit log entry to the release commit
+	return flushAuditLog(registry)
 }
 
 // executePreBumpValidations runs all validation checks before performing a bump.
@@ -117,8 +129,9 @@ func executePreBumpValidations(registry *plugins.PluginRegistry, newVersion, pre
 	return validateTagAvailable(registry, newVersion)
 }
 
-// executePostBumpActions runs all post-bump operations like syncing dependencies,
-// generating changelog, and recording audit logs.
+// executePostBumpActions runs all post-bump operations like syncing dependencies
+// and generating changelog. The audit log entry is recorded by the callers once
+// the post-bump extension hooks have run.
 // bumpedPath is the .version file path (used to exclude from dep-sync output).
 // moduleName identifies the module in changelog headings (empty for single-module).
 // modulePath scopes versioned output dirs and git log (empty for root or single-module).
@@ -129,20 +142,21 @@ func executePostBumpActions(registry *plugins.PluginRegistry, newVersion, previo
 		return err
 	}
 
-	// Generate changelog entry
-	if err := generateChangelogAfterBump(registry, newVersion, previousVersion, bumpType, moduleName, modulePath, independentVersioning); err != nil {
+	// Update dependency references that follow this module
+	if err := operations.SyncDependencyReferences(registry, moduleName, newVersion); err != nil {
 		return err
 	}
 
-	// Record audit log entry
-	return recordAuditLogEntry(registry, newVersion, previousVersion, bumpType)
+	// Generate changelog entry
+	return generateChangelogAfterBump(registry, newVersion, previousVersion, bumpType, moduleName, modulePath, independentVersioning)
 }
 
 // extractBumpParams extracts common bump parameters from CLI command.
-func extractBumpParams(cmd *cli.Command, bumpType string, opBumpType operations.BumpType) bumpParams {
+// Without --meta, the configured metadata template provides the build metadata.
+func extractBumpParams(cmd *cli.Command, cfg *config.Config, bumpType string, opBumpType operations.BumpType) bumpParams {
 	return bumpParams{
 		pre:          cmd.String("pre"),
-		meta:         cmd.String("meta"),
+		meta:         clix.ResolveMetadata(cfg, cmd.String("meta"), cmd.Bool("preserve-meta")),
 		preserveMeta: cmd.Bool("preserve-meta"),
 		skipHooks:    cmd.Bool("skip-hooks"),
 		bumpType:     bumpType,
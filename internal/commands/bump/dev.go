@@ -0,0 +1,144 @@
+package bump
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/indaco/sley/internal/clix"
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// devCmd returns the "dev" subcommand.
+func devCmd(cfg *config.Config, _ *plugins.PluginRegistry) *cli.Command {
+	return &cli.Command{
+		Name:      "dev",
+		Usage:     "Print a snapshot pre-release for CI builds (e.g. 1.5.0-dev.20261016.42)",
+		UsageText: "sley bump dev [--label name] [--bump patch|minor|major] [--meta data] [--write] [--all] [--module name]",
+		Description: `Compute a dev snapshot version from the HEAD commit date and the number
+of commits reachable from HEAD, so later builds always sort higher.
+The version file is only updated with --write; no hooks, tags or
+changelogs are run.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "label",
+				Usage: "Pre-release label (default from dev.label, or \"dev\")",
+			},
+			&cli.StringFlag{
+				Name:  "bump",
+				Usage: "Bump applied to a stable version: patch, minor or major (default from dev.bump, or minor)",
+			},
+			&cli.BoolFlag{
+				Name:  "write",
+				Usage: "Write the dev version to the version file",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runBumpDev(ctx, cmd, cfg)
+		},
+	}
+}
+
+// runBumpDev prints (and optionally writes) the dev snapshot version.
+func runBumpDev(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	label := cmd.String("label")
+	if label == "" {
+		label = cfg.GetDevLabel()
+	}
+	bumpType := cmd.String("bump")
+	if bumpType == "" {
+		bumpType = cfg.GetDevBump()
+	}
+	meta := clix.ResolveMetadata(cfg, cmd.String("meta"), false)
+
+	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	readBuildInfo := bumpDepsFromContext(ctx).buildInfo
+	if readBuildInfo == nil {
+		readBuildInfo = tagmanager.ReadBuildInfo
+	}
+	info := readBuildInfo()
+
+	if execCtx.IsSingleModule() {
+		return writeDevVersion(execCtx.Path, "", label, bumpType, meta, info, cmd.Bool("write"))
+	}
+
+	for _, mod := range execCtx.Modules {
+		if err := writeDevVersion(mod.Path, mod.Name, label, bumpType, meta, info, cmd.Bool("write")); err != nil {
+			return fmt.Errorf("module %s: %w", mod.Name, err)
+		}
+	}
+	return nil
+}
+
+// writeDevVersion prints the dev version for one version file, prefixed by
+// the module name in multi-module mode, and saves it when write is set.
+func writeDevVersion(path, name, label, bumpType, meta string, info tagmanager.BuildInfo, write bool) error {
+	current, err := semver.ReadVersion(path)
+	if err != nil {
+		return fmt.Errorf("failed to read version file at %s: %w", path, err)
+	}
+
+	version, err := devVersion(current, label, bumpType, info)
+	if err != nil {
+		return err
+	}
+	version.Build = meta
+	if write {
+		if err := semver.CheckRecordable(version); err != nil {
+			return err
+		}
+	}
+
+	if name != "" {
+		fmt.Printf("%s %s\n", name, version.String())
+	} else {
+		fmt.Println(version.String())
+	}
+
+	if !write {
+		return nil
+	}
+	if err := semver.SaveVersion(path, version); err != nil {
+		return fmt.Errorf("failed to save version: %w", err)
+	}
+	printer.PrintFaint(fmt.Sprintf("Updated version from %s to %s", current.String(), printer.Info(version.String())))
+	return nil
+}
+
+// devVersion computes a snapshot pre-release "<label>.<YYYYMMDD>.<commits>".
+// A stable version is bumped first so the snapshot sorts below the release
+// it leads to; a pre-release keeps its major.minor.patch.
+func devVersion(current semver.SemVersion, label, bumpType string, info tagmanager.BuildInfo) (semver.SemVersion, error) {
+	if info.CommitCount == 0 {
+		return semver.SemVersion{}, fmt.Errorf("cannot compute a dev version: no commits found (is this a git repository?)")
+	}
+
+	next := semver.SemVersion{Major: current.Major, Minor: current.Minor, Patch: current.Patch}
+	if current.PreRelease == "" {
+		switch bumpType {
+		case "patch", "minor", "major":
+		default:
+			return semver.SemVersion{}, fmt.Errorf("invalid dev bump type: %s", bumpType)
+		}
+		var err error
+		if next, err = semver.BumpByLabel(current, bumpType); err != nil {
+			return semver.SemVersion{}, err
+		}
+	}
+
+	date := info.CommitTime
+	if date.IsZero() {
+		date = time.Now().UTC()
+	}
+	next.PreRelease = fmt.Sprintf("%s.%s.%d", label, date.Format("20060102"), info.CommitCount)
+	return next, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/indaco/sley/internal/config"
@@ -81,14 +82,15 @@ func calculateNewBuild(meta string, preserveMeta bool, currentBuild string) stri
 }
 
 // validateTagAvailable checks if a tag can be created for the version.
-// Returns nil if tag manager is not enabled or tag is available.
+// Returns nil if tag manager is not enabled or tag is available. When versions
+// are derived from git tags the tag is the only record of the bump, so a
+// disabled tag manager or auto-create is an error.
 func validateTagAvailable(registry *plugins.PluginRegistry, version semver.SemVersion) error {
 	tm := registry.GetTagManager()
-	if tm == nil {
-		return nil
-	}
-
-	if !tm.IsAutoCreateEnabled() {
+	if tm == nil || !tm.IsAutoCreateEnabled() {
+		if semver.UsesGitTags() {
+			return fmt.Errorf("cannot record version %s: source is git-tags but tag-manager auto-create is disabled", version)
+		}
 		return nil
 	}
 
@@ -160,9 +162,10 @@ func commitAndTagAfterBump(registry *plugins.PluginRegistry, version semver.SemV
 	}
 	defer restorePrefix()
 
-	// Commit bump-modified files before creating the tag
+	// Commit bump-modified files before creating the tag. There is no version
+	// file to stage when versions are derived from git tags.
 	var extraFiles []string
-	if bumpedPath != "" {
+	if bumpedPath != "" && !semver.UsesGitTags() {
 		extraFiles = []string{bumpedPath}
 	}
 	if err := tm.CommitChanges(version, extraFiles); err != nil {
@@ -183,6 +186,14 @@ func commitAndTagAfterBump(registry *plugins.PluginRegistry, version semver.SemV
 		printer.PrintFaint(fmt.Sprintf("Pushed tag: %s", printer.Info(tagName)))
 	}
 
+	aliases, err := tm.UpdateAliases(version)
+	if err != nil {
+		return fmt.Errorf("failed to update alias tags: %w", err)
+	}
+	if len(aliases) > 0 {
+		printer.PrintFaint(fmt.Sprintf("Moved alias tags: %s", printer.Info(strings.Join(aliases, ", "))))
+	}
+
 	return nil
 }
 
@@ -389,9 +400,52 @@ func fileExists(path string) bool {
 	return err == nil
 }
 
+// auditDetails is the bump context recorded alongside an audit log entry.
+type auditDetails struct {
+	module     string
+	tag        string
+	executions []extensionmgr.HookExecution
+}
+
+// newAuditDetails collects the module name, the tag the bump creates and the
+// extension hooks that ran for the bumped .version file. tagPath is the path
+// passed to the tag step, which determines the module tag prefix.
+func newAuditDetails(registry *plugins.PluginRegistry, version semver.SemVersion, moduleName, versionPath, tagPath string, cfg *config.Config) auditDetails {
+	moduleDir := ""
+	if info := moduleInfoFromPath(versionPath); info != nil {
+		moduleDir = info.Dir
+		if moduleName == "" {
+			moduleName = info.Name
+		}
+	}
+
+	return auditDetails{
+		module:     moduleName,
+		tag:        plannedTagName(registry, version, tagPath, cfg),
+		executions: extensionmgr.TakeExecutions(moduleDir),
+	}
+}
+
+// plannedTagName returns the tag the bump will create, or "" when tags are
+// not created automatically.
+func plannedTagName(registry *plugins.PluginRegistry, version semver.SemVersion, bumpedPath string, cfg *config.Config) string {
+	tm := registry.GetTagManager()
+	if tm == nil || !tm.IsAutoCreateEnabled() {
+		return ""
+	}
+
+	restorePrefix, err := applyModuleTagPrefix(tm, bumpedPath, cfg)
+	if err != nil {
+		return ""
+	}
+	defer restorePrefix()
+
+	return tm.FormatTagName(version)
+}
+
 // recordAuditLogEntry records the version bump to the audit log if enabled.
 // Returns nil if audit log is not enabled or if logging fails (doesn't block the bump).
-func recordAuditLogEntry(registry *plugins.PluginRegistry, version, previousVersion semver.SemVersion, bumpType string) error {
+func recordAuditLogEntry(registry *plugins.PluginRegistry, version, previousVersion semver.SemVersion, bumpType string, details auditDetails) error {
 	al := registry.GetAuditLog()
 	if al == nil {
 		return nil
@@ -405,8 +459,28 @@ func recordAuditLogEntry(registry *plugins.PluginRegistry, version, previousVers
 		PreviousVersion: previousVersion.String(),
 		NewVersion:      version.String(),
 		BumpType:        bumpType,
+		Module:          details.module,
+		Tag:             details.tag,
+	}
+	for _, e := range details.executions {
+		if !slices.Contains(entry.Hooks, e.Hook) {
+			entry.Hooks = append(entry.Hooks, e.Hook)
+		}
+		if !slices.Contains(entry.Extensions, e.Extension) {
+			entry.Extensions = append(entry.Extensions, e.Extension)
+		}
 	}
 
 	// RecordEntry handles errors gracefully and logs warnings
 	return al.RecordEntry(entry)
 }
+
+// flushAuditLog writes recorded entries to the audit log sinks that attach
+// them to the release commit. It must run after commitAndTagAfterBump.
+func flushAuditLog(registry *plugins.PluginRegistry) error {
+	al := registry.GetAuditLog()
+	if al == nil || !al.IsEnabled() {
+		return nil
+	}
+	return al.Flush()
+}
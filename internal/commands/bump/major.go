@@ -34,7 +34,7 @@ func runBumpMajor(ctx context.Context, cmd *cli.Command, cfg *config.Config, reg
 		return err
 	}
 
-	params := extractBumpParams(cmd, "major", operations.BumpMajor)
+	params := extractBumpParams(cmd, cfg, "major", operations.BumpMajor)
 
 	return executeStandardBump(ctx, cmd, cfg, registry, params)
 }
@@ -34,7 +34,7 @@ func runBumpMinor(ctx context.Context, cmd *cli.Command, cfg *config.Config, reg
 		return err
 	}
 
-	params := extractBumpParams(cmd, "minor", operations.BumpMinor)
+	params := extractBumpParams(cmd, cfg, "minor", operations.BumpMinor)
 
 	return executeStandardBump(ctx, cmd, cfg, registry, params)
 }
@@ -36,6 +36,9 @@ func runMultiModuleBump(
 	}
 	bumper := bumperFn()
 	operation := operations.NewBumpOperation(fs, bumper, bumpType, preRelease, metadata, preserveMetadata)
+	if bumpType == operations.BumpPre {
+		operation.WithNextChannel(nextChannels(cmd, cfg))
+	}
 
 	skipHooks := cmd.Bool("skip-hooks")
 
@@ -118,7 +121,7 @@ func postBumpForModule(ctx context.Context, result workspace.ExecutionResult, re
 	moduleName := resolveModuleName(result.Module.Name)
 	effectiveCfg := resolveModuleConfig(cfg, modulePath, result.Module.Dir)
 
-	// Post-bump actions (dep-sync, changelog, audit-log)
+	// Post-bump actions (dep-sync, changelog)
 	independentVersioning := cfg != nil && cfg.Workspace != nil && cfg.Workspace.IsIndependentVersioning()
 	if err := executePostBumpActions(registry, newVer, oldVer, bumpTypeStr, result.Module.Path, moduleName, modulePath, independentVersioning); err != nil {
 		return fmt.Errorf("module %s: post-bump actions: %w", result.Module.Name, err)
@@ -129,11 +132,17 @@ func postBumpForModule(ctx context.Context, result workspace.ExecutionResult, re
 		return fmt.Errorf("module %s: post-bump hooks: %w", result.Module.Name, err)
 	}
 
+	// Audit log entry
+	details := newAuditDetails(registry, newVer, moduleName, result.Module.Path, result.Module.Path, effectiveCfg)
+	if err := recordAuditLogEntry(registry, newVer, oldVer, bumpTypeStr, details); err != nil {
+		return fmt.Errorf("module %s: audit log: %w", result.Module.Name, err)
+	}
+
 	// Commit and tag
 	if err := commitAndTagAfterBump(registry, newVer, bumpTypeStr, result.Module.Path, effectiveCfg); err != nil {
 		return fmt.Errorf("module %s: commit/tag: %w", result.Module.Name, err)
 	}
-	return nil
+	return flushAuditLog(registry)
 }
 
 // runPreBumpPhase runs extension hooks and validations for all modules before any
@@ -34,7 +34,7 @@ func runBumpPatch(ctx context.Context, cmd *cli.Command, cfg *config.Config, reg
 		return err
 	}
 
-	params := extractBumpParams(cmd, "patch", operations.BumpPatch)
+	params := extractBumpParams(cmd, cfg, "patch", operations.BumpPatch)
 
 	return executeStandardBump(ctx, cmd, cfg, registry, params)
 }
@@ -2,6 +2,7 @@ package bump
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/indaco/sley/internal/cliflags"
 	"github.com/indaco/sley/internal/clix"
@@ -20,6 +21,10 @@ func preCmd(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
 			Aliases: []string{"l"},
 			Usage:   "Pre-release label (e.g., alpha, beta, rc). If omitted, increments existing pre-release",
 		},
+		&cli.BoolFlag{
+			Name:  "next-channel",
+			Usage: "Move to the next configured pre-release channel (e.g., alpha.3 -> beta.1)",
+		},
 		&cli.StringFlag{
 			Name:  "meta",
 			Usage: "Optional build metadata",
@@ -38,7 +43,7 @@ func preCmd(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
 	return &cli.Command{
 		Name:      "pre",
 		Usage:     "Increment pre-release version (e.g., rc.1 -> rc.2)",
-		UsageText: "sley bump pre [--label name] [--meta data] [--preserve-meta] [--skip-hooks] [--all] [--module name]",
+		UsageText: "sley bump pre [--label name | --next-channel] [--meta data] [--preserve-meta] [--skip-hooks] [--all] [--module name]",
 		Flags:     flags,
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			return runBumpPre(ctx, cmd, cfg, registry)
@@ -49,8 +54,11 @@ func preCmd(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
 // runBumpPre executes the pre-release bump logic.
 func runBumpPre(ctx context.Context, cmd *cli.Command, cfg *config.Config, registry *plugins.PluginRegistry) error {
 	label := cmd.String("label")
-	meta := cmd.String("meta")
+	if label != "" && cmd.Bool("next-channel") {
+		return fmt.Errorf("--label and --next-channel cannot be used together")
+	}
 	isPreserveMeta := cmd.Bool("preserve-meta")
+	meta := clix.ResolveMetadata(cfg, cmd.String("meta"), isPreserveMeta)
 	isSkipHooks := cmd.Bool("skip-hooks")
 
 	if err := hooks.RunPreReleaseHooks(ctx, isSkipHooks); err != nil {
@@ -74,6 +82,16 @@ func runBumpPre(ctx context.Context, cmd *cli.Command, cfg *config.Config, regis
 		skipHooks:    isSkipHooks,
 		bumpType:     "pre",
 		opBumpType:   operations.BumpPre,
+		channels:     nextChannels(cmd, cfg),
 	}
 	return executeSingleModuleBump(ctx, cmd, cfg, registry, execCtx, params)
 }
+
+// nextChannels returns the configured pre-release channels when --next-channel
+// is set, or nil to keep the regular pre-release increment.
+func nextChannels(cmd *cli.Command, cfg *config.Config) []string {
+	if !cmd.Bool("next-channel") {
+		return nil
+	}
+	return cfg.GetPreReleaseChannels()
+}
@@ -33,6 +33,7 @@ func releaseCmd(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Comma
 func runBumpRelease(ctx context.Context, cmd *cli.Command, cfg *config.Config, registry *plugins.PluginRegistry) error {
 	isPreserveMeta := cmd.Bool("preserve-meta")
 	isSkipHooks := cmd.Bool("skip-hooks")
+	meta := clix.ResolveMetadata(cfg, "", isPreserveMeta)
 
 	// Run pre-release hooks first (before any version operations)
 	if err := hooks.RunPreReleaseHooks(ctx, isSkipHooks); err != nil {
@@ -46,11 +47,12 @@ func runBumpRelease(ctx context.Context, cmd *cli.Command, cfg *config.Config, r
 	}
 
 	if !execCtx.IsSingleModule() {
-		return runMultiModuleBump(ctx, cmd, cfg, execCtx, registry, nil, operations.BumpRelease, "", "", isPreserveMeta)
+		return runMultiModuleBump(ctx, cmd, cfg, execCtx, registry, nil, operations.BumpRelease, "", meta, isPreserveMeta)
 	}
 
 	// Single-module: use the unified path
 	params := bumpParams{
+		meta:         meta,
 		preserveMeta: isPreserveMeta,
 		skipHooks:    isSkipHooks,
 		bumpType:     "release",
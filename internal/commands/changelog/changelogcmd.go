@@ -3,9 +3,14 @@ package changelog
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/plugins/changeloggenerator"
+	"github.com/indaco/sley/internal/plugins/changelogparser"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/printer"
 	"github.com/urfave/cli/v3"
 )
@@ -17,6 +22,8 @@ func Run(cfg *config.Config) *cli.Command {
 		Usage: "Manage changelog files",
 		Commands: []*cli.Command{
 			mergeCmd(cfg),
+			templateCmd(),
+			lintCmd(cfg),
 		},
 	}
 }
@@ -33,6 +40,9 @@ This command combines all versioned changelog files (.changes/v*.md) into a sing
 CHANGELOG.md file, sorted by version (newest first). It prepends a default header
 or uses a custom header template if specified.
 
+Outputs configured in .sley.yaml (json, asciidoc, rst, html) are rebuilt from the
+per-version release data (.changes/v*.json) at the same time.
+
 Examples:
   sley changelog merge
   sley changelog merge --changes-dir .changes --output CHANGELOG.md
@@ -59,6 +69,130 @@ Examples:
 	}
 }
 
+// templateCmd returns the "template" subcommand.
+func templateCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "template",
+		Usage:     "Print a built-in changelog template to customize",
+		UsageText: "sley changelog template <" + strings.Join(changeloggenerator.BuiltinTemplateNames, "|") + ">",
+		Description: `Print the Go text/template behind one of the built-in changelog formats.
+
+Save it to a file, adapt it, then point the changelog generator at it:
+
+  plugins:
+    changelog-generator:
+      format: template
+      template: changelog.tmpl
+
+Examples:
+  sley changelog template grouped > changelog.tmpl
+  sley changelog template keepachangelog`,
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 1 {
+				return fmt.Errorf("expected one template name (%s)", strings.Join(changeloggenerator.BuiltinTemplateNames, ", "))
+			}
+			source, err := changeloggenerator.BuiltinTemplate(cmd.Args().First())
+			if err != nil {
+				return err
+			}
+			fmt.Print(source)
+			return nil
+		},
+	}
+}
+
+// lintCmd returns the "lint" subcommand.
+func lintCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "lint",
+		Usage:     "Check the changelog for structural problems",
+		UsageText: "sley changelog lint [--path CHANGELOG.md] [--fix]",
+		Description: `Check the changelog for duplicate or unsorted version headings, versions
+without a matching git tag, missing or incorrect compare links, malformed
+dates, empty sections and an Unreleased section left after a release.
+
+The command exits with an error when issues are found, so it can gate releases
+in CI. With --fix, versions are sorted newest first (Unreleased on top) and
+compare links are rebuilt before linting.
+
+Examples:
+  sley changelog lint
+  sley changelog lint --path docs/CHANGELOG.md
+  sley changelog lint --fix`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "path",
+				Usage: "Path to the changelog file (default: from .sley.yaml or CHANGELOG.md)",
+			},
+			&cli.BoolFlag{
+				Name:  "fix",
+				Usage: "Normalise version ordering and rebuild compare links",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runLintCmd(cmd, cfg)
+		},
+	}
+}
+
+// runLintCmd lints the changelog and applies fixes when requested.
+func runLintCmd(cmd *cli.Command, cfg *config.Config) error {
+	path := cmd.String("path")
+	if path == "" {
+		path = resolveChangelogPath(cfg)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read changelog: %w", err)
+	}
+	content := string(data)
+
+	opts := changelogparser.LintOptions{}
+	if tags, err := tagmanager.ListTags(""); err == nil {
+		opts.Tags = tags
+	} else {
+		printer.PrintFaint("Git tags unavailable, skipping tag checks")
+	}
+	if gen, err := changeloggenerator.NewGenerator(buildGeneratorConfig(cmd, cfg), changeloggenerator.NewGitOps()); err == nil {
+		opts.CompareURL = gen.CompareURLFunc()
+	}
+
+	if cmd.Bool("fix") {
+		if fixed := changelogparser.FixChangelog(content, opts); fixed != content {
+			if err := os.WriteFile(path, []byte(fixed), core.PermPublicRead); err != nil {
+				return fmt.Errorf("failed to write changelog: %w", err)
+			}
+			content = fixed
+			printer.PrintFaint(fmt.Sprintf("Fixed ordering and compare links in %s", printer.Info(path)))
+		}
+	}
+
+	issues := changelogparser.LintChangelog(content, opts)
+	if len(issues) == 0 {
+		printer.PrintSuccess(fmt.Sprintf("%s: no issues found", path))
+		return nil
+	}
+	for _, issue := range issues {
+		fmt.Printf("%s:%s\n", path, issue)
+	}
+	return fmt.Errorf("changelog lint found %d issue(s) in %s", len(issues), path)
+}
+
+// resolveChangelogPath returns the changelog path configured for the
+// changelog parser or generator, defaulting to CHANGELOG.md.
+func resolveChangelogPath(cfg *config.Config) string {
+	if cfg != nil && cfg.Plugins != nil {
+		if cfg.Plugins.ChangelogParser != nil && cfg.Plugins.ChangelogParser.Path != "" {
+			return cfg.Plugins.ChangelogParser.Path
+		}
+		if cfg.Plugins.ChangelogGenerator != nil && cfg.Plugins.ChangelogGenerator.ChangelogPath != "" {
+			return cfg.Plugins.ChangelogGenerator.ChangelogPath
+		}
+	}
+	return "CHANGELOG.md"
+}
+
 // runMergeCmd executes the merge operation.
 func runMergeCmd(cmd *cli.Command, cfg *config.Config) error {
 	// Check if changelog-generator plugin is enabled
@@ -98,6 +232,9 @@ func runMergeCmd(cmd *cli.Command, cfg *config.Config) error {
 
 	printer.PrintFaint(fmt.Sprintf("Merged changelog files from %s into %s",
 		printer.Info(genCfg.ChangesDir), printer.Info(genCfg.ChangelogPath)))
+	for _, out := range genCfg.Outputs {
+		printer.PrintFaint(fmt.Sprintf("Rendered %s changelog %s", out.Format, printer.Info(out.Path)))
+	}
 
 	return nil
 }
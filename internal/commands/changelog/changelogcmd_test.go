@@ -281,6 +281,52 @@ func TestChangelogMergeCmd_WithConfig(t *testing.T) {
 	}
 }
 
+func TestChangelogMergeCmd_Outputs(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	changesDir := filepath.Join(tmpDir, ".changes")
+	createVersionedChangelogFiles(t, changesDir)
+
+	release := `{"version": "v1.2.0", "date": "2026-01-02", "commits": [{"description": "add <export>", "subject": "feat: add <export>", "hash": "abc", "short_hash": "abc", "author": "A", "group": "Enhancements"}]}`
+	if err := os.WriteFile(filepath.Join(changesDir, "v1.2.0.json"), []byte(release), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{
+		Path: tmpDir,
+		Plugins: &config.PluginConfig{
+			ChangelogGenerator: &config.ChangelogGeneratorConfig{
+				Enabled: true,
+				Outputs: []config.ChangelogOutputConfig{{Path: "site/changelog.html", Format: "html"}},
+			},
+		},
+	}
+
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"sley", "changelog", "merge"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+	if !strings.Contains(output, "Rendered html changelog") {
+		t.Errorf("expected rendered output message, got: %s", output)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "site", "changelog.html"))
+	if err != nil {
+		t.Fatalf("expected HTML output: %v", err)
+	}
+	for _, want := range []string{"<h2>v1.2.0 - 2026-01-02</h2>", "<h3>Enhancements</h3>", "<li>add &lt;export&gt;</li>"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("expected %q in HTML output:\n%s", want, content)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "CHANGELOG.md")); err != nil {
+		t.Errorf("expected unified Markdown changelog: %v", err)
+	}
+}
+
 func TestChangelogMergeCmd_FlagOverridesConfig(t *testing.T) {
 
 	tmpDir := t.TempDir()
@@ -638,6 +684,151 @@ func TestChangelogMergeCmd_MultiModuleSubdirectories(t *testing.T) {
 	}
 }
 
+/* ------------------------------------------------------------------------- */
+/* CHANGELOG TEMPLATE COMMAND                                                */
+/* ------------------------------------------------------------------------- */
+
+func TestChangelogTemplateCmd(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{Path: tmpDir}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"sley", "changelog", "template", "keepachangelog"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+
+	want, _ := changeloggenerator.BuiltinTemplate("keepachangelog")
+	if strings.TrimSpace(output) != strings.TrimSpace(want) {
+		t.Errorf("output = %q, want the built-in template", output)
+	}
+
+	for _, args := range [][]string{
+		{"sley", "changelog", "template"},
+		{"sley", "changelog", "template", "unknown"},
+	} {
+		if err := appCli.Run(t.Context(), args); err == nil {
+			t.Errorf("%v: expected error, got nil", args)
+		}
+	}
+}
+
+const unsortedChangelog = `# Changelog
+
+## [1.0.0] - 2025-01-01
+
+### Added
+- Initial release
+
+## [1.1.0] - 2025-02-01
+
+### Added
+- New feature
+
+[1.1.0]: https://github.com/owner/repo/compare/v0.9.0...v1.1.0
+`
+
+func TestChangelogLintCmd_ReportsIssues(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	testutils.WriteFile(t, filepath.Join(tmpDir, "CHANGELOG.md"), unsortedChangelog, 0o644)
+
+	cfg := &config.Config{Path: tmpDir}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	var cliErr error
+	output, err := testutils.CaptureStdout(func() {
+		cliErr = testutils.RunCLITestAllowError(t, appCli, []string{"sley", "changelog", "lint"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+	if cliErr == nil || !strings.Contains(cliErr.Error(), "changelog lint found 2 issue(s)") {
+		t.Fatalf("expected lint error, got: %v", cliErr)
+	}
+	for _, want := range []string{"CHANGELOG.md:line 8:", "(unsorted-versions)", "CHANGELOG.md:line 13:", "(incorrect-link)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got: %s", want, output)
+		}
+	}
+}
+
+func TestChangelogLintCmd_Fix(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "docs", "CHANGELOG.md")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	testutils.WriteFile(t, path, unsortedChangelog, 0o644)
+
+	cfg := &config.Config{
+		Path: tmpDir,
+		Plugins: &config.PluginConfig{
+			ChangelogParser: &config.ChangelogParserConfig{Enabled: true, Path: "docs/CHANGELOG.md"},
+		},
+	}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"sley", "changelog", "lint", "--fix"}, tmpDir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+	if !strings.Contains(output, "Fixed ordering and compare links") {
+		t.Errorf("expected fix message, got: %s", output)
+	}
+
+	content := testutils.ReadFile(t, path)
+	if strings.Index(content, "## [1.1.0]") > strings.Index(content, "## [1.0.0]") {
+		t.Errorf("expected versions sorted newest first:\n%s", content)
+	}
+	if !strings.Contains(content, "[1.1.0]: https://github.com/owner/repo/compare/v1.0.0...v1.1.0") {
+		t.Errorf("expected rebuilt compare link:\n%s", content)
+	}
+}
+
+func TestChangelogLintCmd_MissingFile(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{Path: tmpDir}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	err := testutils.RunCLITestAllowError(t, appCli, []string{"sley", "changelog", "lint", "--path", "missing.md"}, tmpDir)
+	if err == nil || !strings.Contains(err.Error(), "failed to read changelog") {
+		t.Fatalf("expected read error, got: %v", err)
+	}
+}
+
+func TestResolveChangelogPath(t *testing.T) {
+
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want string
+	}{
+		{"nil config", nil, "CHANGELOG.md"},
+		{"parser path", &config.Config{Plugins: &config.PluginConfig{
+			ChangelogParser:    &config.ChangelogParserConfig{Path: "docs/CHANGES.md"},
+			ChangelogGenerator: &config.ChangelogGeneratorConfig{ChangelogPath: "OTHER.md"},
+		}}, "docs/CHANGES.md"},
+		{"generator path", &config.Config{Plugins: &config.PluginConfig{
+			ChangelogGenerator: &config.ChangelogGeneratorConfig{ChangelogPath: "OTHER.md"},
+		}}, "OTHER.md"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveChangelogPath(tt.cfg); got != tt.want {
+				t.Errorf("resolveChangelogPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsChangelogGeneratorEnabled(t *testing.T) {
 
 	tests := []struct {
@@ -0,0 +1,151 @@
+package depsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins"
+	"github.com/indaco/sley/internal/plugins/dependencycheck"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// RunCheck returns the "check" command.
+func RunCheck(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
+	return &cli.Command{
+		Name:      "check",
+		Usage:     "Check that dependency-check files match the current version",
+		UsageText: "sley check [--format text|json]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Output format: text or json",
+				Value: "text",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runCheckCmd(cmd, cfg, registry)
+		},
+	}
+}
+
+// checkReport is the JSON output of "sley check".
+type checkReport struct {
+	Version         string               `json:"version"`
+	Consistent      bool                 `json:"consistent"`
+	Files           int                  `json:"files"`
+	Inconsistencies []inconsistencyEntry `json:"inconsistencies"`
+}
+
+// inconsistencyEntry is one mismatch in the JSON output.
+type inconsistencyEntry struct {
+	File     string `json:"file"`
+	Field    string `json:"field,omitempty"`
+	Format   string `json:"format"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// runCheckCmd compares every configured file against the .version file and
+// fails when any of them differ.
+func runCheckCmd(cmd *cli.Command, cfg *config.Config, registry *plugins.PluginRegistry) error {
+	format := cmd.String("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format %q: must be text or json", format)
+	}
+
+	dc, err := dependencyChecker(registry)
+	if err != nil {
+		return err
+	}
+
+	path := versionPath(cmd, cfg)
+	version, err := semver.ReadVersion(path)
+	if err != nil {
+		return fmt.Errorf("failed to read version file at %s: %w", path, err)
+	}
+
+	inconsistencies, err := dc.CheckConsistency(version.String())
+	if err != nil {
+		return fmt.Errorf("dependency check failed: %w", err)
+	}
+
+	files := len(dc.GetConfig().Files)
+	if format == "json" {
+		if err := writeCheckJSON(os.Stdout, version.String(), files, inconsistencies); err != nil {
+			return err
+		}
+	} else {
+		writeCheckText(os.Stdout, version.String(), files, inconsistencies)
+	}
+
+	if len(inconsistencies) > 0 {
+		return fmt.Errorf("%d version inconsistency(ies) found; run 'sley sync' to fix", len(inconsistencies))
+	}
+	return nil
+}
+
+// writeCheckText prints one line per mismatch, or a success line.
+func writeCheckText(w io.Writer, version string, files int, inconsistencies []dependencycheck.Inconsistency) {
+	if len(inconsistencies) == 0 {
+		fmt.Fprintf(w, "%s All %d file(s) match version %s\n", printer.SuccessBadge("✓"), files, printer.Info(version))
+		return
+	}
+	for _, inc := range inconsistencies {
+		fmt.Fprintf(w, "%s %s\n", printer.Error("✗"), inc.String())
+	}
+}
+
+func writeCheckJSON(w io.Writer, version string, files int, inconsistencies []dependencycheck.Inconsistency) error {
+	report := checkReport{
+		Version:         version,
+		Consistent:      len(inconsistencies) == 0,
+		Files:           files,
+		Inconsistencies: make([]inconsistencyEntry, len(inconsistencies)),
+	}
+	for i, inc := range inconsistencies {
+		report.Inconsistencies[i] = inconsistencyEntry{
+			File:     inc.Path,
+			Field:    inc.Field,
+			Format:   inc.Format,
+			Expected: inc.Expected,
+			Actual:   inc.Found,
+		}
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode check report: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// dependencyChecker returns the enabled dependency checker or an error
+// explaining how to enable it.
+func dependencyChecker(registry *plugins.PluginRegistry) (dependencycheck.DependencyChecker, error) {
+	dc := registry.GetDependencyChecker()
+	if dc == nil || !dc.IsEnabled() {
+		return nil, fmt.Errorf("dependency-check plugin is not enabled: configure plugins.dependency-check in .sley.yaml")
+	}
+	if len(dc.GetConfig().Files) == 0 {
+		return nil, fmt.Errorf("dependency-check plugin has no files configured")
+	}
+	return dc, nil
+}
+
+// versionPath returns the version file path from flags or config.
+func versionPath(cmd *cli.Command, cfg *config.Config) string {
+	if cmd.IsSet("path") {
+		return cmd.String("path")
+	}
+	if cfg != nil && cfg.Path != "" {
+		return cfg.Path
+	}
+	return ".version"
+}
@@ -0,0 +1,103 @@
+package depsync
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins"
+	"github.com/indaco/sley/internal/plugins/dependencycheck"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+// setupProject writes a .version file and a package.json and returns a
+// registry with a dependency checker configured for the package.json.
+func setupProject(t *testing.T, version, pkgVersion string) (string, *plugins.PluginRegistry) {
+	t.Helper()
+	dir := t.TempDir()
+	testutils.WriteTempVersionFile(t, dir, version)
+	testutils.WriteFile(t, filepath.Join(dir, "package.json"), `{"name": "app", "version": "`+pkgVersion+`"}`+"\n", 0o644)
+
+	registry := plugins.NewPluginRegistry()
+	dc := dependencycheck.NewDependencyChecker(&dependencycheck.Config{
+		Enabled: true,
+		Files: []dependencycheck.FileConfig{
+			{Path: filepath.Join(dir, "package.json"), Field: "version", Format: "json"},
+		},
+	})
+	if err := registry.RegisterDependencyChecker(dc); err != nil {
+		t.Fatal(err)
+	}
+	return dir, registry
+}
+
+func TestCLI_CheckCommand(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "1.2.3")
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunCheck(cfg, registry)})
+
+	output, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"sley", "check"}, dir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+	if !strings.Contains(output, "All 1 file(s) match version") {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
+
+func TestCLI_CheckCommand_Inconsistent(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "1.2.0")
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunCheck(cfg, registry)})
+
+	var runErr error
+	output, err := testutils.CaptureStdout(func() {
+		runErr = testutils.RunCLITestAllowError(t, appCli, []string{"sley", "check", "--format", "json"}, dir)
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+	if runErr == nil {
+		t.Fatal("expected error for inconsistent files, got nil")
+	}
+
+	var report checkReport
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", output, err)
+	}
+	if report.Consistent || len(report.Inconsistencies) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	got := report.Inconsistencies[0]
+	if got.Field != "version" || got.Expected != "1.2.3" || got.Actual != "1.2.0" || filepath.Base(got.File) != "package.json" {
+		t.Errorf("unexpected inconsistency: %+v", got)
+	}
+}
+
+func TestCLI_CheckCommand_Errors(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "1.2.3")
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+
+	tests := []struct {
+		name     string
+		registry *plugins.PluginRegistry
+		args     []string
+	}{
+		{"plugin not enabled", plugins.NewPluginRegistry(), []string{"sley", "check"}},
+		{"invalid format", registry, []string{"sley", "check", "--format", "xml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunCheck(cfg, tt.registry)})
+			if err := testutils.RunCLITestAllowError(t, appCli, tt.args, dir); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
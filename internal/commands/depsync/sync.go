@@ -1,4 +1,5 @@
-// Package depsync provides dependency synchronization for CLI commands.
+// Package depsync provides dependency synchronization for CLI commands and
+// the "sley check" and "sley sync" commands.
 package depsync
 
 import (
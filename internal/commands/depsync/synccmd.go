@@ -0,0 +1,111 @@
+package depsync
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/operations"
+	"github.com/indaco/sley/internal/parser"
+	"github.com/indaco/sley/internal/plugins"
+	"github.com/indaco/sley/internal/plugins/dependencycheck"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// RunSync returns the "sync" command.
+func RunSync(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
+	return &cli.Command{
+		Name:      "sync",
+		Usage:     "Write the current version to all dependency-check files",
+		UsageText: "sley sync [--from file]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "Adopt the version found in this file and write it to .version and all other files",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runSyncCmd(ctx, cmd, cfg, registry)
+		},
+	}
+}
+
+// runSyncCmd forces every configured file to the version in .version, or to
+// the version read from --from.
+func runSyncCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config, registry *plugins.PluginRegistry) error {
+	dc, err := dependencyChecker(registry)
+	if err != nil {
+		return err
+	}
+
+	path := versionPath(cmd, cfg)
+	var version semver.SemVersion
+	if from := cmd.String("from"); from != "" {
+		version, err = readSourceVersion(ctx, from, dc.GetConfig().Files)
+		if err != nil {
+			return err
+		}
+		if err := semver.CheckRecordable(version); err != nil {
+			return err
+		}
+		if err := semver.SaveVersion(path, version); err != nil {
+			return fmt.Errorf("failed to save version: %w", err)
+		}
+		printer.PrintFaint(fmt.Sprintf("Set version to %s in %s (from %s)", printer.Info(version.String()), printer.Info(path), from))
+	} else {
+		version, err = semver.ReadVersion(path)
+		if err != nil {
+			return fmt.Errorf("failed to read version file at %s: %w", path, err)
+		}
+	}
+
+	if err := dc.SyncVersions(version.String()); err != nil {
+		return fmt.Errorf("failed to sync dependency versions: %w", err)
+	}
+
+	files := dc.GetConfig().Files
+	items := make([]string, len(files))
+	for i, file := range files {
+		name := operations.DeriveDependencyName(file.Path)
+		items[i] = fmt.Sprintf("%s %s %s%s", printer.SuccessBadge("✓"), name, printer.Faint("("+file.Path+")"), printer.Faint(": "+version.String()))
+	}
+	ty := printer.Typography()
+	fmt.Println(ty.Section(ty.H4("Sync dependencies"), ty.UL(items...)))
+	return nil
+}
+
+// readSourceVersion reads the version from path. A configured file is read
+// with its own format and field; any other file is detected by name.
+func readSourceVersion(ctx context.Context, path string, files []dependencycheck.FileConfig) (semver.SemVersion, error) {
+	source := parser.FileConfig{
+		Path:   path,
+		Format: parser.FormatForFile(path),
+	}
+	if source.Format != parser.FormatRaw {
+		source.Field = parser.FieldForFormat(path)
+	}
+	for _, file := range files {
+		if filepath.Clean(file.Path) != filepath.Clean(path) {
+			continue
+		}
+		if file.Field == "" && len(file.References) > 0 {
+			return semver.SemVersion{}, fmt.Errorf("%s only lists dependency references and has no version to adopt", path)
+		}
+		source = parser.FileConfig{Path: path, Format: parser.Format(file.Format), Field: file.Field, Pattern: file.Pattern}
+		break
+	}
+
+	raw, err := parser.NewReader(core.NewOSFileSystem()).ReadVersion(ctx, source)
+	if err != nil {
+		return semver.SemVersion{}, err
+	}
+	version, err := semver.ParseLenient(raw)
+	if err != nil {
+		return semver.SemVersion{}, fmt.Errorf("invalid version %q in %s: %w", raw, path, err)
+	}
+	return version, nil
+}
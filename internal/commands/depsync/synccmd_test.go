@@ -0,0 +1,109 @@
+package depsync
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+func TestCLI_SyncCommand(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "1.0.0")
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunSync(cfg, registry)})
+
+	if _, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"sley", "sync"}, dir)
+	}); err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+
+	got := testutils.ReadFile(t, filepath.Join(dir, "package.json"))
+	if !strings.Contains(got, `"version": "1.2.3"`) {
+		t.Errorf("package.json not synced: %q", got)
+	}
+}
+
+func TestCLI_SyncCommand_From(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "2.0.0")
+	testutils.WriteFile(t, filepath.Join(dir, "Cargo.toml"), "[package]\nname = \"app\"\nversion = \"3.1.0\"\n", 0o644)
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunSync(cfg, registry)})
+
+	tests := []struct {
+		name string
+		from string
+		want string
+	}{
+		{"configured file", filepath.Join(dir, "package.json"), "2.0.0"},
+		{"detected file", "Cargo.toml", "3.1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := testutils.CaptureStdout(func() {
+				testutils.RunCLITest(t, appCli, []string{"sley", "sync", "--from", tt.from}, dir)
+			}); err != nil {
+				t.Fatalf("Failed to capture stdout: %v", err)
+			}
+
+			if got := testutils.ReadTempVersionFile(t, dir); got != tt.want {
+				t.Errorf(".version = %q, want %q", got, tt.want)
+			}
+			pkg := testutils.ReadFile(t, filepath.Join(dir, "package.json"))
+			if !strings.Contains(pkg, `"version": "`+tt.want+`"`) {
+				t.Errorf("package.json not synced: %q", pkg)
+			}
+		})
+	}
+}
+
+func TestCLI_SyncCommand_FromNormalizesVersion(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "2.0.0")
+	testutils.WriteFile(t, filepath.Join(dir, "VERSION"), "v2.4\n", 0o644)
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunSync(cfg, registry)})
+
+	if _, err := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{"sley", "sync", "--from", "VERSION"}, dir)
+	}); err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+
+	if got := testutils.ReadTempVersionFile(t, dir); got != "2.4.0" {
+		t.Errorf(".version = %q, want %q", got, "2.4.0")
+	}
+}
+
+func TestCLI_SyncCommand_FromInvalidVersion(t *testing.T) {
+	dir, registry := setupProject(t, "1.2.3", "not-a-version")
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunSync(cfg, registry)})
+
+	err := testutils.RunCLITestAllowError(t, appCli, []string{"sley", "sync", "--from", filepath.Join(dir, "package.json")}, dir)
+	if err == nil {
+		t.Fatal("expected error for invalid source version, got nil")
+	}
+	if got := testutils.ReadTempVersionFile(t, dir); got != "1.2.3" {
+		t.Errorf(".version changed to %q", got)
+	}
+}
+
+func TestCLI_SyncCommand_FromGitTagSource(t *testing.T) {
+	restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(
+		semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.2.3"}})))
+	defer restore()
+
+	dir, registry := setupProject(t, "1.2.3", "2.0.0")
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{RunSync(cfg, registry)})
+
+	err := testutils.RunCLITestAllowError(t, appCli, []string{"sley", "sync", "--from", filepath.Join(dir, "package.json")}, dir)
+	if err == nil || !strings.Contains(err.Error(), "cannot record version 2.0.0: source is git-tags") {
+		t.Fatalf("expected git-tags error, got %v", err)
+	}
+}
@@ -193,12 +193,13 @@ func printConfigValidationSummary(results []config.ValidationResult) {
 }
 
 // runSingleModuleValidate handles the single-module validate operation.
+// The version file must conform strictly to the SemVer 2.0.0 grammar.
 func runSingleModuleValidate(cmd *cli.Command, path string) error {
 	if _, err := clix.FromCommand(cmd); err != nil {
 		return err
 	}
 
-	_, err := semver.ReadVersion(path)
+	_, err := semver.ReadVersionStrict(path)
 	if err != nil {
 		return fmt.Errorf("invalid version file at %s: %w", path, err)
 	}
@@ -64,6 +64,9 @@ func TestCLI_ValidateCommand_Errors(t *testing.T) {
 	}{
 		{"invalid version string", "not-a-version", "invalid version"},
 		{"invalid build metadata", "1.0.0+inv@lid-meta", "invalid version"},
+		{"leading zero", "01.2.3", "leading zero in major version"},
+		{"v prefix", "v1.2.3", "prefix is not allowed"},
+		{"empty pre-release identifier", "1.2.3-rc..1", "empty pre-release identifier"},
 	}
 
 	for _, tt := range tests {
@@ -15,6 +15,8 @@ func Run() *cli.Command {
 			enableCmd(),
 			disableCmd(),
 			listCmd(),
+			newCmd(),
+			testCmd(),
 		},
 	}
 }
@@ -0,0 +1,117 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/indaco/sley/internal/extensionmgr"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/urfave/cli/v3"
+)
+
+// newCmd returns the "new" subcommand.
+func newCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "new",
+		Usage:     "Scaffold a new extension",
+		ArgsUsage: "<name>",
+		Description: `Generate a ready-to-run extension: a manifest, an entry script that parses
+the hook input and emits a valid hook output, a README and a test harness.
+
+Examples:
+  sley extension new my-extension
+  sley extension new release-notify --lang python --hooks pre-bump,post-bump
+  sley extension new sync-docs --lang go --dir ./extensions`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "lang",
+				Usage: "Language of the entry script (" + strings.Join(extensionmgr.ScaffoldLanguages, ", ") + ")",
+				Value: "sh",
+			},
+			&cli.StringSliceFlag{
+				Name:  "hooks",
+				Usage: "Hooks the extension handles (pre-bump, post-bump, pre-release, validate)",
+				Value: []string{string(extensionmgr.PostBumpHook)},
+			},
+			&cli.StringFlag{Name: "dir", Usage: "Directory to create the extension in", Value: "."},
+			&cli.StringFlag{Name: "description", Usage: "Extension description"},
+			&cli.StringFlag{Name: "author", Usage: "Extension author (defaults to git user.name)"},
+			&cli.StringFlag{Name: "repository", Usage: "Extension repository URL"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runExtensionNew(ctx, cmd)
+		},
+	}
+}
+
+// runExtensionNew scaffolds a new extension directory.
+func runExtensionNew(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.Args().First()
+	if name == "" {
+		return fmt.Errorf("missing extension name: sley extension new <name>")
+	}
+
+	author := cmd.String("author")
+	if author == "" {
+		author = gitUserName(ctx)
+	}
+
+	extDir, files, err := extensionmgr.Scaffold(extensionmgr.ScaffoldOptions{
+		Name:        name,
+		Lang:        cmd.String("lang"),
+		Hooks:       splitHooks(cmd.StringSlice("hooks")),
+		Dir:         cmd.String("dir"),
+		Description: cmd.String("description"),
+		Author:      author,
+		Repository:  cmd.String("repository"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create extension: %w", err)
+	}
+
+	ty := printer.Typography()
+	fmt.Printf("Created extension %s in %s\n", printer.Info(name), extDir)
+	for _, f := range files {
+		rel, err := filepath.Rel(extDir, f)
+		if err != nil {
+			rel = f
+		}
+		fmt.Printf("  %s\n", ty.Small(rel))
+	}
+
+	fmt.Println()
+	printer.PrintFaint("Next steps:")
+	printer.PrintFaint(fmt.Sprintf("  %s/test.sh", extDir))
+	printer.PrintFaint(fmt.Sprintf("  sley extension test %s --hook %s --version 1.2.3", extDir, splitHooks(cmd.StringSlice("hooks"))[0]))
+	printer.PrintFaint(fmt.Sprintf("  sley extension install --path %s", extDir))
+
+	return nil
+}
+
+// splitHooks accepts both repeated flags and comma-separated values.
+func splitHooks(values []string) []string {
+	var hooks []string
+	for _, v := range values {
+		for h := range strings.SplitSeq(v, ",") {
+			if h = strings.TrimSpace(h); h != "" {
+				hooks = append(hooks, h)
+			}
+		}
+	}
+	if len(hooks) == 0 {
+		return []string{string(extensionmgr.PostBumpHook)}
+	}
+	return hooks
+}
+
+// gitUserName returns the configured git user name, or an empty string.
+func gitUserName(ctx context.Context) string {
+	out, err := exec.CommandContext(ctx, "git", "config", "user.name").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,82 @@
+package extension
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/extensions"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+/* ------------------------------------------------------------------------- */
+/* EXTENSION NEW COMMAND                                                     */
+/* ------------------------------------------------------------------------- */
+
+func TestExtensionNewCmd_Success(t *testing.T) {
+	tmpDir := t.TempDir()
+	appCli := testutils.BuildCLIForTests(filepath.Join(tmpDir, ".version"), []*cli.Command{Run()})
+
+	output, _ := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{
+			"sley", "extension", "new", "my-ext",
+			"--lang", "python", "--hooks", "pre-bump,post-bump", "--author", "Tester",
+		}, tmpDir)
+	})
+
+	if !strings.Contains(output, "Created extension my-ext") {
+		t.Errorf("expected creation message, got:\n%s", output)
+	}
+
+	manifest, err := extensions.LoadExtensionManifest(filepath.Join(tmpDir, "my-ext"))
+	if err != nil {
+		t.Fatalf("generated manifest failed to load: %v", err)
+	}
+	if manifest.Author != "Tester" || manifest.Entry != "hook.py" {
+		t.Errorf("unexpected manifest: %+v", manifest)
+	}
+	if len(manifest.Hooks) != 2 || manifest.Hooks[0] != "pre-bump" || manifest.Hooks[1] != "post-bump" {
+		t.Errorf("expected hooks [pre-bump post-bump], got %v", manifest.Hooks)
+	}
+}
+
+func TestExtensionNewCmd_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing name", []string{"sley", "extension", "new"}, "missing extension name"},
+		{"invalid language", []string{"sley", "extension", "new", "demo", "--lang", "cobol"}, "unsupported language"},
+		{"invalid hook", []string{"sley", "extension", "new", "demo", "--hooks", "after-bump"}, "invalid hook type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			appCli := testutils.BuildCLIForTests(filepath.Join(tmpDir, ".version"), []*cli.Command{Run()})
+
+			err := testutils.RunCLITestAllowError(t, appCli, tt.args, tmpDir)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+			if _, statErr := os.Stat(filepath.Join(tmpDir, "demo")); statErr == nil {
+				t.Error("expected no extension directory to be created")
+			}
+		})
+	}
+}
+
+func TestSplitHooks(t *testing.T) {
+	got := splitHooks([]string{"pre-bump, post-bump", "validate", ""})
+	want := []string{"pre-bump", "post-bump", "validate"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("splitHooks() = %v, want %v", got, want)
+	}
+
+	if got := splitHooks(nil); len(got) != 1 || got[0] != "post-bump" {
+		t.Errorf("expected default post-bump hook, got %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/extensionmgr"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/urfave/cli/v3"
+)
+
+// testCmd returns the "test" subcommand.
+func testCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "test",
+		Usage:     "Invoke an extension hook with synthetic input",
+		ArgsUsage: "<path>",
+		Description: `Run a hook of the extension at <path> through the same executor used during
+bumps, including the sandbox for declared permissions, and print its output.
+
+Examples:
+  sley extension test ./my-extension --hook post-bump --version 1.2.3
+  sley extension test ./my-extension --hook pre-bump --version 2.0.0 --previous-version 1.9.0 --bump-type major`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "hook", Usage: "Hook to invoke", Value: string(extensionmgr.PostBumpHook)},
+			&cli.StringFlag{Name: "version", Usage: "Version passed to the hook", Value: "1.2.3"},
+			&cli.StringFlag{Name: "previous-version", Usage: "Previous version passed to the hook (derived from --version by default)"},
+			&cli.StringFlag{Name: "bump-type", Usage: "Bump type passed to the hook", Value: "patch"},
+			&cli.StringFlag{Name: "module", Usage: "Module name passed to the hook"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runExtensionTest(ctx, cmd)
+		},
+	}
+}
+
+// runExtensionTest invokes a single extension hook and prints the result.
+func runExtensionTest(ctx context.Context, cmd *cli.Command) error {
+	extPath := cmd.Args().First()
+	if extPath == "" {
+		return fmt.Errorf("missing extension path: sley extension test <path>")
+	}
+
+	projectRoot, err := os.Getwd()
+	if err != nil {
+		projectRoot = "."
+	}
+
+	hook := extensionmgr.HookType(cmd.String("hook"))
+	input := extensionmgr.NewSampleHookInput(hook, cmd.String("version"), projectRoot)
+	if prev := cmd.String("previous-version"); prev != "" {
+		input.PreviousVersion = prev
+	}
+	input.BumpType = cmd.String("bump-type")
+	input.ModuleName = cmd.String("module")
+
+	ty := printer.Typography()
+	fmt.Printf("Invoking %s (%s)... ", printer.Info(extPath), ty.Small(string(hook)))
+
+	// A missing or unreadable config only limits what rpc extensions can query.
+	cfg, err := config.LoadConfig()
+	if err != nil || cfg == nil {
+		cfg = &config.Config{Path: ".version"}
+	}
+	defer extensionmgr.CloseRPCSessions()
+
+	_, output, err := extensionmgr.InvokeExtension(ctx, cfg, extPath, hook, input)
+	if err != nil {
+		fmt.Println(ty.ErrorBadge("FAIL"))
+		return fmt.Errorf("extension test failed: %w", err)
+	}
+	fmt.Println(ty.SuccessBadge("OK"))
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format extension output: %w", err)
+	}
+	fmt.Println(string(data))
+
+	return nil
+}
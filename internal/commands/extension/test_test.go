@@ -0,0 +1,79 @@
+package extension
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/extensionmgr"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+// TestMain lets the test binary act as the sandbox helper for extensions
+// that declare permissions, as the sley binary does.
+func TestMain(m *testing.M) {
+	if extensionmgr.IsSandboxHelper() {
+		extensionmgr.RunSandboxHelper()
+	}
+	os.Exit(m.Run())
+}
+
+/* ------------------------------------------------------------------------- */
+/* EXTENSION TEST COMMAND                                                    */
+/* ------------------------------------------------------------------------- */
+
+func TestExtensionTestCmd_Success(t *testing.T) {
+	tmpDir := t.TempDir()
+	extDir, _, err := extensionmgr.Scaffold(extensionmgr.ScaffoldOptions{Name: "demo", Lang: "sh", Hooks: []string{"post-bump"}, Dir: tmpDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appCli := testutils.BuildCLIForTests(filepath.Join(tmpDir, ".version"), []*cli.Command{Run()})
+	output, _ := testutils.CaptureStdout(func() {
+		testutils.RunCLITest(t, appCli, []string{
+			"sley", "extension", "test", extDir, "--hook", "post-bump", "--version", "1.2.3",
+		}, tmpDir)
+	})
+
+	if !strings.Contains(output, `"success": true`) || !strings.Contains(output, "demo: post-bump for 1.2.3") {
+		t.Errorf("expected hook output, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"previous_version": "1.2.2"`) {
+		t.Errorf("expected derived previous version in output, got:\n%s", output)
+	}
+}
+
+func TestExtensionTestCmd_Errors(t *testing.T) {
+	tmpDir := t.TempDir()
+	extDir, _, err := extensionmgr.Scaffold(extensionmgr.ScaffoldOptions{Name: "demo", Lang: "sh", Hooks: []string{"pre-bump"}, Dir: tmpDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing path", []string{"sley", "extension", "test"}, "missing extension path"},
+		{"undeclared hook", []string{"sley", "extension", "test", extDir, "--hook", "post-bump"}, "does not declare"},
+		{"invalid hook", []string{"sley", "extension", "test", extDir, "--hook", "nope"}, "invalid hook type"},
+		{"missing manifest", []string{"sley", "extension", "test", tmpDir}, "manifest not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appCli := testutils.BuildCLIForTests(filepath.Join(tmpDir, ".version"), []*cli.Command{Run()})
+			var runErr error
+			_, _ = testutils.CaptureStdout(func() {
+				runErr = testutils.RunCLITestAllowError(t, appCli, tt.args, tmpDir)
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, runErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package history provides the "sley history" command, which queries the
+// version history recorded by the audit-log plugin and verifies its hash chain.
+package history
@@ -0,0 +1,247 @@
+package history
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins/auditlog"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/urfave/cli/v3"
+)
+
+// Run returns the "history" command.
+func Run(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "history",
+		Usage:     "Query the version history recorded by the audit-log plugin",
+		UsageText: "sley history [--module name] [--since date] [--author name] [--bump-type type] [--format table|json|csv]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "module",
+				Usage: "Only show entries for the named module",
+			},
+			&cli.StringFlag{
+				Name:  "since",
+				Usage: "Only show entries recorded on or after this date (YYYY-MM-DD or RFC 3339)",
+			},
+			&cli.StringFlag{
+				Name:  "author",
+				Usage: "Only show entries whose author contains this text (case-insensitive)",
+			},
+			&cli.StringFlag{
+				Name:  "bump-type",
+				Usage: "Only show entries with this bump type (e.g. major, minor, patch, auto)",
+			},
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Output format: table, json, or csv",
+				Value: "table",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runHistoryCmd(cmd, cfg)
+		},
+		Commands: []*cli.Command{
+			verifyCmd(cfg),
+		},
+	}
+}
+
+// verifyCmd returns the "history verify" subcommand.
+func verifyCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "verify",
+		Usage:     "Verify the hash chain of the history file to detect manual edits",
+		UsageText: "sley history verify",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runVerifyCmd(cfg)
+		},
+	}
+}
+
+// filter holds the entry filters given on the command line.
+type filter struct {
+	module   string
+	since    time.Time
+	author   string
+	bumpType string
+}
+
+// runHistoryCmd prints the filtered history entries.
+func runHistoryCmd(cmd *cli.Command, cfg *config.Config) error {
+	format := cmd.String("format")
+	if format != "table" && format != "json" && format != "csv" {
+		return fmt.Errorf("invalid format %q: must be table, json, or csv", format)
+	}
+
+	f := filter{
+		module:   cmd.String("module"),
+		author:   cmd.String("author"),
+		bumpType: cmd.String("bump-type"),
+	}
+	if since := cmd.String("since"); since != "" {
+		t, err := parseSince(since)
+		if err != nil {
+			return err
+		}
+		f.since = t
+	}
+
+	logFile, err := readHistory(cfg)
+	if err != nil {
+		return err
+	}
+	entries := filterEntries(logFile.Entries, f)
+
+	switch format {
+	case "json":
+		return writeJSON(os.Stdout, entries)
+	case "csv":
+		return writeCSV(os.Stdout, entries)
+	default:
+		if len(entries) == 0 {
+			printer.PrintFaint("No history entries found")
+			return nil
+		}
+		writeTable(os.Stdout, entries)
+		return nil
+	}
+}
+
+// runVerifyCmd checks the hash chain of the history file.
+func runVerifyCmd(cfg *config.Config) error {
+	logFile, err := readHistory(cfg)
+	if err != nil {
+		return err
+	}
+
+	hashed := 0
+	for _, e := range logFile.Entries {
+		if e.Hash != "" {
+			hashed++
+		}
+	}
+	if hashed == 0 {
+		printer.PrintWarning("No hashed entries to verify. Enable 'hash-chain' in the audit-log plugin configuration.")
+		return nil
+	}
+
+	issues, err := auditlog.VerifyChain(logFile.Entries)
+	if err != nil {
+		return err
+	}
+
+	if len(issues) == 0 {
+		printer.PrintSuccess(fmt.Sprintf("History hash chain is intact (%d hashed entries)", hashed))
+		return nil
+	}
+
+	for _, issue := range issues {
+		fmt.Printf("%s entry #%d (%s -> %s, %s): %s\n",
+			printer.Error("✗"), issue.Index+1, issue.Entry.PreviousVersion, issue.Entry.NewVersion,
+			valueOr(issue.Entry.Timestamp, "no timestamp"), issue.Reason)
+	}
+	return fmt.Errorf("history verification failed: %d issue(s) found", len(issues))
+}
+
+// readHistory reads the history file configured for the audit-log plugin.
+func readHistory(cfg *config.Config) (*auditlog.AuditLogFile, error) {
+	var alCfg *config.AuditLogConfig
+	if cfg != nil && cfg.Plugins != nil {
+		alCfg = cfg.Plugins.AuditLog
+	}
+	path, format := auditlog.FromConfigStruct(alCfg).HistorySource()
+	return auditlog.ReadLogFile(path, format)
+}
+
+// parseSince parses a date or an RFC 3339 timestamp.
+func parseSince(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since value %q: use YYYY-MM-DD or RFC 3339", value)
+}
+
+// filterEntries returns the entries matching every filter, keeping their order.
+func filterEntries(entries []auditlog.Entry, f filter) []auditlog.Entry {
+	result := make([]auditlog.Entry, 0, len(entries))
+	for _, e := range entries {
+		if f.module != "" && e.Module != f.module {
+			continue
+		}
+		if f.bumpType != "" && e.BumpType != f.bumpType {
+			continue
+		}
+		if f.author != "" && !strings.Contains(strings.ToLower(e.Author), strings.ToLower(f.author)) {
+			continue
+		}
+		if !f.since.IsZero() {
+			ts, err := time.Parse(time.RFC3339, e.Timestamp)
+			if err != nil || ts.Before(f.since) {
+				continue
+			}
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func writeJSON(w io.Writer, entries []auditlog.Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode history: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// csvHeader lists the CSV columns, matching the entry JSON keys.
+var csvHeader = []string{
+	"timestamp", "module", "previous_version", "new_version", "bump_type", "tag",
+	"author", "commit_sha", "branch", "hooks", "extensions",
+}
+
+func writeCSV(w io.Writer, entries []auditlog.Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		record := []string{
+			e.Timestamp, e.Module, e.PreviousVersion, e.NewVersion, e.BumpType, e.Tag,
+			e.Author, e.CommitSHA, e.Branch, strings.Join(e.Hooks, ";"), strings.Join(e.Extensions, ";"),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeTable(w io.Writer, entries []auditlog.Entry) {
+	fmt.Fprintf(w, "%-22s %-15s %-12s %-12s %-8s %-15s %s\n", "TIMESTAMP", "MODULE", "PREVIOUS", "NEW", "TYPE", "TAG", "AUTHOR")
+	fmt.Fprintln(w, printer.Typography().HR())
+	for _, e := range entries {
+		fmt.Fprintf(w, "%-22s %-15s %-12s %-12s %-8s %-15s %s\n",
+			valueOr(e.Timestamp, "-"), valueOr(e.Module, "-"), e.PreviousVersion, e.NewVersion,
+			e.BumpType, valueOr(e.Tag, "-"), valueOr(e.Author, "-"))
+	}
+}
+
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
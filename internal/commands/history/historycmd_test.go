@@ -0,0 +1,202 @@
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins/auditlog"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+// sampleEntries are ordered newest first, as in the history file.
+var sampleEntries = []auditlog.Entry{
+	{Timestamp: "2026-03-01T10:00:00Z", PreviousVersion: "1.1.0", NewVersion: "2.0.0", BumpType: "major", Author: "Jane Doe <jane@example.com>", Module: "api", Tag: "api/v2.0.0"},
+	{Timestamp: "2026-02-01T10:00:00Z", PreviousVersion: "0.3.0", NewVersion: "0.3.1", BumpType: "patch", Author: "John Roe <john@example.com>", Module: "web", Hooks: []string{"post-bump"}, Extensions: []string{"notify", "docker"}},
+	{Timestamp: "2026-01-01T10:00:00Z", PreviousVersion: "1.0.0", NewVersion: "1.1.0", BumpType: "minor", Author: "Jane Doe <jane@example.com>", Module: "api"},
+}
+
+func writeHistory(t *testing.T, dir string, entries []auditlog.Entry) *config.Config {
+	t.Helper()
+	data, err := json.Marshal(auditlog.AuditLogFile{Entries: entries})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, ".version-history.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return &config.Config{
+		Path:    filepath.Join(dir, ".version"),
+		Plugins: &config.PluginConfig{AuditLog: &config.AuditLogConfig{Enabled: true, Path: path}},
+	}
+}
+
+func runHistory(t *testing.T, cfg *config.Config, dir string, args ...string) (string, error) {
+	t.Helper()
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	var runErr error
+	output, err := testutils.CaptureStdout(func() {
+		runErr = testutils.RunCLITestAllowError(t, appCli, append([]string{"sley", "history"}, args...), dir)
+	})
+	if err != nil {
+		t.Fatalf("failed to capture stdout: %v", err)
+	}
+	return output, runErr
+}
+
+func TestHistoryCmd_Table(t *testing.T) {
+	dir := t.TempDir()
+	cfg := writeHistory(t, dir, sampleEntries)
+
+	output, err := runHistory(t, cfg, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"TIMESTAMP", "api/v2.0.0", "0.3.1", "John Roe"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestHistoryCmd_Filters(t *testing.T) {
+	dir := t.TempDir()
+	cfg := writeHistory(t, dir, sampleEntries)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"module", []string{"--module", "api"}, []string{"2.0.0", "1.1.0"}},
+		{"author", []string{"--author", "JOHN"}, []string{"0.3.1"}},
+		{"bump type", []string{"--bump-type", "minor"}, []string{"1.1.0"}},
+		{"since date", []string{"--since", "2026-02-01"}, []string{"2.0.0", "0.3.1"}},
+		{"since timestamp", []string{"--since", "2026-02-15T00:00:00Z"}, []string{"2.0.0"}},
+		{"combined", []string{"--module", "api", "--since", "2026-02-01"}, []string{"2.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := runHistory(t, cfg, dir, append(tt.args, "--format", "json")...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var entries []auditlog.Entry
+			if err := json.Unmarshal([]byte(output), &entries); err != nil {
+				t.Fatalf("invalid JSON output: %v\n%s", err, output)
+			}
+			var got []string
+			for _, e := range entries {
+				got = append(got, e.NewVersion)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got versions %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistoryCmd_CSV(t *testing.T) {
+	dir := t.TempDir()
+	cfg := writeHistory(t, dir, sampleEntries)
+
+	output, err := runHistory(t, cfg, dir, "--format", "csv", "--module", "web")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got:\n%s", output)
+	}
+	if !strings.HasPrefix(lines[0], "timestamp,module,previous_version") {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "post-bump,notify;docker") {
+		t.Errorf("expected hooks and extensions columns, got %q", lines[1])
+	}
+}
+
+func TestHistoryCmd_InvalidInput(t *testing.T) {
+	dir := t.TempDir()
+	cfg := writeHistory(t, dir, sampleEntries)
+
+	if _, err := runHistory(t, cfg, dir, "--format", "xml"); err == nil || !strings.Contains(err.Error(), "invalid format") {
+		t.Errorf("expected invalid format error, got %v", err)
+	}
+	if _, err := runHistory(t, cfg, dir, "--since", "yesterday"); err == nil || !strings.Contains(err.Error(), "invalid --since") {
+		t.Errorf("expected invalid --since error, got %v", err)
+	}
+}
+
+func TestHistoryCmd_MissingFile(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{Path: filepath.Join(dir, ".version")}
+
+	output, err := runHistory(t, cfg, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "No history entries found") {
+		t.Errorf("expected empty history message, got %q", output)
+	}
+}
+
+func TestHistoryVerifyCmd(t *testing.T) {
+	dir := t.TempDir()
+
+	// Build a valid chain, newest first.
+	var chained []auditlog.Entry
+	for i := len(sampleEntries) - 1; i >= 0; i-- {
+		entry := sampleEntries[i]
+		if len(chained) > 0 {
+			entry.PrevHash = chained[0].Hash
+		}
+		hash, err := auditlog.ComputeHash(entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry.Hash = hash
+		chained = append([]auditlog.Entry{entry}, chained...)
+	}
+
+	cfg := writeHistory(t, dir, chained)
+	output, err := runHistory(t, cfg, dir, "verify")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "intact") {
+		t.Errorf("expected intact chain, got %q", output)
+	}
+
+	chained[1].Author = "Mallory"
+	cfg = writeHistory(t, dir, chained)
+	output, err = runHistory(t, cfg, dir, "verify")
+	if err == nil || !strings.Contains(err.Error(), "verification failed") {
+		t.Fatalf("expected verification error, got %v", err)
+	}
+	if !strings.Contains(output, "entry #2") {
+		t.Errorf("expected the modified entry to be reported, got %q", output)
+	}
+}
+
+func TestHistoryVerifyCmd_NoHashes(t *testing.T) {
+	dir := t.TempDir()
+	cfg := writeHistory(t, dir, sampleEntries)
+
+	output, err := runHistory(t, cfg, dir, "verify")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "hash-chain") {
+		t.Errorf("expected hint to enable hash-chain, got %q", output)
+	}
+}
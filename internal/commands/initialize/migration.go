@@ -9,6 +9,7 @@ import (
 
 	"github.com/goccy/go-yaml"
 	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
 )
 
 // VersionSource represents a detected version from an existing file.
@@ -44,10 +45,10 @@ func DetectExistingVersions() []VersionSource {
 
 	for _, d := range detectors {
 		if version, err := d.detector(d.file); err == nil && version != "" {
-			if isValidSemver(version) {
+			if normalized, ok := normalizeVersion(version); ok {
 				sources = append(sources, VersionSource{
 					File:    d.file,
-					Version: version,
+					Version: normalized,
 					Format:  d.format,
 				})
 			}
@@ -150,14 +151,15 @@ func detectPlainTextVersion(path string) (string, error) {
 	return version, nil
 }
 
-// isValidSemver performs a basic check if the string looks like a semver version.
-func isValidSemver(version string) bool {
-	// Remove 'v' prefix if present
-	version = strings.TrimPrefix(version, "v")
-
-	// Basic semver pattern: x.y.z with optional pre-release and build metadata
-	re := regexp.MustCompile(`^\d+\.\d+\.\d+(-[a-zA-Z0-9.-]+)?(\+[a-zA-Z0-9.-]+)?$`)
-	return re.MatchString(version)
+// normalizeVersion converts a version found in another ecosystem's manifest
+// to SemVer, e.g. "v1.2" becomes "1.2.0" and "1.2.3.4" becomes "1.2.3+4".
+// Returns false if the string cannot be read as a version.
+func normalizeVersion(version string) (string, bool) {
+	v, err := semver.ParseLenient(version)
+	if err != nil {
+		return "", false
+	}
+	return v.String(), true
 }
 
 // GetBestVersionSource returns the most appropriate version source.
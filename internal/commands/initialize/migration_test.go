@@ -253,31 +253,32 @@ func TestDetectPlainTextVersion(t *testing.T) {
 	}
 }
 
-func TestIsValidSemver(t *testing.T) {
+func TestNormalizeVersion(t *testing.T) {
 
 	tests := []struct {
 		version string
+		want    string
 		valid   bool
 	}{
-		{"1.0.0", true},
-		{"0.1.0", true},
-		{"1.2.3", true},
-		{"1.0.0-alpha", true},
-		{"1.0.0-beta.1", true},
-		{"1.0.0-rc.1+build.123", true},
-		{"v1.0.0", true},
-		{"1.0", false},
-		{"1", false},
-		{"invalid", false},
-		{"1.0.0.0", false},
+		{"1.0.0", "1.0.0", true},
+		{"0.1.0", "0.1.0", true},
+		{"1.0.0-beta.1", "1.0.0-beta.1", true},
+		{"1.0.0-rc.1+build.123", "1.0.0-rc.1+build.123", true},
+		{"v1.0.0", "1.0.0", true},
+		{"v1.2", "1.2.0", true},
+		{"1.0", "1.0.0", true},
+		{"1.2.3.4", "1.2.3+4", true},
+		{"1.0.0.0", "1.0.0", true},
+		{"invalid", "", false},
+		{"1.2.3rc1", "", false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.version, func(t *testing.T) {
 
-			got := isValidSemver(tt.version)
-			if got != tt.valid {
-				t.Errorf("isValidSemver(%q): expected %v, got %v", tt.version, tt.valid, got)
+			got, ok := normalizeVersion(tt.version)
+			if ok != tt.valid || got != tt.want {
+				t.Errorf("normalizeVersion(%q) = (%q, %v), want (%q, %v)", tt.version, got, ok, tt.want, tt.valid)
 			}
 		})
 	}
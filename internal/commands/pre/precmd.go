@@ -49,6 +49,7 @@ func Run(cfg *config.Config, registry *plugins.PluginRegistry) *cli.Command {
 func runPreCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config, registry *plugins.PluginRegistry) error {
 	label := cmd.String("label")
 	isInc := cmd.Bool("inc")
+	meta := clix.ResolveMetadata(cfg, "", false)
 
 	// Get execution context to determine single vs multi-module mode
 	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
@@ -58,15 +59,16 @@ func runPreCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config, regist
 
 	// Handle single-module mode
 	if execCtx.IsSingleModule() {
-		return runSingleModulePre(execCtx.Path, label, isInc, registry)
+		return runSingleModulePre(execCtx.Path, label, meta, isInc, registry)
 	}
 
 	// Handle multi-module mode
-	return runMultiModulePre(ctx, cmd, execCtx, label, isInc, registry)
+	return runMultiModulePre(ctx, cmd, execCtx, label, meta, isInc, registry)
 }
 
 // runSingleModulePre handles the single-module pre-release operation.
-func runSingleModulePre(path, label string, isInc bool, registry *plugins.PluginRegistry) error {
+// meta replaces the build metadata when non-empty.
+func runSingleModulePre(path, label, meta string, isInc bool, registry *plugins.PluginRegistry) error {
 	// Auto-initialize if file doesn't exist
 	var version semver.SemVersion
 	version, err := semver.ReadVersion(path)
@@ -86,14 +88,26 @@ func runSingleModulePre(path, label string, isInc bool, registry *plugins.Plugin
 	oldVersion := version.String()
 
 	if isInc {
-		version.PreRelease = semver.IncrementPreRelease(version.PreRelease, label)
+		if version.PreRelease, err = semver.IncrementPreRelease(version.PreRelease, label); err != nil {
+			return err
+		}
 	} else {
 		if version.PreRelease == "" {
-			version.Patch++
+			bumped, err := semver.BumpByLabel(version, "patch")
+			if err != nil {
+				return err
+			}
+			version.Patch = bumped.Patch
 		}
 		version.PreRelease = label
 	}
+	if meta != "" {
+		version.Build = meta
+	}
 
+	if err := semver.CheckRecordable(version); err != nil {
+		return err
+	}
 	if err := semver.SaveVersion(path, version); err != nil {
 		return fmt.Errorf("failed to save version: %w", err)
 	}
@@ -109,9 +123,9 @@ func runSingleModulePre(path, label string, isInc bool, registry *plugins.Plugin
 }
 
 // runMultiModulePre handles the multi-module pre-release operation.
-func runMultiModulePre(ctx context.Context, cmd *cli.Command, execCtx *clix.ExecutionContext, label string, isInc bool, registry *plugins.PluginRegistry) error {
+func runMultiModulePre(ctx context.Context, cmd *cli.Command, execCtx *clix.ExecutionContext, label, meta string, isInc bool, registry *plugins.PluginRegistry) error {
 	fs := core.NewOSFileSystem()
-	operation := operations.NewPreOperation(fs, label, isInc)
+	operation := operations.NewPreOperation(fs, label, isInc).WithMetadata(meta)
 
 	// Create executor with options from flags
 	parallel := cmd.Bool("parallel")
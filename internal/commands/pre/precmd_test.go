@@ -724,3 +724,18 @@ func TestPrintQuietSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestCLI_PreCommand_GitTagSource(t *testing.T) {
+	restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(
+		semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.2.3"}})))
+	defer restore()
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{Path: filepath.Join(tmpDir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg, plugins.NewPluginRegistry())})
+
+	err := testutils.RunCLITestAllowError(t, appCli, []string{"sley", "pre", "--label", "rc.1"}, tmpDir)
+	if err == nil || !strings.Contains(err.Error(), "cannot record version 1.2.4-rc.1: source is git-tags") {
+		t.Fatalf("expected git-tags error, got %v", err)
+	}
+}
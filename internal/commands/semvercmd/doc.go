@@ -0,0 +1,3 @@
+// Package semvercmd provides the "sley semver" command, which compares,
+// matches, computes and formats semantic versions for use in scripts.
+package semvercmd
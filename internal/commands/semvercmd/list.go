@@ -0,0 +1,158 @@
+package semvercmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/indaco/sley/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// listFlags are the flags shared by "semver sort" and "semver max".
+func listFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "skip-invalid",
+			Usage: "Ignore inputs that are not semantic versions (e.g. non-version tags)",
+		},
+		formatFlag(),
+	}
+}
+
+// sortCmd returns the "semver sort" subcommand.
+func sortCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "sort",
+		Usage:     "Sort versions by precedence (reads stdin when no versions are given)",
+		UsageText: "sley semver sort [versions...] [--reverse] [--skip-invalid] [--format template]",
+		Flags: append(listFlags(),
+			&cli.BoolFlag{
+				Name:    "reverse",
+				Aliases: []string{"r"},
+				Usage:   "Sort from highest to lowest",
+			},
+		),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runSortCmd(cmd, os.Stdin)
+		},
+	}
+}
+
+// maxCmd returns the "semver max" subcommand.
+func maxCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "max",
+		Usage:     "Print the highest version, optionally within a range (reads stdin when no versions are given)",
+		UsageText: "sley semver max [versions...] [--satisfying range] [--skip-invalid] [--format template]",
+		Flags: append(listFlags(),
+			&cli.StringFlag{
+				Name:  "satisfying",
+				Usage: "Only consider versions matching this range, e.g. '~1.4'",
+			},
+		),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runMaxCmd(cmd, os.Stdin)
+		},
+	}
+}
+
+// listedVersion is an input version and the text it was given as.
+type listedVersion struct {
+	raw     string
+	version semver.SemVersion
+}
+
+// runSortCmd prints the input versions ordered by precedence.
+func runSortCmd(cmd *cli.Command, stdin io.Reader) error {
+	versions, err := readVersionList(cmd, stdin)
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(versions, func(a, b listedVersion) int {
+		return a.version.Compare(b.version)
+	})
+	if cmd.Bool("reverse") {
+		slices.Reverse(versions)
+	}
+
+	for _, v := range versions {
+		fmt.Println(printListed(v, cmd.String("format")))
+	}
+	return nil
+}
+
+// runMaxCmd prints the highest input version, optionally within a range.
+func runMaxCmd(cmd *cli.Command, stdin io.Reader) error {
+	versions, err := readVersionList(cmd, stdin)
+	if err != nil {
+		return err
+	}
+
+	var constraint *semver.Constraint
+	if expr := cmd.String("satisfying"); expr != "" {
+		if constraint, err = semver.ParseConstraint(expr); err != nil {
+			return err
+		}
+	}
+
+	var best *listedVersion
+	for i, v := range versions {
+		if constraint != nil && !constraint.Check(v.version) {
+			continue
+		}
+		if best == nil || v.version.Compare(best.version) > 0 {
+			best = &versions[i]
+		}
+	}
+	if best == nil {
+		return cli.Exit("no matching version", 1)
+	}
+
+	fmt.Println(printListed(*best, cmd.String("format")))
+	return nil
+}
+
+// readVersionList parses the versions given as arguments, or one per line on
+// stdin when there are none.
+func readVersionList(cmd *cli.Command, stdin io.Reader) ([]listedVersion, error) {
+	inputs := cmd.Args().Slice()
+	if len(inputs) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				inputs = append(inputs, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read versions from stdin: %w", err)
+		}
+	}
+
+	versions := make([]listedVersion, 0, len(inputs))
+	for _, raw := range inputs {
+		version, err := parseArg(raw)
+		if err != nil {
+			if cmd.Bool("skip-invalid") {
+				continue
+			}
+			return nil, err
+		}
+		versions = append(versions, listedVersion{raw: raw, version: version})
+	}
+	return versions, nil
+}
+
+// printListed returns a version as it was given, or rendered through the
+// format template when one is set.
+func printListed(v listedVersion, template string) string {
+	if template == "" {
+		return v.raw
+	}
+	return formatVersion(v.version, template)
+}
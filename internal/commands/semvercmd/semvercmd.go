@@ -0,0 +1,247 @@
+package semvercmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/urfave/cli/v3"
+)
+
+// formatFlag is the --format flag shared by the subcommands that print versions.
+func formatFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "format",
+		Usage: "Output template, e.g. '{major}.{minor}' (placeholders: {version}, {major}, {minor}, {patch}, {prerelease}, {build})",
+	}
+}
+
+// Run returns the "semver" command.
+func Run(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "semver",
+		Usage:     "Compare, match, compute and format semantic versions",
+		UsageText: "sley semver <subcommand> [arguments]",
+		Description: `Query and compute semantic versions from scripts. Versions may carry a
+"v" prefix. Subcommands that take an optional version default to the
+current version.`,
+		Commands: []*cli.Command{
+			compareCmd(),
+			satisfiesCmd(),
+			nextCmd(cfg),
+			formatCmd(cfg),
+			sortCmd(),
+			maxCmd(),
+		},
+	}
+}
+
+// compareCmd returns the "semver compare" subcommand.
+func compareCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "compare",
+		Usage:     "Print -1, 0 or 1 as the first version is lower, equal or greater",
+		UsageText: "sley semver compare <version-a> <version-b>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runCompareCmd(cmd)
+		},
+	}
+}
+
+// satisfiesCmd returns the "semver satisfies" subcommand.
+func satisfiesCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "satisfies",
+		Usage:     "Check a version against a range such as '^1.3' (exits 1 when it does not match)",
+		UsageText: "sley semver satisfies <version> <range> [--include-prerelease]",
+		Description: `Ranges follow npm syntax: primitives (>=1.2.3), x-ranges (1.x, 1.2.*),
+tilde (~1.2.3), caret (^1.2.3), hyphen ranges (1.2.3 - 2.0) and
+unions with ||. Pre-releases only match ranges that mention a
+pre-release of the same major.minor.patch unless --include-prerelease is set.`,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "include-prerelease",
+				Usage: "Let pre-release versions match any range they fall in",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runSatisfiesCmd(cmd)
+		},
+	}
+}
+
+// nextCmd returns the "semver next" subcommand.
+func nextCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "next",
+		Usage:     "Print the next major, minor, patch, release, pre or auto version",
+		UsageText: "sley semver next <major|minor|patch|release|pre|auto> [version] [--label name] [--format template]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "label",
+				Usage: "Pre-release label for 'pre' (e.g. rc)",
+			},
+			formatFlag(),
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runNextCmd(cmd, cfg)
+		},
+	}
+}
+
+// formatCmd returns the "semver format" subcommand.
+func formatCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "format",
+		Usage:     "Print a version through a template, e.g. only major.minor",
+		UsageText: "sley semver format [version] --format '{major}.{minor}'",
+		Flags: []cli.Flag{
+			formatFlag(),
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runFormatCmd(cmd, cfg)
+		},
+	}
+}
+
+// runCompareCmd prints the comparison of two versions.
+func runCompareCmd(cmd *cli.Command) error {
+	if cmd.NArg() != 2 {
+		return cli.Exit("semver compare requires two versions", 1)
+	}
+	a, err := parseArg(cmd.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	b, err := parseArg(cmd.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(a.Compare(b))
+	return nil
+}
+
+// runSatisfiesCmd prints whether a version satisfies a range.
+func runSatisfiesCmd(cmd *cli.Command) error {
+	if cmd.NArg() != 2 {
+		return cli.Exit("semver satisfies requires a version and a range", 1)
+	}
+	version, err := parseArg(cmd.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	constraint, err := semver.ParseConstraint(cmd.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	constraint.IncludePrerelease = cmd.Bool("include-prerelease")
+
+	if !constraint.Check(version) {
+		fmt.Println("false")
+		return cli.Exit("", 1)
+	}
+	fmt.Println("true")
+	return nil
+}
+
+// runNextCmd prints the next version for a bump type.
+func runNextCmd(cmd *cli.Command, cfg *config.Config) error {
+	if cmd.NArg() < 1 {
+		return cli.Exit("semver next requires a bump type (major, minor, patch, release, pre or auto)", 1)
+	}
+	current, err := versionArg(cmd, 1, cfg)
+	if err != nil {
+		return err
+	}
+
+	next, err := nextVersion(current, cmd.Args().Get(0), cmd.String("label"))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(formatVersion(next, cmd.String("format")))
+	return nil
+}
+
+// runFormatCmd prints a version through the --format template.
+func runFormatCmd(cmd *cli.Command, cfg *config.Config) error {
+	version, err := versionArg(cmd, 0, cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(formatVersion(version, cmd.String("format")))
+	return nil
+}
+
+// nextVersion computes the version following current for a bump type.
+// For "pre", a stable version gets its patch bumped before the label is added.
+func nextVersion(current semver.SemVersion, bumpType, label string) (semver.SemVersion, error) {
+	switch bumpType {
+	case "release":
+		return semver.SemVersion{Major: current.Major, Minor: current.Minor, Patch: current.Patch}, nil
+	case "auto":
+		return semver.BumpNext(current)
+	case "pre":
+		next := semver.SemVersion{Major: current.Major, Minor: current.Minor, Patch: current.Patch}
+		var err error
+		switch {
+		case current.PreRelease == "" && label == "":
+			return semver.SemVersion{}, fmt.Errorf("current version has no pre-release; use --label to specify one")
+		case current.PreRelease == "":
+			if next, err = semver.BumpByLabel(current, "patch"); err != nil {
+				return semver.SemVersion{}, err
+			}
+			next.PreRelease, err = semver.IncrementPreRelease("", label)
+		case label == "":
+			next.PreRelease, err = semver.IncrementPreRelease(current.PreRelease, semver.ExtractPreReleaseBase(current.PreRelease))
+		default:
+			next.PreRelease, err = semver.IncrementPreRelease(current.PreRelease, label)
+		}
+		if err != nil {
+			return semver.SemVersion{}, err
+		}
+		return next, nil
+	default:
+		return semver.BumpByLabel(current, bumpType)
+	}
+}
+
+// versionArg parses the optional version argument at index i, falling back
+// to the current version read from --path.
+func versionArg(cmd *cli.Command, i int, cfg *config.Config) (semver.SemVersion, error) {
+	if cmd.NArg() > i {
+		return parseArg(cmd.Args().Get(i))
+	}
+
+	path := cmd.String("path")
+	if path == "" {
+		path = cfg.Path
+	}
+	version, err := semver.ReadVersion(path)
+	if err != nil {
+		return semver.SemVersion{}, fmt.Errorf("failed to read version file at %s: %w", path, err)
+	}
+	return version, nil
+}
+
+// parseArg parses a version argument, naming it in the error.
+func parseArg(raw string) (semver.SemVersion, error) {
+	version, err := semver.ParseVersion(raw)
+	if err != nil {
+		return semver.SemVersion{}, fmt.Errorf("invalid version %q: %w", raw, err)
+	}
+	return version, nil
+}
+
+// formatVersion renders a version through a template using the tag message
+// placeholders, or as a plain version string when the template is empty.
+func formatVersion(version semver.SemVersion, template string) string {
+	if template == "" {
+		return version.String()
+	}
+	return tagmanager.FormatMessage(template, tagmanager.NewTemplateData(version, "", ""))
+}
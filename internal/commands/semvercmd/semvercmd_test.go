@@ -0,0 +1,230 @@
+package semvercmd
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/urfave/cli/v3"
+)
+
+// runSemver runs "sley semver <args>" and returns its trimmed stdout and the
+// error passed to the exit handler or returned by Run.
+func runSemver(t *testing.T, cfg *config.Config, args ...string) (string, error) {
+	t.Helper()
+
+	var runErr error
+	app := &cli.Command{
+		Name:      "sley",
+		Writer:    io.Discard,
+		ErrWriter: io.Discard,
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "path", Value: cfg.Path},
+		},
+		ExitErrHandler: func(_ context.Context, _ *cli.Command, err error) {
+			runErr = err
+		},
+		Commands: []*cli.Command{Run(cfg)},
+	}
+
+	output, _ := testutils.CaptureStdout(func() {
+		if err := app.Run(context.Background(), append([]string{"sley", "semver"}, args...)); err != nil {
+			runErr = err
+		}
+	})
+	return output, runErr
+}
+
+func TestRunCommand(t *testing.T) {
+	cmd := Run(&config.Config{})
+
+	var names []string
+	for _, sub := range cmd.Commands {
+		names = append(names, sub.Name)
+	}
+	if got := strings.Join(names, ","); got != "compare,satisfies,next,format,sort,max" {
+		t.Errorf("subcommands = %s", got)
+	}
+}
+
+func TestRunCompareCmd(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"1.2.3", "1.10.0", "-1"},
+		{"v2.0.0", "2.0.0+build", "0"},
+		{"1.0.0", "1.0.0-rc.1", "1"},
+	}
+	for _, tt := range tests {
+		output, err := runSemver(t, &config.Config{}, "compare", tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("compare %s %s: unexpected error: %v", tt.a, tt.b, err)
+		}
+		if output != tt.want {
+			t.Errorf("compare %s %s = %q, want %q", tt.a, tt.b, output, tt.want)
+		}
+	}
+
+	if _, err := runSemver(t, &config.Config{}, "compare", "1.0.0"); err == nil {
+		t.Error("expected error for a single version")
+	}
+	if _, err := runSemver(t, &config.Config{}, "compare", "1.0", "1.0.0"); err == nil {
+		t.Error("expected error for an invalid version")
+	}
+}
+
+func TestRunSatisfiesCmd(t *testing.T) {
+	output, err := runSemver(t, &config.Config{}, "satisfies", "1.4.2", "^1.3")
+	if err != nil || output != "true" {
+		t.Errorf("satisfies 1.4.2 ^1.3 = %q, %v; want true", output, err)
+	}
+
+	output, err = runSemver(t, &config.Config{}, "satisfies", "2.0.0", "^1.3")
+	if err == nil || output != "false" {
+		t.Errorf("satisfies 2.0.0 ^1.3 = %q, %v; want false with exit error", output, err)
+	}
+
+	output, err = runSemver(t, &config.Config{}, "satisfies", "--include-prerelease", "1.4.0-rc.1", "^1.3")
+	if err != nil || output != "true" {
+		t.Errorf("satisfies --include-prerelease = %q, %v; want true", output, err)
+	}
+
+	if _, err := runSemver(t, &config.Config{}, "satisfies", "1.0.0", "^x.y"); err == nil {
+		t.Error("expected error for an invalid range")
+	}
+}
+
+func TestRunNextCmd(t *testing.T) {
+	tmp := t.TempDir()
+	cfg := &config.Config{Path: testutils.WriteTempVersionFile(t, tmp, "1.4.2")}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"next", "minor"}, "1.5.0"},
+		{[]string{"next", "major", "0.9.1"}, "1.0.0"},
+		{[]string{"next", "patch", "1.2.3-rc.1"}, "1.2.4"},
+		{[]string{"next", "release", "1.2.3-rc.1+b"}, "1.2.3"},
+		{[]string{"next", "auto", "1.2.3-rc.1"}, "1.2.3"},
+		{[]string{"next", "pre", "--label", "rc"}, "1.4.3-rc.1"},
+		{[]string{"next", "pre", "1.5.0-beta.2"}, "1.5.0-beta.3"},
+		{[]string{"next", "pre", "--label", "rc", "1.5.0-beta.2"}, "1.5.0-rc.1"},
+		{[]string{"next", "minor", "--format", "{major}.{minor}"}, "1.5"},
+	}
+	for _, tt := range tests {
+		output, err := runSemver(t, cfg, tt.args...)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.args, err)
+		}
+		if output != tt.want {
+			t.Errorf("%v = %q, want %q", tt.args, output, tt.want)
+		}
+	}
+
+	for _, args := range [][]string{{"next"}, {"next", "build"}, {"next", "pre"}} {
+		if _, err := runSemver(t, cfg, args...); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
+
+func TestRunFormatCmd(t *testing.T) {
+	tmp := t.TempDir()
+	cfg := &config.Config{Path: testutils.WriteTempVersionFile(t, tmp, "1.4.2-rc.1+sha.abc")}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"format", "--format", "{major}.{minor}"}, "1.4"},
+		{[]string{"format", "--format", "{prerelease}|{build}"}, "rc.1|sha.abc"},
+		{[]string{"format", "v2.0.1", "--format", "v{major}"}, "v2"},
+		{[]string{"format", "v2.0.1"}, "2.0.1"},
+	}
+	for _, tt := range tests {
+		output, err := runSemver(t, cfg, tt.args...)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.args, err)
+		}
+		if output != tt.want {
+			t.Errorf("%v = %q, want %q", tt.args, output, tt.want)
+		}
+	}
+
+	if _, err := runSemver(t, &config.Config{Path: tmp + "/missing/.version"}, "format"); err == nil {
+		t.Error("expected error for a missing version file")
+	}
+}
+
+func TestRunSortCmd(t *testing.T) {
+	output, err := runSemver(t, &config.Config{}, "sort", "1.10.0", "v1.2.0", "1.2.0-rc.1", "1.9.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "1.2.0-rc.1\nv1.2.0\n1.9.3\n1.10.0" {
+		t.Errorf("sort = %q", output)
+	}
+
+	output, err = runSemver(t, &config.Config{}, "sort", "--reverse", "--format", "{major}.{minor}", "1.2.0", "1.10.0")
+	if err != nil || output != "1.10\n1.2" {
+		t.Errorf("sort --reverse --format = %q, %v", output, err)
+	}
+
+	if _, err := runSemver(t, &config.Config{}, "sort", "1.2.0", "latest"); err == nil {
+		t.Error("expected error for an invalid version")
+	}
+	output, err = runSemver(t, &config.Config{}, "sort", "--skip-invalid", "1.2.0", "latest", "v1")
+	if err != nil || output != "1.2.0" {
+		t.Errorf("sort --skip-invalid = %q, %v", output, err)
+	}
+}
+
+func TestRunSortCmd_Stdin(t *testing.T) {
+	app := &cli.Command{Name: "sort", Flags: sortCmd().Flags, Action: func(ctx context.Context, cmd *cli.Command) error {
+		return runSortCmd(cmd, strings.NewReader("v1.3.0\n\nv1.1.0\nv1.2.0\n"))
+	}}
+
+	output, _ := testutils.CaptureStdout(func() {
+		if err := app.Run(context.Background(), []string{"sort"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	if output != "v1.1.0\nv1.2.0\nv1.3.0" {
+		t.Errorf("sort from stdin = %q", output)
+	}
+}
+
+func TestRunMaxCmd(t *testing.T) {
+	versions := []string{"1.3.5", "1.4.2", "v2.0.0", "1.4.3-rc.1"}
+
+	output, err := runSemver(t, &config.Config{}, append([]string{"max"}, versions...)...)
+	if err != nil || output != "v2.0.0" {
+		t.Errorf("max = %q, %v; want v2.0.0", output, err)
+	}
+
+	output, err = runSemver(t, &config.Config{}, append([]string{"max", "--satisfying", "~1.4"}, versions...)...)
+	if err != nil || output != "1.4.2" {
+		t.Errorf("max --satisfying ~1.4 = %q, %v; want 1.4.2", output, err)
+	}
+
+	if _, err := runSemver(t, &config.Config{}, append([]string{"max", "--satisfying", "^3"}, versions...)...); err == nil {
+		t.Error("expected error when no version matches")
+	}
+}
+
+func TestNextVersion_Auto(t *testing.T) {
+	current := semver.SemVersion{Major: 0, Minor: 9}
+	next, err := nextVersion(current, "auto", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.String() != "0.10.0" {
+		t.Errorf("nextVersion(auto) = %s, want 0.10.0", next)
+	}
+}
@@ -3,6 +3,7 @@ package set
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/indaco/sley/internal/cliflags"
 	"github.com/indaco/sley/internal/clix"
@@ -49,15 +50,23 @@ func runSetCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 
 	raw := args.Get(0)
 	pre := cmd.String("pre")
-	meta := cmd.String("meta")
+	meta := clix.ResolveMetadata(cfg, cmd.String("meta"), false)
 
-	// Parse and validate the version first
+	// Validate the version as typed against the strict SemVer grammar before
+	// the lenient parse drops leading zeros ("01.2.3"). A "v" prefix is
+	// accepted on the command line.
+	if _, err := semver.ParseStrict(strings.TrimPrefix(raw, "v")); err != nil {
+		return fmt.Errorf("invalid version: %w", err)
+	}
 	version, err := semver.ParseVersion(raw)
 	if err != nil {
 		return fmt.Errorf("invalid version: %w", err)
 	}
 	version.PreRelease = pre
 	version.Build = meta
+	if _, err := semver.ParseStrict(version.String()); err != nil {
+		return fmt.Errorf("invalid version: %w", err)
+	}
 
 	// Get execution context to determine single vs multi-module mode
 	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg)
@@ -76,6 +85,9 @@ func runSetCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error
 
 // runSingleModuleSet handles the single-module set operation.
 func runSingleModuleSet(path string, version semver.SemVersion) error {
+	if err := semver.CheckRecordable(version); err != nil {
+		return err
+	}
 	if err := semver.SaveVersion(path, version); err != nil {
 		return fmt.Errorf("failed to save version: %w", err)
 	}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/testutils"
 	"github.com/urfave/cli/v3"
 )
@@ -61,6 +62,41 @@ func TestCLI_SetVersionCommand_InvalidFormat(t *testing.T) {
 	}
 }
 
+func TestCLI_SetVersionCommand_StrictGrammar(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+
+	cfg := &config.Config{Path: versionPath}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"leading zero major", []string{"sley", "set", "01.2.3"}, "leading zero in major version"},
+		{"leading zero pre-release flag", []string{"sley", "set", "1.2.3", "--pre", "rc.01"}, "leading zero"},
+		{"empty pre-release identifier", []string{"sley", "set", "1.2.3-rc..1"}, "invalid version format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := appCli.Run(context.Background(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+			if _, statErr := os.Stat(versionPath); statErr == nil {
+				t.Errorf("expected no version file to be written")
+			}
+		})
+	}
+
+	testutils.RunCLITest(t, appCli, []string{"sley", "set", "v1.2.3"}, tmpDir)
+	if got := testutils.ReadTempVersionFile(t, tmpDir); got != "1.2.3" {
+		t.Errorf("expected v prefix to be accepted, got %q", got)
+	}
+}
+
 func TestCLI_SetVersionCommand_MissingArgument(t *testing.T) {
 	if os.Getenv("TEST_SLEY_SET_MISSING_ARG") == "1" {
 		tmp := t.TempDir()
@@ -490,3 +526,18 @@ func TestCLI_SetVersionCommand_MultiModule_TextFormat(t *testing.T) {
 		t.Errorf("expected text output with module names, got: %q", output)
 	}
 }
+
+func TestCLI_SetVersionCommand_GitTagSource(t *testing.T) {
+	restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(
+		semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.0.0"}})))
+	defer restore()
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{Path: filepath.Join(tmpDir, ".version")}
+	appCli := testutils.BuildCLIForTests(cfg.Path, []*cli.Command{Run(cfg)})
+
+	err := testutils.RunCLITestAllowError(t, appCli, []string{"sley", "set", "2.0.0"}, tmpDir)
+	if err == nil || !strings.Contains(err.Error(), "cannot record version 2.0.0: source is git-tags") {
+		t.Fatalf("expected git-tags error, got %v", err)
+	}
+}
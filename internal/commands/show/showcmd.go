@@ -53,7 +53,7 @@ func runSingleModuleShow(cmd *cli.Command, path string) error {
 		return err
 	}
 
-	version, err := semver.ReadVersion(path)
+	version, err := semver.DescribeVersion(path)
 	if err != nil {
 		return fmt.Errorf("failed to read version file at %s: %w", path, err)
 	}
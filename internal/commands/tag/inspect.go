@@ -0,0 +1,170 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/indaco/sley/internal/cliflags"
+	"github.com/indaco/sley/internal/clix"
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// showCmd returns the "tag show" subcommand.
+func (tc *TagCommand) showCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "show",
+		Aliases:   []string{"s"},
+		Usage:     "Show the tagger, date, message, signature and commit of a tag",
+		UsageText: "sley tag show [tag-name] [--all] [--module name]",
+		Description: `Show the details of a tag. Signed tags are verified with the configured
+signing settings. Without a tag name, the tag of the current version is shown.`,
+		Flags: cliflags.MultiModuleFlags(),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return tc.runShowCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
+// diffCmd returns the "tag diff" subcommand.
+func (tc *TagCommand) diffCmd(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Show the commits and changed modules between two tags",
+		UsageText: "sley tag diff <from-tag> <to-tag>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return tc.runDiffCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
+// runShowCmd prints the details of a tag.
+func (tc *TagCommand) runShowCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	tagName, effectiveCfg, err := resolveTagName(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	details, err := tc.reader.ShowTag(ctx, tagName)
+	if err != nil {
+		return fmt.Errorf("failed to read tag %s: %w", tagName, err)
+	}
+
+	kind := "lightweight"
+	if details.Annotated {
+		kind = "annotated"
+	}
+
+	fmt.Printf("%-11s%s\n", "Tag:", details.Name)
+	fmt.Printf("%-11s%s\n", "Type:", kind)
+	if details.Tagger != "" {
+		fmt.Printf("%-11s%s\n", "Tagger:", details.Tagger)
+	}
+	if !details.Date.IsZero() {
+		fmt.Printf("%-11s%s\n", "Date:", details.Date.Format("2006-01-02 15:04:05 -0700"))
+	}
+	fmt.Printf("%-11s%s\n", "Commit:", details.Commit)
+	fmt.Printf("%-11s%s\n", "Signature:", tc.describeSignature(ctx, details.Name, details.SignatureFormat, effectiveCfg))
+	if details.Message != "" {
+		fmt.Printf("\n%s\n", details.Message)
+	}
+	return nil
+}
+
+// describeSignature verifies a signed tag and describes the result.
+func (tc *TagCommand) describeSignature(ctx context.Context, tagName, format string, cfg *config.Config) string {
+	if format == "" {
+		return "none"
+	}
+
+	signing := buildTagManagerConfig(cfg).Signing()
+	signing.Format = format
+	if _, err := tc.gitOps.VerifyTag(ctx, tagName, signing); err != nil {
+		return fmt.Sprintf("%s (invalid: %v)", format, err)
+	}
+	return fmt.Sprintf("%s (valid)", format)
+}
+
+// runDiffCmd prints the commits and changed modules between two tags.
+func (tc *TagCommand) runDiffCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	if cmd.NArg() < 2 {
+		return cli.Exit("tag diff requires two tag names", 1)
+	}
+	from, to := cmd.Args().Get(0), cmd.Args().Get(1)
+
+	commits, err := tc.reader.CommitsBetween(ctx, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to list commits between %s and %s: %w", from, to, err)
+	}
+	files, err := tc.reader.ChangedFiles(ctx, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to list changed files between %s and %s: %w", from, to, err)
+	}
+
+	fmt.Printf("%d commit(s) between %s and %s\n", len(commits), from, to)
+	for _, commit := range commits {
+		fmt.Printf("  %s\n", commit)
+	}
+
+	var modules []*workspace.Module
+	if execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg, clix.WithDefaultAll()); err == nil && execCtx.IsMultiModule() {
+		modules = execCtx.Modules
+	}
+
+	if len(modules) == 0 {
+		fmt.Printf("\n%d file(s) changed\n", len(files))
+		return nil
+	}
+
+	changed := changedModules(files, modules)
+	fmt.Printf("\n%d module(s) changed\n", len(changed))
+	for _, mc := range changed {
+		fmt.Printf("  %s %s\n", mc.name, printer.Faint(fmt.Sprintf("(%d file(s))", mc.files)))
+	}
+	return nil
+}
+
+// moduleChange counts the changed files of a module.
+type moduleChange struct {
+	name  string
+	files int
+}
+
+// changedModules attributes each changed file to the module whose directory
+// contains it most specifically. Files outside every module are reported
+// under "(root)". The result is sorted by module name.
+func changedModules(files []string, modules []*workspace.Module) []moduleChange {
+	cwd, _ := os.Getwd()
+	dirs := make(map[string]string, len(modules))
+	for _, mod := range modules {
+		dir := mod.Dir
+		if rel, err := filepath.Rel(cwd, mod.Dir); err == nil {
+			dir = rel
+		}
+		dirs[filepath.ToSlash(dir)] = mod.Name
+	}
+
+	counts := make(map[string]int)
+	for _, file := range files {
+		name, best := "(root)", -1
+		for dir, modName := range dirs {
+			if (dir == "." || file == dir || strings.HasPrefix(file, dir+"/")) && len(dir) > best {
+				name, best = modName, len(dir)
+			}
+		}
+		counts[name]++
+	}
+
+	changes := make([]moduleChange, 0, len(counts))
+	for name, count := range counts {
+		changes = append(changes, moduleChange{name: name, files: count})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].name < changes[j].name })
+	return changes
+}
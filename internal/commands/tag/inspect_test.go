@@ -0,0 +1,205 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/indaco/sley/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRunShowCmd(t *testing.T) {
+	details := core.TagDetails{
+		Name:            "v1.2.0",
+		Annotated:       true,
+		Tagger:          "Jane Doe <jane@example.com>",
+		Date:            time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		Commit:          "abc123",
+		Message:         "Release 1.2.0",
+		SignatureFormat: "ssh",
+	}
+
+	tests := []struct {
+		name      string
+		verifyErr error
+		want      []string
+	}{
+		{
+			name: "valid signature",
+			want: []string{"Tag:       v1.2.0", "Type:      annotated", "Tagger:    Jane Doe <jane@example.com>", "Commit:    abc123", "Signature: ssh (valid)", "Release 1.2.0"},
+		},
+		{
+			name:      "invalid signature",
+			verifyErr: errors.New("no principal matched"),
+			want:      []string{"Signature: ssh (invalid: no principal matched)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verifiedFormat string
+			ops := &mockGitTagOps{
+				verifyTag: func(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+					verifiedFormat = signing.Format
+					return "", tt.verifyErr
+				},
+			}
+			reader := &mockGitTagReader{
+				showTag: func(ctx context.Context, name string) (core.TagDetails, error) {
+					return details, nil
+				},
+			}
+			tc := NewTagCommandWithReader(ops, reader)
+			app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.showCmd(nil)}}
+
+			output, _ := testutils.CaptureStdout(func() {
+				if err := app.Run(context.Background(), []string{"test", "show", "v1.2.0"}); err != nil {
+					t.Errorf("runShowCmd() unexpected error: %v", err)
+				}
+			})
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			if verifiedFormat != "ssh" {
+				t.Errorf("VerifyTag() format = %q, want the tag's own format ssh", verifiedFormat)
+			}
+		})
+	}
+}
+
+func TestRunShowCmd_LightweightUnsigned(t *testing.T) {
+	ops := &mockGitTagOps{
+		verifyTag: func(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+			t.Error("VerifyTag() should not be called for unsigned tags")
+			return "", nil
+		},
+	}
+	reader := &mockGitTagReader{
+		showTag: func(ctx context.Context, name string) (core.TagDetails, error) {
+			return core.TagDetails{Name: name, Commit: "abc123", Message: "feat: initial"}, nil
+		},
+	}
+	tc := NewTagCommandWithReader(ops, reader)
+	app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.showCmd(nil)}}
+
+	output, _ := testutils.CaptureStdout(func() {
+		if err := app.Run(context.Background(), []string{"test", "show", "v1.0.0"}); err != nil {
+			t.Errorf("runShowCmd() unexpected error: %v", err)
+		}
+	})
+	if !strings.Contains(output, "Type:      lightweight") || !strings.Contains(output, "Signature: none") {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+	if strings.Contains(output, "Tagger:") {
+		t.Errorf("lightweight tag should have no tagger:\n%s", output)
+	}
+}
+
+func TestRunShowCmd_Error(t *testing.T) {
+	reader := &mockGitTagReader{
+		showTag: func(ctx context.Context, name string) (core.TagDetails, error) {
+			return core.TagDetails{}, errors.New("tag v9.9.9 not found")
+		},
+	}
+	tc := NewTagCommandWithReader(&mockGitTagOps{}, reader)
+	app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.showCmd(nil)}}
+
+	err := app.Run(context.Background(), []string{"test", "show", "v9.9.9"})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("runShowCmd() error = %v, want not found", err)
+	}
+}
+
+func TestRunDiffCmd(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+	testutils.WriteFile(t, versionPath, "1.1.0\n", 0o644)
+	cfg := &config.Config{Path: versionPath}
+
+	reader := &mockGitTagReader{
+		commitsBetween: func(ctx context.Context, from, to string) ([]string, error) {
+			if from != "v1.0.0" || to != "v1.1.0" {
+				t.Errorf("CommitsBetween(%q, %q)", from, to)
+			}
+			return []string{"abc123 feat: b", "def456 fix: a"}, nil
+		},
+		changedFiles: func(ctx context.Context, from, to string) ([]string, error) {
+			return []string{"main.go", "README.md"}, nil
+		},
+	}
+	tc := NewTagCommandWithReader(&mockGitTagOps{}, reader)
+	app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.diffCmd(cfg)}}
+
+	output, _ := testutils.CaptureStdout(func() {
+		if err := app.Run(context.Background(), []string{"test", "diff", "v1.0.0", "v1.1.0"}); err != nil {
+			t.Errorf("runDiffCmd() unexpected error: %v", err)
+		}
+	})
+	for _, want := range []string{"2 commit(s) between v1.0.0 and v1.1.0", "  abc123 feat: b", "2 file(s) changed"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestRunDiffCmd_Errors(t *testing.T) {
+	tc := NewTagCommandWithReader(&mockGitTagOps{}, &mockGitTagReader{
+		commitsBetween: func(ctx context.Context, from, to string) ([]string, error) {
+			return nil, errors.New("unknown revision")
+		},
+	})
+	var exitErr error
+	app := &cli.Command{
+		Name:      "test",
+		Writer:    io.Discard,
+		ErrWriter: io.Discard,
+		ExitErrHandler: func(_ context.Context, _ *cli.Command, err error) {
+			exitErr = err
+		},
+		Commands: []*cli.Command{tc.diffCmd(nil)},
+	}
+
+	_ = app.Run(context.Background(), []string{"test", "diff", "v1.0.0"})
+	if exitErr == nil {
+		t.Error("runDiffCmd() expected error for a single tag")
+	}
+	err := app.Run(context.Background(), []string{"test", "diff", "v1.0.0", "v2.0.0"})
+	if err == nil || !strings.Contains(err.Error(), "unknown revision") {
+		t.Errorf("runDiffCmd() error = %v, want git error", err)
+	}
+}
+
+func TestChangedModules(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+
+	modules := []*workspace.Module{
+		{Name: "api", Dir: filepath.Join(root, "services", "api")},
+		{Name: "api-v2", Dir: filepath.Join(root, "services", "api", "v2")},
+		{Name: "web", Dir: filepath.Join(root, "web")},
+	}
+	files := []string{
+		"services/api/main.go",
+		"services/api/v2/handler.go",
+		"services/api/v2/router.go",
+		"services/apiary/x.go",
+		"go.mod",
+	}
+
+	got := changedModules(files, modules)
+	want := []moduleChange{{name: "(root)", files: 2}, {name: "api", files: 1}, {name: "api-v2", files: 2}}
+	if !slices.Equal(got, want) {
+		t.Errorf("changedModules() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,402 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indaco/sley/internal/cliflags"
+	"github.com/indaco/sley/internal/clix"
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/tui"
+	"github.com/urfave/cli/v3"
+)
+
+// reconcileCmd returns the "tag reconcile" subcommand.
+func (tc *TagCommand) reconcileCmd(cfg *config.Config) *cli.Command {
+	flags := []cli.Flag{
+		&cli.BoolFlag{
+			Name:    "yes",
+			Aliases: []string{"y"},
+			Usage:   "Create and push missing tags without confirmation",
+		},
+	}
+	flags = append(flags, cliflags.MultiModuleFlags()...)
+
+	return &cli.Command{
+		Name:      "reconcile",
+		Usage:     "Compare .version, local tags and remote tags and fix missing tags",
+		UsageText: "sley tag reconcile [--yes] [--all] [--module name]",
+		Description: `For each module, compare the version in .version with the latest local tag
+and the tags on the remote. Offers to create the tag of the current version
+when it is missing and to push local version tags missing from the remote.
+
+Non-interactive sessions only report, unless --yes is passed.`,
+		Flags: flags,
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return tc.runReconcileCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
+// pruneCmd returns the "tag prune" subcommand.
+func (tc *TagCommand) pruneCmd(cfg *config.Config) *cli.Command {
+	flags := []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "prereleases",
+			Usage: "Only prune pre-release tags",
+		},
+		&cli.StringFlag{
+			Name:  "older-than",
+			Usage: "Only prune tags older than a duration (e.g. 90d, 12w, 720h)",
+		},
+		&cli.IntFlag{
+			Name:  "keep",
+			Usage: "Always keep the newest N matching tags",
+		},
+		&cli.BoolFlag{
+			Name:  "remote",
+			Usage: "Also delete the tags from remote",
+		},
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "List the tags that would be deleted",
+		},
+		&cli.BoolFlag{
+			Name:    "yes",
+			Aliases: []string{"y"},
+			Usage:   "Delete without confirmation",
+		},
+	}
+	flags = append(flags, cliflags.MultiModuleFlags()...)
+
+	return &cli.Command{
+		Name:      "prune",
+		Usage:     "Delete old or pre-release version tags",
+		UsageText: "sley tag prune [--prereleases] [--older-than 90d] [--keep 5] [--remote] [--dry-run] [--yes]",
+		Description: `Delete version tags matching the given criteria. At least one of
+--prereleases, --older-than or --keep is required. The tag of the current
+version is never pruned.`,
+		Flags: flags,
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return tc.runPruneCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
+// tagTarget is a version file and the tag settings that apply to it.
+type tagTarget struct {
+	name       string
+	version    semver.SemVersion
+	prefix     string
+	modulePath string
+	config     *tagmanager.Config
+	channels   []string
+}
+
+// tagName returns the tag of the target's current version.
+func (t tagTarget) tagName() string {
+	return t.prefix + t.version.String()
+}
+
+// resolveTagTargets reads the version of every selected module, or of the
+// single version file outside workspaces.
+func resolveTagTargets(ctx context.Context, cmd *cli.Command, cfg *config.Config) ([]tagTarget, error) {
+	execCtx, err := clix.GetExecutionContext(ctx, cmd, cfg, clix.WithDefaultAll())
+	if err != nil {
+		return nil, err
+	}
+
+	type versionFile struct{ name, path string }
+	files := []versionFile{{name: "", path: execCtx.Path}}
+	if execCtx.IsMultiModule() {
+		files = files[:0]
+		for _, mod := range execCtx.Modules {
+			files = append(files, versionFile{name: mod.Name, path: mod.Path})
+		}
+	}
+
+	targets := make([]tagTarget, 0, len(files))
+	for _, f := range files {
+		version, err := semver.ReadVersion(f.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read version from %s: %w", f.path, err)
+		}
+		effectiveCfg, modulePath := resolveModuleConfig(cfg, f.path)
+		tmConfig := buildTagManagerConfig(effectiveCfg)
+		targets = append(targets, tagTarget{
+			name:       f.name,
+			version:    version,
+			prefix:     tagmanager.InterpolatePrefix(tmConfig.Prefix, modulePath),
+			modulePath: modulePath,
+			config:     tmConfig,
+			channels:   effectiveCfg.GetPreReleaseChannels(),
+		})
+	}
+	return targets, nil
+}
+
+// versionTags filters tags to the version tags of a prefix, newest first.
+// Alias tags and other non-semver tags are dropped.
+func versionTags(tags []string, prefix string, channels []string) []string {
+	var result []string
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, prefix) {
+			continue
+		}
+		if _, err := semver.ParseVersion(strings.TrimPrefix(tag, prefix)); err == nil {
+			result = append(result, tag)
+		}
+	}
+	sortTagsBySemver(result, prefix, channels)
+	return result
+}
+
+// runReconcileCmd reports and fixes missing local and remote version tags.
+func (tc *TagCommand) runReconcileCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	targets, err := resolveTagTargets(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	remoteTags, err := tc.reader.ListRemoteTags(ctx)
+	remoteAvailable := err == nil
+	if !remoteAvailable {
+		printer.PrintWarning(fmt.Sprintf("Remote tags unavailable, skipping push checks: %v", err))
+	}
+
+	var toCreate []tagTarget
+	var toPush []string
+	skipped := false
+	for _, target := range targets {
+		label := target.tagName()
+		if target.name != "" {
+			label = fmt.Sprintf("%s (%s)", target.name, label)
+		}
+
+		tags, err := tc.gitOps.ListTags(ctx, target.prefix+"*")
+		if err != nil {
+			return fmt.Errorf("failed to list tags: %w", err)
+		}
+		local := versionTags(tags, target.prefix, target.channels)
+
+		latest := "none"
+		if len(local) > 0 {
+			latest = local[0]
+		}
+		fmt.Printf("%s: version %s, latest tag %s\n", label, target.version.String(), latest)
+
+		behind := len(local) > 0 && parseVersionFromTag(local[0], target.prefix).Compare(target.version) > 0
+		if behind {
+			printer.PrintWarning(fmt.Sprintf("  latest tag %s is newer than the version file", local[0]))
+		}
+
+		if !slices.Contains(local, target.tagName()) {
+			// HEAD is past the newer release, so tagging it with the older
+			// version would point the tag at the wrong commit.
+			if behind {
+				fmt.Printf("  missing local tag %s not created: HEAD is past %s\n", target.tagName(), local[0])
+				skipped = true
+			} else {
+				fmt.Printf("  missing local tag %s\n", target.tagName())
+				toCreate = append(toCreate, target)
+				local = append(local, target.tagName())
+			}
+		}
+
+		if remoteAvailable {
+			for _, tag := range local {
+				if !slices.Contains(remoteTags, tag) {
+					fmt.Printf("  tag %s is not on the remote\n", tag)
+					toPush = append(toPush, tag)
+				}
+			}
+		}
+	}
+
+	if len(toCreate) == 0 && len(toPush) == 0 {
+		if skipped {
+			printer.PrintWarning("No tags to create or push; update the version file or tag the older release manually")
+			return nil
+		}
+		printer.PrintSuccess("Tags are in sync")
+		return nil
+	}
+
+	confirmed, err := tc.confirm(cmd, fmt.Sprintf("Create %d and push %d tag(s)?", len(toCreate), len(toPush)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		printer.PrintFaint("No changes made (re-run with --yes to apply)")
+		return nil
+	}
+
+	for _, target := range toCreate {
+		data := tagmanager.NewTemplateData(target.version, target.prefix, target.modulePath)
+		message := tagmanager.FormatMessage(target.config.MessageTemplate, data)
+		if err := tc.createTag(ctx, target.tagName(), message, target.config); err != nil {
+			return err
+		}
+		printer.PrintFaint(fmt.Sprintf("Created tag %s", printer.Info(target.tagName())))
+	}
+	for _, tag := range toPush {
+		if err := tc.gitOps.PushTag(ctx, tag); err != nil {
+			return fmt.Errorf("failed to push tag %s: %w", tag, err)
+		}
+		printer.PrintFaint(fmt.Sprintf("Pushed tag %s to remote", printer.Info(tag)))
+	}
+	return nil
+}
+
+// runPruneCmd deletes version tags matching the prune criteria.
+func (tc *TagCommand) runPruneCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	prereleases := cmd.Bool("prereleases")
+	keep := cmd.Int("keep")
+	if !prereleases && !cmd.IsSet("older-than") && keep == 0 {
+		return cli.Exit("tag prune needs at least one of --prereleases, --older-than or --keep", 1)
+	}
+	if keep < 0 {
+		return cli.Exit("--keep must not be negative", 1)
+	}
+
+	var cutoff time.Time
+	if cmd.IsSet("older-than") {
+		age, err := parseAge(cmd.String("older-than"))
+		if err != nil {
+			return err
+		}
+		cutoff = time.Now().Add(-age)
+	}
+
+	targets, err := resolveTagTargets(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	var toDelete []string
+	for _, target := range targets {
+		refs, err := tc.reader.ListTagRefs(ctx, target.prefix+"*")
+		if err != nil {
+			return fmt.Errorf("failed to list tags: %w", err)
+		}
+		toDelete = append(toDelete, selectPrunable(refs, target, prereleases, keep, cutoff)...)
+	}
+
+	if len(toDelete) == 0 {
+		printer.PrintFaint("No tags to prune")
+		return nil
+	}
+
+	fmt.Printf("%d tag(s) to prune:\n", len(toDelete))
+	for _, tag := range toDelete {
+		fmt.Printf("  %s\n", tag)
+	}
+	if cmd.Bool("dry-run") {
+		return nil
+	}
+
+	where := "locally"
+	if cmd.Bool("remote") {
+		where = "locally and on the remote"
+	}
+	confirmed, err := tc.confirm(cmd, fmt.Sprintf("Delete %d tag(s) %s?", len(toDelete), where))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return fmt.Errorf("tag prune not confirmed; re-run with --yes to delete without a prompt")
+	}
+
+	for _, tag := range toDelete {
+		if err := tc.gitOps.DeleteTag(ctx, tag); err != nil {
+			return fmt.Errorf("failed to delete local tag %s: %w", tag, err)
+		}
+		if cmd.Bool("remote") {
+			if err := tc.gitOps.DeleteRemoteTag(ctx, tag); err != nil {
+				return fmt.Errorf("failed to delete remote tag %s: %w", tag, err)
+			}
+		}
+	}
+	printer.PrintSuccess(fmt.Sprintf("Pruned %d tag(s) %s", len(toDelete), where))
+	return nil
+}
+
+// selectPrunable returns the version tags of a target that match the prune
+// criteria. The newest keep matching tags and the current version's tag are
+// always kept; a zero cutoff disables the age check.
+func selectPrunable(refs []core.TagRef, target tagTarget, prereleases bool, keep int, cutoff time.Time) []string {
+	dates := make(map[string]time.Time, len(refs))
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		dates[ref.Name] = ref.Date
+		names = append(names, ref.Name)
+	}
+
+	var matching []string
+	for _, tag := range versionTags(names, target.prefix, target.channels) {
+		if prereleases && parseVersionFromTag(tag, target.prefix).PreRelease == "" {
+			continue
+		}
+		matching = append(matching, tag)
+	}
+	if keep >= len(matching) {
+		return nil
+	}
+
+	var prunable []string
+	for _, tag := range matching[keep:] {
+		if tag == target.tagName() {
+			continue
+		}
+		if !cutoff.IsZero() && !dates[tag].Before(cutoff) {
+			continue
+		}
+		prunable = append(prunable, tag)
+	}
+	return prunable
+}
+
+// parseAge parses a duration that also accepts day ("90d") and week ("12w") units.
+func parseAge(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid --older-than value %q (use e.g. 90d, 12w or 720h)", s)
+
+	unit := map[byte]time.Duration{'d': 24 * time.Hour, 'w': 7 * 24 * time.Hour}
+	if n := len(s); n > 1 {
+		if mult, ok := unit[s[n-1]]; ok {
+			count, err := strconv.Atoi(s[:n-1])
+			if err != nil || count < 0 {
+				return 0, invalid
+			}
+			return time.Duration(count) * mult, nil
+		}
+	}
+
+	age, err := time.ParseDuration(s)
+	if err != nil || age < 0 {
+		return 0, invalid
+	}
+	return age, nil
+}
+
+// confirm asks the user to approve an action. --yes approves without a
+// prompt; non-interactive sessions decline.
+func (tc *TagCommand) confirm(cmd *cli.Command, message string) (bool, error) {
+	if cmd.Bool("yes") {
+		return true, nil
+	}
+	if tc.prompter != nil {
+		return tc.prompter.ConfirmOperation(message)
+	}
+	if !tui.IsInteractive() {
+		return false, nil
+	}
+	return tui.Confirm(message, "")
+}
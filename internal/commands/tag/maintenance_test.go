@@ -0,0 +1,321 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/indaco/sley/internal/tui"
+	"github.com/urfave/cli/v3"
+)
+
+// newVersionConfig writes a .version file and returns a config pointing at it.
+func newVersionConfig(t *testing.T, version string) *config.Config {
+	t.Helper()
+	versionPath := filepath.Join(t.TempDir(), ".version")
+	testutils.WriteFile(t, versionPath, version+"\n", 0o644)
+	return &config.Config{
+		Path: versionPath,
+		Plugins: &config.PluginConfig{
+			TagManager: &config.TagManagerConfig{Enabled: true},
+		},
+	}
+}
+
+func TestRunReconcileCmd(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		local       []string
+		remote      []string
+		remoteErr   error
+		args        []string
+		confirm     bool
+		wantCreated []string
+		wantPushed  []string
+		wantOutput  string
+	}{
+		{
+			name:       "in sync",
+			version:    "1.1.0",
+			local:      []string{"v1.0.0", "v1.1.0", "v1"},
+			remote:     []string{"v1.0.0", "v1.1.0", "v1"},
+			wantOutput: "version 1.1.0, latest tag v1.1.0",
+		},
+		{
+			name:        "missing local and remote tags with --yes",
+			version:     "1.2.0",
+			local:       []string{"v1.0.0", "v1.1.0"},
+			remote:      []string{"v1.0.0"},
+			args:        []string{"--yes"},
+			wantCreated: []string{"v1.2.0"},
+			wantPushed:  []string{"v1.1.0", "v1.2.0"},
+			wantOutput:  "missing local tag v1.2.0",
+		},
+		{
+			name:        "confirmed by prompt",
+			version:     "1.1.0",
+			local:       []string{"v1.1.0"},
+			confirm:     true,
+			wantPushed:  []string{"v1.1.0"},
+			wantOutput:  "tag v1.1.0 is not on the remote",
+			wantCreated: nil,
+		},
+		{
+			name:       "declined",
+			version:    "1.2.0",
+			local:      []string{"v1.1.0"},
+			remote:     []string{"v1.1.0"},
+			wantOutput: "No changes made",
+		},
+		{
+			name:        "remote unavailable",
+			version:     "1.2.0",
+			local:       []string{"v1.1.0"},
+			remoteErr:   errors.New("no remote"),
+			args:        []string{"--yes"},
+			wantCreated: []string{"v1.2.0"},
+		},
+		{
+			name:       "version behind latest tag",
+			version:    "1.0.0",
+			local:      []string{"v1.0.0", "v1.1.0"},
+			remote:     []string{"v1.0.0", "v1.1.0"},
+			wantOutput: "latest tag v1.1.0",
+		},
+		{
+			name:       "missing tag behind latest tag is not created",
+			version:    "1.0.1",
+			local:      []string{"v1.0.0", "v1.1.0"},
+			remote:     []string{"v1.0.0", "v1.1.0"},
+			args:       []string{"--yes"},
+			wantOutput: "missing local tag v1.0.1 not created: HEAD is past v1.1.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created, pushed []string
+			ops := &mockGitTagOps{
+				listTags: func(ctx context.Context, pattern string) ([]string, error) {
+					return tt.local, nil
+				},
+				createAnnotatedTag: func(ctx context.Context, name, message string) error {
+					created = append(created, name)
+					return nil
+				},
+				pushTag: func(ctx context.Context, name string) error {
+					pushed = append(pushed, name)
+					return nil
+				},
+			}
+			reader := &mockGitTagReader{
+				listRemoteTags: func(ctx context.Context) ([]string, error) {
+					return tt.remote, tt.remoteErr
+				},
+			}
+			tc := NewTagCommandWithReader(ops, reader)
+			tc.prompter = tui.NewMockPrompter().WithConfirmResult(tt.confirm)
+			app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.reconcileCmd(newVersionConfig(t, tt.version))}}
+
+			output, _ := testutils.CaptureStdout(func() {
+				if err := app.Run(context.Background(), append([]string{"test", "reconcile"}, tt.args...)); err != nil {
+					t.Errorf("runReconcileCmd() unexpected error: %v", err)
+				}
+			})
+
+			if !slices.Equal(created, tt.wantCreated) {
+				t.Errorf("created = %v, want %v", created, tt.wantCreated)
+			}
+			if !slices.Equal(pushed, tt.wantPushed) {
+				t.Errorf("pushed = %v, want %v", pushed, tt.wantPushed)
+			}
+			if !strings.Contains(output, tt.wantOutput) {
+				t.Errorf("output missing %q:\n%s", tt.wantOutput, output)
+			}
+		})
+	}
+}
+
+func TestSelectPrunable(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-200 * 24 * time.Hour)
+	refs := []core.TagRef{
+		{Name: "v1.0.0-rc.1", Date: old},
+		{Name: "v1.0.0", Date: old},
+		{Name: "v1.1.0-beta.1", Date: old},
+		{Name: "v1.1.0", Date: now},
+		{Name: "v2.0.0-rc.1", Date: now},
+		{Name: "v1", Date: old},
+	}
+	target := tagTarget{prefix: "v", version: semver.SemVersion{Major: 1, Minor: 1}}
+	cutoff := now.Add(-90 * 24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		prereleases bool
+		keep        int
+		cutoff      time.Time
+		want        []string
+	}{
+		{name: "pre-releases", prereleases: true, want: []string{"v2.0.0-rc.1", "v1.1.0-beta.1", "v1.0.0-rc.1"}},
+		{name: "pre-releases keeping newest", prereleases: true, keep: 1, want: []string{"v1.1.0-beta.1", "v1.0.0-rc.1"}},
+		{name: "older than cutoff", cutoff: cutoff, want: []string{"v1.1.0-beta.1", "v1.0.0", "v1.0.0-rc.1"}},
+		{name: "keep never prunes current version", keep: 1, want: []string{"v1.1.0-beta.1", "v1.0.0", "v1.0.0-rc.1"}},
+		{name: "keep more than available", keep: 10, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectPrunable(refs, target, tt.prereleases, tt.keep, tt.cutoff)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("selectPrunable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "90d", want: 90 * 24 * time.Hour},
+		{in: "2w", want: 14 * 24 * time.Hour},
+		{in: "36h", want: 36 * time.Hour},
+		{in: "d", wantErr: true},
+		{in: "-5d", wantErr: true},
+		{in: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseAge(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAge(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseAge(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunPruneCmd(t *testing.T) {
+	refs := []core.TagRef{
+		{Name: "v1.0.0-rc.1"},
+		{Name: "v1.0.0"},
+		{Name: "v1.1.0-rc.1"},
+	}
+
+	tests := []struct {
+		name          string
+		args          []string
+		confirm       bool
+		wantDeleted   []string
+		wantRemoteDel []string
+		wantErr       string
+	}{
+		{
+			name:          "prune pre-releases locally and remotely",
+			args:          []string{"--prereleases", "--remote", "--yes"},
+			wantDeleted:   []string{"v1.1.0-rc.1", "v1.0.0-rc.1"},
+			wantRemoteDel: []string{"v1.1.0-rc.1", "v1.0.0-rc.1"},
+		},
+		{
+			name:        "confirmed by prompt",
+			args:        []string{"--prereleases", "--keep", "1"},
+			confirm:     true,
+			wantDeleted: []string{"v1.0.0-rc.1"},
+		},
+		{
+			name: "dry run",
+			args: []string{"--prereleases", "--dry-run"},
+		},
+		{
+			name:    "declined",
+			args:    []string{"--prereleases"},
+			wantErr: "tag prune not confirmed",
+		},
+		{
+			name:    "invalid age",
+			args:    []string{"--older-than", "later"},
+			wantErr: "invalid --older-than value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted, remoteDeleted []string
+			ops := &mockGitTagOps{
+				deleteTag: func(ctx context.Context, name string) error {
+					deleted = append(deleted, name)
+					return nil
+				},
+				deleteRemoteTag: func(ctx context.Context, name string) error {
+					remoteDeleted = append(remoteDeleted, name)
+					return nil
+				},
+			}
+			reader := &mockGitTagReader{
+				listTagRefs: func(ctx context.Context, pattern string) ([]core.TagRef, error) {
+					return refs, nil
+				},
+			}
+			tc := NewTagCommandWithReader(ops, reader)
+			tc.prompter = tui.NewMockPrompter().WithConfirmResult(tt.confirm)
+			app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.pruneCmd(newVersionConfig(t, "1.0.0"))}}
+
+			var err error
+			_, _ = testutils.CaptureStdout(func() {
+				err = app.Run(context.Background(), append([]string{"test", "prune"}, tt.args...))
+			})
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("runPruneCmd() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runPruneCmd() unexpected error: %v", err)
+			}
+			if !slices.Equal(deleted, tt.wantDeleted) {
+				t.Errorf("deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if !slices.Equal(remoteDeleted, tt.wantRemoteDel) {
+				t.Errorf("remote deleted = %v, want %v", remoteDeleted, tt.wantRemoteDel)
+			}
+		})
+	}
+}
+
+func TestRunPruneCmd_RequiresCriteria(t *testing.T) {
+	tc := NewTagCommandWithReader(&mockGitTagOps{}, &mockGitTagReader{})
+
+	var exitErr error
+	app := &cli.Command{
+		Name:      "test",
+		Writer:    io.Discard,
+		ErrWriter: io.Discard,
+		ExitErrHandler: func(_ context.Context, _ *cli.Command, err error) {
+			exitErr = err
+		},
+		Commands: []*cli.Command{tc.pruneCmd(nil)},
+	}
+
+	_ = app.Run(context.Background(), []string{"test", "prune"})
+	if exitErr == nil || !strings.Contains(exitErr.Error(), "at least one of") {
+		t.Errorf("runPruneCmd() error = %v, want criteria error", exitErr)
+	}
+}
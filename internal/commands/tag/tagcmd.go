@@ -15,22 +15,35 @@ import (
 	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/printer"
 	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/tui"
 	"github.com/urfave/cli/v3"
 )
 
 // TagCommand handles tag operations with injected dependencies.
 type TagCommand struct {
 	gitOps core.GitTagOperations
+	reader core.GitTagReader
+
+	// prompter confirms destructive actions. When nil, an interactive
+	// prompt is shown if the session is a TTY.
+	prompter tui.Prompter
 }
 
 // NewTagCommand creates a new TagCommand with the given git operations.
 func NewTagCommand(gitOps core.GitTagOperations) *TagCommand {
-	return &TagCommand{gitOps: gitOps}
+	return NewTagCommandWithReader(gitOps, tagmanager.NewOSGitTagOperations())
+}
+
+// NewTagCommandWithReader creates a new TagCommand with the given git
+// operations and tag reader.
+func NewTagCommandWithReader(gitOps core.GitTagOperations, reader core.GitTagReader) *TagCommand {
+	return &TagCommand{gitOps: gitOps, reader: reader}
 }
 
 // NewDefaultTagCommand creates a TagCommand with the default OS git operations.
 func NewDefaultTagCommand() *TagCommand {
-	return NewTagCommand(tagmanager.NewOSGitTagOperations())
+	ops := tagmanager.NewOSGitTagOperations()
+	return NewTagCommandWithReader(ops, ops)
 }
 
 // Run returns the "tag" command with subcommands.
@@ -48,6 +61,12 @@ func Run(cfg *config.Config) *cli.Command {
 			tc.listCmd(cfg),
 			tc.pushCmd(cfg),
 			tc.deleteCmd(cfg),
+			tc.verifyCmd(cfg),
+			tc.aliasesCmd(cfg),
+			tc.showCmd(cfg),
+			tc.diffCmd(cfg),
+			tc.reconcileCmd(cfg),
+			tc.pruneCmd(cfg),
 		},
 	}
 }
@@ -135,6 +154,64 @@ func (tc *TagCommand) deleteCmd(cfg *config.Config) *cli.Command {
 	}
 }
 
+// verifyCmd returns the "tag verify" subcommand.
+func (tc *TagCommand) verifyCmd(cfg *config.Config) *cli.Command {
+	flags := []cli.Flag{
+		&cli.StringFlag{
+			Name:  "allowed-signers",
+			Usage: "SSH allowed signers file (overrides allowed-signers-file)",
+		},
+	}
+	flags = append(flags, cliflags.MultiModuleFlags()...)
+
+	return &cli.Command{
+		Name:      "verify",
+		Aliases:   []string{"v"},
+		Usage:     "Verify the signature of a git tag",
+		UsageText: "sley tag verify [tag-name] [--allowed-signers file] [--all] [--module name]",
+		Description: `Verify the signature of a tag with git tag -v, using the signing-format
+configured for the tag-manager plugin (gpg, ssh or x509). SSH signatures are
+checked against the allowed signers file.
+
+Without a tag name, the tag of the current version is verified.`,
+		Flags: flags,
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return tc.runVerifyCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
+// aliasesCmd returns the "tag aliases" subcommand.
+func (tc *TagCommand) aliasesCmd(cfg *config.Config) *cli.Command {
+	flags := []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "repair",
+			Usage: "Force-move every alias tag to its expected version tag",
+		},
+		&cli.BoolFlag{
+			Name:  "push",
+			Usage: "Push repaired alias tags to remote (with --force-with-lease)",
+		},
+	}
+	flags = append(flags, cliflags.MultiModuleFlags()...)
+
+	return &cli.Command{
+		Name:      "aliases",
+		Aliases:   []string{"a"},
+		Usage:     "Show or repair moving alias tags (v1, v1.4, latest)",
+		UsageText: "sley tag aliases [--repair] [--push] [--all] [--module name]",
+		Description: `Compute the alias tags configured in tag-manager aliases from the existing
+semver tags: each alias points at the newest stable version it covers.
+
+Without flags, the expected alias targets are listed. With --repair, every
+alias is force-moved to its target.`,
+		Flags: flags,
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return tc.runAliasesCmd(ctx, cmd, cfg)
+		},
+	}
+}
+
 // resolveModuleConfig loads and merges per-module config for a given version path.
 // Returns the effective config and the module's relative directory path.
 func resolveModuleConfig(cfg *config.Config, path string) (*config.Config, string) {
@@ -221,6 +298,14 @@ func (tc *TagCommand) createTagForPath(ctx context.Context, cmd *cli.Command, cf
 		printer.PrintFaint(fmt.Sprintf("Pushed tag %s to remote", printer.Info(tagName)))
 	}
 
+	aliases, err := tc.updateAliases(version, prefix, tmConfig, shouldPush)
+	if err != nil {
+		return err
+	}
+	if len(aliases) > 0 {
+		printer.PrintFaint(fmt.Sprintf("Moved alias tags %s", printer.Info(strings.Join(aliases, ", "))))
+	}
+
 	return nil
 }
 
@@ -282,6 +367,16 @@ func (tc *TagCommand) createTagsForAllModules(ctx context.Context, cmd *cli.Comm
 			}
 			printer.PrintFaint(fmt.Sprintf("  Pushed tag %s to remote", printer.Info(tagName)))
 		}
+
+		aliases, err := tc.updateAliases(version, prefix, tmConfig, pushThis)
+		if err != nil {
+			printer.PrintError(fmt.Sprintf("  Failed to update alias tags for module %q: %v", mod.Name, err))
+			failedModules = append(failedModules, mod.Name)
+			continue
+		}
+		if len(aliases) > 0 {
+			printer.PrintFaint(fmt.Sprintf("  Moved alias tags %s", printer.Info(strings.Join(aliases, ", "))))
+		}
 	}
 
 	if len(failedModules) > 0 {
@@ -296,7 +391,7 @@ func (tc *TagCommand) createTagsForAllModules(ctx context.Context, cmd *cli.Comm
 func (tc *TagCommand) createTag(ctx context.Context, tagName, message string, cfg *tagmanager.Config) error {
 	switch {
 	case cfg.Sign:
-		if err := tc.gitOps.CreateSignedTag(ctx, tagName, message, cfg.SigningKey); err != nil {
+		if err := tc.gitOps.CreateSignedTag(ctx, tagName, message, cfg.Signing()); err != nil {
 			return fmt.Errorf("failed to create signed tag: %w", err)
 		}
 	case cfg.Annotate:
@@ -311,6 +406,22 @@ func (tc *TagCommand) createTag(ctx context.Context, tagName, message string, cf
 	return nil
 }
 
+// updateAliases force-moves the alias tags of a newly created version tag.
+func (tc *TagCommand) updateAliases(version semver.SemVersion, prefix string, cfg *tagmanager.Config, push bool) ([]string, error) {
+	if len(cfg.Aliases) == 0 {
+		return nil, nil
+	}
+
+	aliasCfg := *cfg
+	aliasCfg.Prefix = prefix
+	aliasCfg.Push = push
+	aliases, err := tagmanager.NewTagManagerWithOps(&aliasCfg, tc.gitOps, nil).UpdateAliases(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update alias tags: %w", err)
+	}
+	return aliases, nil
+}
+
 // runListCmd lists existing version tags.
 func (tc *TagCommand) runListCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
 	effectiveCfg := cfg
@@ -335,7 +446,7 @@ func (tc *TagCommand) runListCmd(ctx context.Context, cmd *cli.Command, cfg *con
 		return nil
 	}
 
-	sortTagsBySemver(tags, prefix)
+	sortTagsBySemver(tags, prefix, effectiveCfg.GetPreReleaseChannels())
 
 	limit := cmd.Int("limit")
 	if limit > 0 && limit < len(tags) {
@@ -387,6 +498,111 @@ func (tc *TagCommand) runPushCmd(ctx context.Context, cmd *cli.Command, cfg *con
 	return nil
 }
 
+// runVerifyCmd verifies the signature of a git tag.
+func (tc *TagCommand) runVerifyCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	tagName, effectiveCfg, err := resolveTagName(ctx, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	signing := buildTagManagerConfig(effectiveCfg).Signing()
+	if cmd.IsSet("allowed-signers") {
+		signing.AllowedSignersFile = cmd.String("allowed-signers")
+	}
+
+	exists, err := tc.gitOps.TagExists(ctx, tagName)
+	if err != nil {
+		return fmt.Errorf("failed to check tag existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("tag %s does not exist locally", tagName)
+	}
+
+	output, err := tc.gitOps.VerifyTag(ctx, tagName, signing)
+	if err != nil {
+		return fmt.Errorf("tag %s has no valid signature: %w", tagName, err)
+	}
+
+	printer.PrintSuccess(fmt.Sprintf("Tag %s has a valid %s signature", tagName, signing.Format))
+	if output != "" {
+		printer.PrintFaint(output)
+	}
+	return nil
+}
+
+// runAliasesCmd lists or repairs the alias tags.
+func (tc *TagCommand) runAliasesCmd(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	effectiveCfg := cfg
+	modulePath := ""
+	if cfg != nil {
+		path, err := resolveVersionPath(ctx, cmd, cfg)
+		if err != nil {
+			return err
+		}
+		effectiveCfg, modulePath = resolveModuleConfig(cfg, path)
+	}
+
+	tmConfig := buildTagManagerConfig(effectiveCfg)
+	if len(tmConfig.Aliases) == 0 {
+		printer.PrintFaint("No alias tags configured (set tag-manager aliases)")
+		return nil
+	}
+	tmConfig.Prefix = tagmanager.InterpolatePrefix(tmConfig.Prefix, modulePath)
+	tmConfig.Push = cmd.Bool("push") || tmConfig.Push
+	tm := tagmanager.NewTagManagerWithOps(tmConfig, tc.gitOps, nil)
+
+	if !cmd.Bool("repair") {
+		aliases, err := tm.ResolveAliases()
+		if err != nil {
+			return err
+		}
+		if len(aliases) == 0 {
+			printer.PrintFaint("No stable version tags found")
+			return nil
+		}
+		for _, alias := range aliases {
+			fmt.Printf("%s -> %s\n", alias.Name, alias.Target)
+		}
+		return nil
+	}
+
+	aliases, err := tm.RepairAliases()
+	for _, alias := range aliases {
+		printer.PrintFaint(fmt.Sprintf("Moved alias tag %s to %s", printer.Info(alias.Name), printer.Info(alias.Target)))
+	}
+	if err != nil {
+		return err
+	}
+	if len(aliases) == 0 {
+		printer.PrintFaint("No stable version tags found")
+	} else if tmConfig.Push {
+		printer.PrintFaint(fmt.Sprintf("Pushed %d alias tag(s) to remote", len(aliases)))
+	}
+	return nil
+}
+
+// resolveTagName returns the tag named by the first argument, or the tag of
+// the current version, together with the effective config for that tag.
+func resolveTagName(ctx context.Context, cmd *cli.Command, cfg *config.Config) (string, *config.Config, error) {
+	if cmd.NArg() > 0 {
+		return cmd.Args().Get(0), cfg, nil
+	}
+
+	path, err := resolveVersionPath(ctx, cmd, cfg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	version, err := semver.ReadVersion(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read version from %s: %w", path, err)
+	}
+
+	effectiveCfg, modulePath := resolveModuleConfig(cfg, path)
+	prefix := tagmanager.InterpolatePrefix(getTagPrefix(effectiveCfg), modulePath)
+	return prefix + version.String(), effectiveCfg, nil
+}
+
 // runDeleteCmd deletes a git tag.
 func (tc *TagCommand) runDeleteCmd(ctx context.Context, cmd *cli.Command, _ *config.Config) error {
 	if cmd.NArg() < 1 {
@@ -502,36 +718,23 @@ func buildTagManagerConfig(cfg *config.Config) *tagmanager.Config {
 	tmConfig.Push = tmCfg.Push
 	tmConfig.TagPrereleases = tmCfg.GetTagPrereleases()
 	tmConfig.Sign = tmCfg.GetSign()
+	tmConfig.SigningFormat = tmCfg.GetSigningFormat()
 	tmConfig.SigningKey = tmCfg.GetSigningKey()
+	tmConfig.SigningProgram = tmCfg.SigningProgram
+	tmConfig.AllowedSignersFile = tmCfg.AllowedSignersFile
 	tmConfig.MessageTemplate = tmCfg.GetMessageTemplate()
+	tmConfig.Aliases = tmCfg.Aliases
 
 	return tmConfig
 }
 
 // sortTagsBySemver sorts tags by semantic version in descending order (newest first).
-func sortTagsBySemver(tags []string, prefix string) {
-	sort.Slice(tags, func(i, j int) bool {
+// Pre-releases on different channels are ordered by channel position.
+func sortTagsBySemver(tags []string, prefix string, channels []string) {
+	sort.SliceStable(tags, func(i, j int) bool {
 		vi := parseVersionFromTag(tags[i], prefix)
 		vj := parseVersionFromTag(tags[j], prefix)
-
-		if vi.Major != vj.Major {
-			return vi.Major > vj.Major
-		}
-		if vi.Minor != vj.Minor {
-			return vi.Minor > vj.Minor
-		}
-		if vi.Patch != vj.Patch {
-			return vi.Patch > vj.Patch
-		}
-
-		if vi.PreRelease == "" && vj.PreRelease != "" {
-			return true
-		}
-		if vi.PreRelease != "" && vj.PreRelease == "" {
-			return false
-		}
-
-		return vi.PreRelease > vj.PreRelease
+		return semver.CompareWithChannels(vi, vj, channels) > 0
 	})
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/indaco/sley/internal/clix"
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/testutils"
@@ -28,8 +29,11 @@ type mockGitTagOps struct {
 	deleteRemoteTag    func(ctx context.Context, name string) error
 	createAnnotatedTag func(ctx context.Context, name, message string) error
 	createLightweight  func(ctx context.Context, name string) error
-	createSignedTag    func(ctx context.Context, name, message, keyID string) error
+	createSignedTag    func(ctx context.Context, name, message string, signing core.TagSigning) error
 	getLatestTag       func(ctx context.Context) (string, error)
+	verifyTag          func(ctx context.Context, name string, signing core.TagSigning) (string, error)
+	moveTag            func(ctx context.Context, name, target string) (string, error)
+	forcePushTag       func(ctx context.Context, name, expected string) error
 }
 
 func (m *mockGitTagOps) TagExists(ctx context.Context, name string) (bool, error) {
@@ -81,9 +85,30 @@ func (m *mockGitTagOps) CreateLightweightTag(ctx context.Context, name string) e
 	return nil
 }
 
-func (m *mockGitTagOps) CreateSignedTag(ctx context.Context, name, message, keyID string) error {
+func (m *mockGitTagOps) CreateSignedTag(ctx context.Context, name, message string, signing core.TagSigning) error {
 	if m.createSignedTag != nil {
-		return m.createSignedTag(ctx, name, message, keyID)
+		return m.createSignedTag(ctx, name, message, signing)
+	}
+	return nil
+}
+
+func (m *mockGitTagOps) VerifyTag(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+	if m.verifyTag != nil {
+		return m.verifyTag(ctx, name, signing)
+	}
+	return "", nil
+}
+
+func (m *mockGitTagOps) MoveTag(ctx context.Context, name, target string) (string, error) {
+	if m.moveTag != nil {
+		return m.moveTag(ctx, name, target)
+	}
+	return "", nil
+}
+
+func (m *mockGitTagOps) ForcePushTag(ctx context.Context, name, expected string) error {
+	if m.forcePushTag != nil {
+		return m.forcePushTag(ctx, name, expected)
 	}
 	return nil
 }
@@ -95,6 +120,50 @@ func (m *mockGitTagOps) GetLatestTag(ctx context.Context) (string, error) {
 	return "", nil
 }
 
+// mockGitTagReader is a mock implementation of core.GitTagReader for testing.
+type mockGitTagReader struct {
+	showTag        func(ctx context.Context, name string) (core.TagDetails, error)
+	listTagRefs    func(ctx context.Context, pattern string) ([]core.TagRef, error)
+	listRemoteTags func(ctx context.Context) ([]string, error)
+	commitsBetween func(ctx context.Context, from, to string) ([]string, error)
+	changedFiles   func(ctx context.Context, from, to string) ([]string, error)
+}
+
+func (m *mockGitTagReader) ShowTag(ctx context.Context, name string) (core.TagDetails, error) {
+	if m.showTag != nil {
+		return m.showTag(ctx, name)
+	}
+	return core.TagDetails{Name: name}, nil
+}
+
+func (m *mockGitTagReader) ListTagRefs(ctx context.Context, pattern string) ([]core.TagRef, error) {
+	if m.listTagRefs != nil {
+		return m.listTagRefs(ctx, pattern)
+	}
+	return nil, nil
+}
+
+func (m *mockGitTagReader) ListRemoteTags(ctx context.Context) ([]string, error) {
+	if m.listRemoteTags != nil {
+		return m.listRemoteTags(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockGitTagReader) CommitsBetween(ctx context.Context, from, to string) ([]string, error) {
+	if m.commitsBetween != nil {
+		return m.commitsBetween(ctx, from, to)
+	}
+	return nil, nil
+}
+
+func (m *mockGitTagReader) ChangedFiles(ctx context.Context, from, to string) ([]string, error) {
+	if m.changedFiles != nil {
+		return m.changedFiles(ctx, from, to)
+	}
+	return nil, nil
+}
+
 func TestGetVersionPath(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -302,7 +371,7 @@ func TestSortTagsBySemver(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sortTagsBySemver(tt.tags, tt.prefix)
+			sortTagsBySemver(tt.tags, tt.prefix, nil)
 			if len(tt.tags) != len(tt.want) {
 				t.Fatalf("sortTagsBySemver() len = %v, want %v", len(tt.tags), len(tt.want))
 			}
@@ -315,6 +384,31 @@ func TestSortTagsBySemver(t *testing.T) {
 	}
 }
 
+func TestSortTagsBySemver_Channels(t *testing.T) {
+	tags := []string{"v1.0.0-nightly.9", "v1.0.0-rc.1", "v1.0.0-beta.10", "v1.0.0-beta.2", "v1.0.0"}
+	sortTagsBySemver(tags, "v", []string{"nightly", "beta", "rc"})
+
+	want := []string{"v1.0.0", "v1.0.0-rc.1", "v1.0.0-beta.10", "v1.0.0-beta.2", "v1.0.0-nightly.9"}
+	for i := range want {
+		if tags[i] != want[i] {
+			t.Errorf("sortTagsBySemver()[%d] = %v, want %v", i, tags[i], want[i])
+		}
+	}
+}
+
+func TestSortTagsBySemver_UnknownChannels(t *testing.T) {
+	// beta and canary are not configured: they rank after dev and alpha.
+	tags := []string{"v1.0.0-beta.1", "v1.0.0-dev.3", "v1.0.0-canary.1", "v1.0.0-alpha.2", "v0.9.0", "v1.0.0-beta.2"}
+	sortTagsBySemver(tags, "v", []string{"dev", "alpha"})
+
+	want := []string{"v1.0.0-canary.1", "v1.0.0-beta.2", "v1.0.0-beta.1", "v1.0.0-alpha.2", "v1.0.0-dev.3", "v0.9.0"}
+	for i := range want {
+		if tags[i] != want[i] {
+			t.Errorf("sortTagsBySemver()[%d] = %v, want %v", i, tags[i], want[i])
+		}
+	}
+}
+
 func TestParseVersionFromTag(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -817,15 +911,21 @@ func TestRunCommand(t *testing.T) {
 		t.Errorf("Run().Name = %v, want %v", cmd.Name, "tag")
 	}
 
-	if len(cmd.Commands) != 4 {
-		t.Errorf("Run().Commands len = %v, want 4", len(cmd.Commands))
+	if len(cmd.Commands) != 10 {
+		t.Errorf("Run().Commands len = %v, want 10", len(cmd.Commands))
 	}
 
 	expectedSubcommands := map[string]bool{
-		"create": false,
-		"list":   false,
-		"push":   false,
-		"delete": false,
+		"create":    false,
+		"list":      false,
+		"push":      false,
+		"delete":    false,
+		"verify":    false,
+		"aliases":   false,
+		"show":      false,
+		"diff":      false,
+		"reconcile": false,
+		"prune":     false,
 	}
 
 	for _, subcmd := range cmd.Commands {
@@ -844,10 +944,10 @@ func TestRunCommand(t *testing.T) {
 func TestCreateTag_Signed(t *testing.T) {
 	var signedTag, signedMessage, signedKeyID string
 	mockOps := &mockGitTagOps{
-		createSignedTag: func(ctx context.Context, name, message, keyID string) error {
+		createSignedTag: func(ctx context.Context, name, message string, signing core.TagSigning) error {
 			signedTag = name
 			signedMessage = message
-			signedKeyID = keyID
+			signedKeyID = signing.Key
 			return nil
 		},
 	}
@@ -899,7 +999,7 @@ func TestCreateTag_Lightweight(t *testing.T) {
 
 func TestCreateTag_SignedError(t *testing.T) {
 	mockOps := &mockGitTagOps{
-		createSignedTag: func(ctx context.Context, name, message, keyID string) error {
+		createSignedTag: func(ctx context.Context, name, message string, signing core.TagSigning) error {
 			return fmt.Errorf("gpg signing failed")
 		},
 	}
@@ -2111,7 +2211,7 @@ func TestSortTagsBySemver_MultipleModulePrefixes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sortTagsBySemver(tt.tags, tt.prefix)
+			sortTagsBySemver(tt.tags, tt.prefix, nil)
 			if len(tt.tags) != len(tt.want) {
 				t.Fatalf("sortTagsBySemver() len = %v, want %v", len(tt.tags), len(tt.want))
 			}
@@ -2156,3 +2256,224 @@ func TestResolveModuleConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRunVerifyCmd(t *testing.T) {
+	cfg := &config.Config{
+		Plugins: &config.PluginConfig{
+			TagManager: &config.TagManagerConfig{
+				Enabled:            true,
+				Sign:               true,
+				SigningFormat:      "ssh",
+				AllowedSignersFile: ".allowed_signers",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		exists      bool
+		verifyErr   error
+		wantSigners string
+		wantErr     string
+	}{
+		{name: "valid signature", args: []string{"v1.0.0"}, exists: true, wantSigners: ".allowed_signers"},
+		{
+			name:        "allowed signers override",
+			args:        []string{"--allowed-signers", "signers.txt", "v1.0.0"},
+			exists:      true,
+			wantSigners: "signers.txt",
+		},
+		{name: "missing tag", args: []string{"v1.0.0"}, wantErr: "tag v1.0.0 does not exist locally"},
+		{
+			name:      "invalid signature",
+			args:      []string{"v1.0.0"},
+			exists:    true,
+			verifyErr: fmt.Errorf("error: no signature found"),
+			wantErr:   "tag v1.0.0 has no valid signature: error: no signature found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotSigning core.TagSigning
+			mockOps := &mockGitTagOps{
+				tagExists: func(ctx context.Context, name string) (bool, error) {
+					return tt.exists, nil
+				},
+				verifyTag: func(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+					gotSigning = signing
+					return "Good \"git\" signature", tt.verifyErr
+				},
+			}
+			tc := NewTagCommand(mockOps)
+			app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.verifyCmd(cfg)}}
+
+			var err error
+			_, _ = testutils.CaptureStdout(func() {
+				err = app.Run(context.Background(), append([]string{"test", "verify"}, tt.args...))
+			})
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("runVerifyCmd() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runVerifyCmd() unexpected error: %v", err)
+			}
+			if gotSigning.Format != "ssh" || gotSigning.AllowedSignersFile != tt.wantSigners {
+				t.Errorf("runVerifyCmd() signing = %+v, want ssh with %q", gotSigning, tt.wantSigners)
+			}
+		})
+	}
+}
+
+func TestRunVerifyCmd_CurrentVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+	testutils.WriteFile(t, versionPath, "1.2.3\n", 0o644)
+
+	var verified string
+	mockOps := &mockGitTagOps{
+		tagExists: func(ctx context.Context, name string) (bool, error) {
+			return true, nil
+		},
+		verifyTag: func(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+			verified = name
+			return "", nil
+		},
+	}
+	tc := NewTagCommand(mockOps)
+	cfg := &config.Config{Path: versionPath}
+	app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.verifyCmd(cfg)}}
+
+	var err error
+	_, _ = testutils.CaptureStdout(func() {
+		err = app.Run(context.Background(), []string{"test", "verify"})
+	})
+	if err != nil {
+		t.Fatalf("runVerifyCmd() unexpected error: %v", err)
+	}
+	if verified != "v1.2.3" {
+		t.Errorf("runVerifyCmd() verified tag = %q, want v1.2.3", verified)
+	}
+}
+
+func TestRunAliasesCmd(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+	testutils.WriteFile(t, versionPath, "1.4.2\n", 0o644)
+
+	cfg := &config.Config{
+		Path: versionPath,
+		Plugins: &config.PluginConfig{
+			TagManager: &config.TagManagerConfig{
+				Enabled: true,
+				Aliases: []string{"major", "latest"},
+			},
+		},
+	}
+
+	newOps := func(moved map[string]string, pushed *[]string) *mockGitTagOps {
+		return &mockGitTagOps{
+			listTags: func(ctx context.Context, pattern string) ([]string, error) {
+				return []string{"v1.3.0", "v1.4.2", "v2.0.0-rc.1"}, nil
+			},
+			moveTag: func(ctx context.Context, name, target string) (string, error) {
+				moved[name] = target
+				return "", nil
+			},
+			forcePushTag: func(ctx context.Context, name, expected string) error {
+				*pushed = append(*pushed, name)
+				return nil
+			},
+		}
+	}
+
+	t.Run("list", func(t *testing.T) {
+		moved := map[string]string{}
+		var pushed []string
+		tc := NewTagCommand(newOps(moved, &pushed))
+		app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.aliasesCmd(cfg)}}
+
+		output, err := testutils.CaptureStdout(func() {
+			if err := app.Run(context.Background(), []string{"test", "aliases"}); err != nil {
+				t.Errorf("runAliasesCmd() unexpected error: %v", err)
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output != "latest -> v1.4.2\nv1 -> v1.4.2" {
+			t.Errorf("runAliasesCmd() output = %q", output)
+		}
+		if len(moved) != 0 {
+			t.Errorf("runAliasesCmd() moved %v without --repair", moved)
+		}
+	})
+
+	t.Run("repair and push", func(t *testing.T) {
+		moved := map[string]string{}
+		var pushed []string
+		tc := NewTagCommand(newOps(moved, &pushed))
+		app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.aliasesCmd(cfg)}}
+
+		var err error
+		_, _ = testutils.CaptureStdout(func() {
+			err = app.Run(context.Background(), []string{"test", "aliases", "--repair", "--push"})
+		})
+		if err != nil {
+			t.Fatalf("runAliasesCmd() unexpected error: %v", err)
+		}
+		if moved["v1"] != "v1.4.2" || moved["latest"] != "v1.4.2" || len(moved) != 2 {
+			t.Errorf("runAliasesCmd() moved = %v", moved)
+		}
+		if len(pushed) != 2 {
+			t.Errorf("runAliasesCmd() pushed = %v, want 2 aliases", pushed)
+		}
+	})
+}
+
+func TestRunCreateCmd_MovesAliases(t *testing.T) {
+	tmpDir := t.TempDir()
+	versionPath := filepath.Join(tmpDir, ".version")
+	testutils.WriteFile(t, versionPath, "1.5.0\n", 0o644)
+
+	var moved []string
+	mockOps := &mockGitTagOps{
+		listTags: func(ctx context.Context, pattern string) ([]string, error) {
+			return []string{"v1.4.2", "v1.5.0"}, nil
+		},
+		moveTag: func(ctx context.Context, name, target string) (string, error) {
+			if target != "v1.5.0" {
+				t.Errorf("MoveTag(%s) target = %q, want v1.5.0", name, target)
+			}
+			moved = append(moved, name)
+			return "", nil
+		},
+	}
+	cfg := &config.Config{
+		Path: versionPath,
+		Plugins: &config.PluginConfig{
+			TagManager: &config.TagManagerConfig{
+				Enabled: true,
+				Aliases: []string{"major", "minor"},
+			},
+		},
+	}
+	tc := NewTagCommand(mockOps)
+	app := &cli.Command{Name: "test", Commands: []*cli.Command{tc.createCmd(cfg)}}
+
+	var err error
+	_, _ = testutils.CaptureStdout(func() {
+		err = app.Run(context.Background(), []string{"test", "create"})
+	})
+	if err != nil {
+		t.Fatalf("runCreateCmd() unexpected error: %v", err)
+	}
+	if len(moved) != 2 || moved[0] != "v1" || moved[1] != "v1.5" {
+		t.Errorf("runCreateCmd() moved aliases = %v, want [v1 v1.5]", moved)
+	}
+}
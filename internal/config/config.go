@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -33,6 +34,124 @@ type Config struct {
 	Extensions      []ExtensionConfig                 `yaml:"extensions,omitempty"`
 	PreReleaseHooks []map[string]PreReleaseHookConfig `yaml:"pre-release-hooks,omitempty"`
 	Workspace       *WorkspaceConfig                  `yaml:"workspace,omitempty"`
+
+	// Source selects where the current version comes from: "file" (default)
+	// reads the .version file, "git-tags" derives it from the newest semver tag.
+	Source string `yaml:"source,omitempty"`
+
+	// Describe configures describe-style versions for untagged commits
+	// when Source is "git-tags".
+	Describe *DescribeConfig `yaml:"describe,omitempty"`
+
+	// Metadata configures build metadata applied automatically by bump, pre and set.
+	Metadata *MetadataConfig `yaml:"metadata,omitempty"`
+
+	// Dev configures the snapshot pre-releases produced by "bump dev".
+	Dev *DevConfig `yaml:"dev,omitempty"`
+
+	// PreReleaseChannels is the ordered pre-release channel progression used by
+	// "bump pre --next-channel", the channel-order rule and tag sorting.
+	PreReleaseChannels []string `yaml:"pre-release-channels,omitempty"`
+
+	// ReleaseLines maps maintenance branches to the release line they ship,
+	// so tag lookups and bumps on e.g. release/1.x stay on 1.x.
+	ReleaseLines []ReleaseLineConfig `yaml:"release-lines,omitempty"`
+}
+
+// ReleaseLineConfig maps a branch pattern to a maintenance release line.
+type ReleaseLineConfig struct {
+	// Branch is a glob matched against the current branch, e.g. "release/*".
+	Branch string `yaml:"branch"`
+
+	// Line is the allowed version line, e.g. "1.x" or "1.3.x". When empty it
+	// is derived from the end of the branch name (release/1.3.x -> 1.3.x).
+	Line string `yaml:"line,omitempty"`
+}
+
+// ReleaseLineForBranch returns the first release line entry whose branch
+// pattern matches branch, or false when branch is not a maintenance branch.
+func (c *Config) ReleaseLineForBranch(branch string) (ReleaseLineConfig, bool) {
+	if c == nil || branch == "" {
+		return ReleaseLineConfig{}, false
+	}
+	for _, rl := range c.ReleaseLines {
+		if matched, err := path.Match(rl.Branch, branch); err == nil && matched {
+			return rl, true
+		}
+	}
+	return ReleaseLineConfig{}, false
+}
+
+// Version sources for the source option.
+const (
+	SourceFile    = "file"
+	SourceGitTags = "git-tags"
+)
+
+// DescribeConfig holds settings for describe-style versions such as
+// 1.4.0-dev.5+g1a2b3c4 (five commits after the last tag).
+type DescribeConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Label is the pre-release label (default "dev").
+	Label string `yaml:"label,omitempty"`
+
+	// Bump is applied to the nearest tag: "patch" (default), "minor" or "major".
+	Bump string `yaml:"bump,omitempty"`
+}
+
+// MetadataConfig holds the build metadata template, e.g. "{commit_short}.{ci_run}".
+// It uses the tag message placeholders plus {commit}, {commit_short},
+// {commit_count}, {branch}, {branch_slug} and {ci_run}.
+type MetadataConfig struct {
+	Template string `yaml:"template"`
+}
+
+// GetMetadataTemplate returns the configured metadata template, or "" if none.
+func (c *Config) GetMetadataTemplate() string {
+	if c == nil || c.Metadata == nil {
+		return ""
+	}
+	return c.Metadata.Template
+}
+
+// DevConfig holds settings for dev snapshot pre-releases such as 1.5.0-dev.20261016.42.
+type DevConfig struct {
+	// Label is the pre-release label (default "dev").
+	Label string `yaml:"label,omitempty"`
+
+	// Bump is applied to a stable current version: "patch", "minor" (default) or "major".
+	Bump string `yaml:"bump,omitempty"`
+}
+
+// GetDevLabel returns the dev pre-release label with default "dev".
+func (c *Config) GetDevLabel() string {
+	if c == nil || c.Dev == nil || c.Dev.Label == "" {
+		return "dev"
+	}
+	return c.Dev.Label
+}
+
+// GetDevBump returns the dev bump type with default "minor".
+func (c *Config) GetDevBump() string {
+	if c == nil || c.Dev == nil || c.Dev.Bump == "" {
+		return "minor"
+	}
+	return c.Dev.Bump
+}
+
+// GetPreReleaseChannels returns the configured pre-release channels with
+// default alpha, beta, rc.
+func (c *Config) GetPreReleaseChannels() []string {
+	if c == nil || len(c.PreReleaseChannels) == 0 {
+		return []string{"alpha", "beta", "rc"}
+	}
+	return c.PreReleaseChannels
+}
+
+// UsesGitTags reports whether the current version is derived from git tags.
+func (c *Config) UsesGitTags() bool {
+	return c.Source == SourceGitTags
 }
 
 // GetTheme returns the configured theme name, defaulting to "sley" if not set.
@@ -213,6 +332,7 @@ var MergePluginConfig = MergeConfig
 // Non-plugin field semantics:
 //   - Path: always root
 //   - Workspace: always root
+//   - Source, Describe, Metadata, Dev: always root
 //   - Theme: module wins if non-empty, else root
 //   - Extensions: additive merge (root + module, dedup by Name, module wins)
 //   - PreReleaseHooks: additive merge (root hooks then module hooks appended)
@@ -236,11 +356,17 @@ func MergeConfig(root, module *Config) *Config {
 	}
 
 	merged := &Config{
-		Path:            root.Path,
-		Theme:           theme,
-		Extensions:      mergeExtensions(root.Extensions, module.Extensions),
-		PreReleaseHooks: mergePreReleaseHooks(root.PreReleaseHooks, module.PreReleaseHooks),
-		Workspace:       root.Workspace,
+		Path:               root.Path,
+		Theme:              theme,
+		Extensions:         mergeExtensions(root.Extensions, module.Extensions),
+		PreReleaseHooks:    mergePreReleaseHooks(root.PreReleaseHooks, module.PreReleaseHooks),
+		Workspace:          root.Workspace,
+		Source:             root.Source,
+		Describe:           root.Describe,
+		Metadata:           root.Metadata,
+		Dev:                root.Dev,
+		PreReleaseChannels: root.PreReleaseChannels,
+		ReleaseLines:       root.ReleaseLines,
 	}
 
 	rootPlugins := root.Plugins
@@ -34,16 +34,28 @@ type TagManagerConfig struct {
 	// Default: false.
 	TagPrereleases *bool `yaml:"tag-prereleases,omitempty"`
 
-	// Sign creates GPG-signed tags using git tag -s.
-	// Requires git to be configured with a GPG signing key.
+	// Sign creates signed tags using git tag -s.
+	// Requires git to be configured with a signing key.
 	// Default: false.
 	Sign bool `yaml:"sign,omitempty"`
 
-	// SigningKey specifies the GPG key ID to use for signing.
+	// SigningFormat is the signature format: gpg, ssh or x509 (default: "gpg").
+	SigningFormat string `yaml:"signing-format,omitempty"`
+
+	// SigningKey specifies the key to sign with: a GPG key ID, an SSH key
+	// path or public key, or an X.509 identity.
 	// If empty, git uses the default signing key from user.signingkey config.
 	// Only used when Sign is true.
 	SigningKey string `yaml:"signing-key,omitempty"`
 
+	// SigningProgram overrides the program git signs and verifies with
+	// (default for x509: "gitsign").
+	SigningProgram string `yaml:"signing-program,omitempty"`
+
+	// AllowedSignersFile is the SSH allowed signers file used to verify tags.
+	// If empty, git uses gpg.ssh.allowedSignersFile.
+	AllowedSignersFile string `yaml:"allowed-signers-file,omitempty"`
+
 	// MessageTemplate is a template for the tag message.
 	// Supports placeholders: {version}, {tag}, {prefix}, {date}, {major}, {minor}, {patch}, {prerelease}, {build}
 	// Default: "Release {version}" for annotated/signed tags.
@@ -53,6 +65,11 @@ type TagManagerConfig struct {
 	// when auto-create is enabled. Supports the same placeholders as message-template.
 	// Default: "chore(release): {tag}".
 	CommitMessageTemplate string `yaml:"commit-message-template,omitempty" json:"commit-message-template,omitempty"`
+
+	// Aliases lists moving alias tags force-moved to each new stable release:
+	// "major" (v1), "minor" (v1.4), "latest", or a custom template using the
+	// message-template placeholders (e.g. "{prefix}{major}-stable").
+	Aliases []string `yaml:"aliases,omitempty"`
 }
 
 // GetAutoCreate returns the auto-create setting with default false.
@@ -92,6 +109,14 @@ func (c *TagManagerConfig) GetSign() bool {
 	return c.Sign
 }
 
+// GetSigningFormat returns the signing format with default "gpg".
+func (c *TagManagerConfig) GetSigningFormat() string {
+	if c.SigningFormat == "" {
+		return "gpg"
+	}
+	return c.SigningFormat
+}
+
 // GetSigningKey returns the signing key.
 func (c *TagManagerConfig) GetSigningKey() string {
 	return c.SigningKey
@@ -160,14 +185,35 @@ type DependencyFileConfig struct {
 	// Path is the file path relative to repository root.
 	Path string `yaml:"path"`
 
-	// Field is the dot-notation path to the version field (for JSON/YAML/TOML).
+	// Field is the dot-notation path to the version field (for JSON/YAML/TOML),
+	// the element path for XML, or the property/constant name for source formats.
 	Field string `yaml:"field,omitempty"`
 
-	// Format specifies the file format: json, yaml, toml, raw, regex
+	// Format specifies the file format: json, yaml, toml, raw, regex, xml,
+	// properties, gradle, ruby, elixir, swift, go or gomod
 	Format string `yaml:"format"`
 
 	// Pattern is the regex pattern for "regex" format.
 	Pattern string `yaml:"pattern,omitempty"`
+
+	// References lists dependency entries whose version constraints follow a
+	// module version (json, toml and gomod formats only).
+	References []DependencyReferenceConfig `yaml:"references,omitempty"`
+
+	// Policy controls how reference constraints are updated:
+	// "preserve" (default) keeps the range operator, "widen" keeps constraints
+	// that already admit the new version, "pin" writes the exact version.
+	Policy string `yaml:"policy,omitempty"`
+}
+
+// DependencyReferenceConfig names a dependency entry in a manifest.
+type DependencyReferenceConfig struct {
+	// Name is the dependency name (e.g. "@acme/core" or "example.com/core").
+	Name string `yaml:"name"`
+
+	// Module is the workspace module whose version the reference follows.
+	// When empty, the reference follows the synced version.
+	Module string `yaml:"module,omitempty"`
 }
 
 // ChangelogParserConfig holds configuration for the changelog parser plugin.
@@ -227,8 +273,14 @@ type ChangelogGeneratorConfig struct {
 	// Format determines the changelog format: "grouped" or "keepachangelog".
 	// "grouped" (default): Custom group labels with commit type grouping
 	// "keepachangelog": Keep a Changelog specification format with standard sections
+	// "template": Renders the Go text/template file set in Template
 	Format string `yaml:"format,omitempty"`
 
+	// Template is the changelog template used by the "template" format: a path to a
+	// Go text/template file, or "builtin:<name>" for one of the built-in templates
+	// (grouped, keepachangelog, github, minimal).
+	Template string `yaml:"template,omitempty"`
+
 	// ChangesDir is the directory for version-specific changelog files (versioned mode).
 	ChangesDir string `yaml:"changes-dir,omitempty"`
 
@@ -274,6 +326,18 @@ type ChangelogGeneratorConfig struct {
 	// Contributors configures the contributors section.
 	Contributors *ContributorsConfig `yaml:"contributors,omitempty"`
 
+	// PullRequests links commits to the pull/merge requests that introduced
+	// them through the GitHub, GitLab or Gitea API.
+	PullRequests *PullRequestsConfig `yaml:"pull-requests,omitempty"`
+
+	// Outputs lists additional unified changelogs rendered from the per-version
+	// release data, each with its own path and format (json, asciidoc, rst, html).
+	Outputs []ChangelogOutputConfig `yaml:"outputs,omitempty"`
+
+	// Routing splits the changelog of a coordinated workspace into one changelog
+	// per module, routing commits by the paths they touch or by their scope.
+	Routing *ChangelogRoutingConfig `yaml:"routing,omitempty"`
+
 	// MergeAfter controls when versioned changelog files are merged into the unified changelog.
 	// Values:
 	// - "immediate" (merge right after generation)
@@ -356,6 +420,97 @@ type CommitGroupConfig struct {
 
 	// Order determines the display order (lower = higher priority).
 	Order int `yaml:"order,omitempty"`
+
+	// Labels lists pull request labels that place a commit in this group,
+	// regardless of its type. Requires pull-requests to be enabled.
+	Labels []string `yaml:"labels,omitempty"`
+}
+
+// ChangelogOutputConfig is an additional changelog output.
+type ChangelogOutputConfig struct {
+	// Path is the output file path (e.g., "docs/changelog.html").
+	Path string `yaml:"path"`
+
+	// Format is the output format: "json", "asciidoc", "rst", or "html".
+	Format string `yaml:"format"`
+}
+
+// ChangelogRoutingConfig configures per-module changelog routing.
+type ChangelogRoutingConfig struct {
+	// Enabled activates routing.
+	Enabled bool `yaml:"enabled"`
+
+	// Modules lists the modules commits are routed to.
+	Modules []ChangelogRouteConfig `yaml:"modules,omitempty"`
+
+	// Aggregate also writes the root changelog, with a subsection per module.
+	// Default: true.
+	Aggregate *bool `yaml:"aggregate,omitempty"`
+
+	// RootLabel is the root changelog subsection for commits that match no module.
+	// Default: "General".
+	RootLabel string `yaml:"root-label,omitempty"`
+}
+
+// GetAggregate returns the aggregate setting with default true.
+func (c *ChangelogRoutingConfig) GetAggregate() bool {
+	if c.Aggregate == nil {
+		return true
+	}
+	return *c.Aggregate
+}
+
+// GetRootLabel returns the root label with default "General".
+func (c *ChangelogRoutingConfig) GetRootLabel() string {
+	if c.RootLabel == "" {
+		return "General"
+	}
+	return c.RootLabel
+}
+
+// ChangelogRouteConfig routes commits to a module changelog.
+type ChangelogRouteConfig struct {
+	// Name is the module name used in the aggregated root changelog.
+	Name string `yaml:"name"`
+
+	// Path is the module directory. Commits touching files below it are routed
+	// to the module.
+	Path string `yaml:"path"`
+
+	// Scopes lists conventional commit scopes routed to the module.
+	Scopes []string `yaml:"scopes,omitempty"`
+
+	// ChangelogPath is the module changelog. Default: "<path>/CHANGELOG.md".
+	ChangelogPath string `yaml:"changelog-path,omitempty"`
+}
+
+// PullRequestsConfig configures pull/merge request lookup for changelog entries.
+type PullRequestsConfig struct {
+	// Enabled controls whether commits are looked up through the forge API.
+	Enabled bool `yaml:"enabled"`
+
+	// BaseURL overrides the API base URL, e.g. "https://github.example.com/api/v3".
+	// Default: derived from the repository provider and host.
+	BaseURL string `yaml:"base-url,omitempty"`
+
+	// TokenEnv is the environment variable holding the API token.
+	// Default: GITHUB_TOKEN, GITLAB_TOKEN or GITEA_TOKEN depending on the provider.
+	TokenEnv string `yaml:"token-env,omitempty"`
+
+	// CachePath is the file where lookups are cached so later runs work offline.
+	// Default: ".sley-cache/pull-requests.json".
+	CachePath string `yaml:"cache-path,omitempty"`
+
+	// Offline disables API requests and only uses cached lookups.
+	Offline bool `yaml:"offline,omitempty"`
+}
+
+// GetCachePath returns the cache path with default ".sley-cache/pull-requests.json".
+func (c *PullRequestsConfig) GetCachePath() string {
+	if c.CachePath == "" {
+		return ".sley-cache/pull-requests.json"
+	}
+	return c.CachePath
 }
 
 // ContributorsConfig configures the contributors section in changelog.
@@ -421,6 +576,28 @@ type ReleaseGateConfig struct {
 
 	// BlockedBranches lists branches where bumps are never allowed.
 	BlockedBranches []string `yaml:"blocked-branches,omitempty"`
+
+	// RequireChangelogLint blocks bumps if the changelog has lint issues.
+	RequireChangelogLint bool `yaml:"require-changelog-lint,omitempty"`
+
+	// ChangelogPath is the changelog checked by require-changelog-lint (default: "CHANGELOG.md").
+	ChangelogPath string `yaml:"changelog-path,omitempty"`
+
+	// RequireSignedTag blocks bumps if the previous release tag is not validly signed.
+	// The signature is checked with the tag-manager signing settings.
+	RequireSignedTag bool `yaml:"require-signed-tag,omitempty"`
+
+	// RequireRCForMajor blocks stable major releases that were not promoted
+	// from a pre-release on the last configured channel (rc by default).
+	RequireRCForMajor bool `yaml:"require-rc-for-major,omitempty"`
+}
+
+// GetChangelogPath returns the changelog path with default "CHANGELOG.md".
+func (c *ReleaseGateConfig) GetChangelogPath() string {
+	if c.ChangelogPath == "" {
+		return "CHANGELOG.md"
+	}
+	return c.ChangelogPath
 }
 
 // AuditLogConfig holds configuration for the audit log plugin.
@@ -445,6 +622,47 @@ type AuditLogConfig struct {
 
 	// IncludeBranch includes current branch name in log entries.
 	IncludeBranch bool `yaml:"include-branch,omitempty"`
+
+	// HashChain stores the hash of the previous entry in each new entry,
+	// making manual edits of the history file detectable.
+	HashChain bool `yaml:"hash-chain,omitempty"`
+
+	// Sinks lists the destinations entries are written to.
+	// When empty, entries are written to a single file using Path and Format.
+	Sinks []AuditLogSinkConfig `yaml:"sinks,omitempty"`
+}
+
+// AuditLogSinkConfig configures a single audit log destination.
+type AuditLogSinkConfig struct {
+	// Type is the sink type: file, jsonl, git-notes, syslog, or webhook.
+	Type string `yaml:"type"`
+
+	// Path is the output file for the file and jsonl sinks.
+	Path string `yaml:"path,omitempty"`
+
+	// Format is the file sink format: json or yaml (default: the plugin format).
+	Format string `yaml:"format,omitempty"`
+
+	// Ref is the notes ref for the git-notes sink (default: "sley-audit").
+	Ref string `yaml:"ref,omitempty"`
+
+	// Tag is the syslog tag (default: "sley").
+	Tag string `yaml:"tag,omitempty"`
+
+	// Network and Address select a remote syslog server (e.g. "udp", "logs.example.com:514").
+	// When empty, the local syslog or journald socket is used.
+	Network string `yaml:"network,omitempty"`
+	Address string `yaml:"address,omitempty"`
+
+	// URL is the endpoint the webhook sink posts entries to.
+	URL string `yaml:"url,omitempty"`
+
+	// Headers are added to webhook requests. Values may reference
+	// environment variables, e.g. "Bearer ${AUDIT_TOKEN}".
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// Timeout is the webhook request timeout (default: "10s").
+	Timeout string `yaml:"timeout,omitempty"`
 }
 
 // GetPath returns the path with default ".version-history.json".
@@ -48,6 +48,9 @@ func (v *Validator) Validate(ctx context.Context) ([]ValidationResult, error) {
 	v.validations = make([]ValidationResult, 0)
 
 	v.validateYAMLSyntax(ctx)
+	v.validateVersionSource()
+	v.validateReleaseLines()
+	v.validateVersionMetadata()
 	v.validatePluginConfigs(ctx)
 	v.validateWorkspaceConfig(ctx)
 	v.validateExtensionConfigs(ctx)
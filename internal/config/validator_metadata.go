@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var validPreReleaseLabel = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// validateVersionMetadata validates the metadata template, dev settings and
+// pre-release channels.
+func (v *Validator) validateVersionMetadata() {
+	if v.cfg == nil {
+		return
+	}
+
+	if v.cfg.Metadata != nil {
+		if v.cfg.Metadata.Template == "" {
+			v.addValidation("Version Metadata", false, "metadata template is empty", false)
+		} else {
+			v.addValidation("Version Metadata", true,
+				fmt.Sprintf("Build metadata template: %s", v.cfg.Metadata.Template), false)
+		}
+	}
+
+	if v.cfg.Dev != nil {
+		if v.cfg.Dev.Bump != "" {
+			valid := map[string]bool{"patch": true, "minor": true, "major": true}
+			v.validateEnum("Version Metadata", "dev bump", v.cfg.Dev.Bump, valid)
+		}
+		if v.cfg.Dev.Label != "" && !validPreReleaseLabel.MatchString(v.cfg.Dev.Label) {
+			v.addValidation("Version Metadata", false,
+				fmt.Sprintf("Invalid dev label '%s': use only letters, digits and '-'", v.cfg.Dev.Label), false)
+		}
+	}
+
+	v.validatePreReleaseChannels()
+}
+
+// validatePreReleaseChannels checks that configured channels are valid, unique labels.
+func (v *Validator) validatePreReleaseChannels() {
+	if len(v.cfg.PreReleaseChannels) == 0 {
+		return
+	}
+
+	seen := make(map[string]bool, len(v.cfg.PreReleaseChannels))
+	for _, channel := range v.cfg.PreReleaseChannels {
+		if !validPreReleaseLabel.MatchString(channel) {
+			v.addValidation("Version Metadata", false,
+				fmt.Sprintf("Invalid pre-release channel '%s': use only letters, digits and '-'", channel), false)
+			return
+		}
+		if seen[channel] {
+			v.addValidation("Version Metadata", false,
+				fmt.Sprintf("Duplicate pre-release channel '%s'", channel), false)
+			return
+		}
+		seen[channel] = true
+	}
+
+	v.addValidation("Version Metadata", true,
+		fmt.Sprintf("Pre-release channels: %s", strings.Join(v.cfg.PreReleaseChannels, " -> ")), false)
+}
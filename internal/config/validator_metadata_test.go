@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+func TestValidator_ValidateVersionMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *Config
+		wantFail bool
+	}{
+		{name: "nothing configured", cfg: &Config{}},
+		{name: "template", cfg: &Config{Metadata: &MetadataConfig{Template: "{commit_short}.{ci_run}"}}},
+		{name: "empty template", cfg: &Config{Metadata: &MetadataConfig{}}, wantFail: true},
+		{name: "dev defaults", cfg: &Config{Dev: &DevConfig{}}},
+		{name: "dev settings", cfg: &Config{Dev: &DevConfig{Label: "nightly", Bump: "patch"}}},
+		{name: "invalid dev bump", cfg: &Config{Dev: &DevConfig{Bump: "build"}}, wantFail: true},
+		{name: "invalid dev label", cfg: &Config{Dev: &DevConfig{Label: "dev.1"}}, wantFail: true},
+		{name: "channels", cfg: &Config{PreReleaseChannels: []string{"alpha", "beta", "rc"}}},
+		{name: "invalid channel", cfg: &Config{PreReleaseChannels: []string{"alpha", "rc.1"}}, wantFail: true},
+		{name: "duplicate channel", cfg: &Config{PreReleaseChannels: []string{"beta", "rc", "beta"}}, wantFail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(core.NewMockFileSystem(), tt.cfg, "", ".")
+
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			var failed bool
+			for _, r := range results {
+				if r.Category == "Version Metadata" && !r.Passed {
+					failed = true
+				}
+			}
+			if failed != tt.wantFail {
+				t.Errorf("failed = %v, want %v", failed, tt.wantFail)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package config
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // validateYAMLSyntax checks if the config file is valid YAML.
@@ -33,7 +35,7 @@ func (v *Validator) validatePluginConfigs(ctx context.Context) {
 	v.validateVersionValidatorConfig()
 	v.validateDependencyCheckConfig(ctx)
 	v.validateChangelogParserConfig(ctx)
-	v.validateChangelogGeneratorConfig()
+	v.validateChangelogGeneratorConfig(ctx)
 	v.validateReleaseGateConfig()
 	v.validateAuditLogConfig()
 }
@@ -62,6 +64,64 @@ func (v *Validator) validateTagManagerConfig() {
 				fmt.Sprintf("Tag prefix '%s' is valid", prefix), false)
 		}
 	}
+
+	v.validateTagSigning(v.cfg.Plugins.TagManager)
+	v.validateTagAliases(v.cfg.Plugins.TagManager)
+}
+
+// validateTagSigning validates the tag-manager signing settings.
+func (v *Validator) validateTagSigning(cfg *TagManagerConfig) {
+	validFormats := map[string]bool{
+		"gpg":  true,
+		"ssh":  true,
+		"x509": true,
+	}
+
+	format := cfg.GetSigningFormat()
+	if !v.validateEnum("Plugin: tag-manager", "signing-format", format, validFormats) {
+		return
+	}
+	if !cfg.GetSign() && cfg.SigningFormat == "" {
+		return
+	}
+
+	v.addValidation("Plugin: tag-manager", true,
+		fmt.Sprintf("Tag signing format: %s", format), false)
+
+	if format == "ssh" && cfg.AllowedSignersFile == "" {
+		v.addValidation("Plugin: tag-manager", true,
+			"SSH signing without allowed-signers-file; verification relies on git's gpg.ssh.allowedSignersFile", true)
+	}
+	if format != "ssh" && cfg.AllowedSignersFile != "" {
+		v.addValidation("Plugin: tag-manager", true,
+			fmt.Sprintf("allowed-signers-file is only used with signing-format 'ssh' (got '%s')", format), true)
+	}
+}
+
+// validateTagAliases validates the tag-manager alias tags.
+func (v *Validator) validateTagAliases(cfg *TagManagerConfig) {
+	if len(cfg.Aliases) == 0 {
+		return
+	}
+
+	valid := true
+	for _, alias := range cfg.Aliases {
+		switch {
+		case strings.TrimSpace(alias) == "":
+			v.addValidation("Plugin: tag-manager", false, "Empty entry in aliases", false)
+			valid = false
+		case strings.ContainsAny(alias, " \t\n\r"):
+			v.addValidation("Plugin: tag-manager", false,
+				fmt.Sprintf("Invalid alias '%s': contains whitespace", alias), false)
+			valid = false
+		}
+	}
+	if !valid {
+		return
+	}
+
+	v.addValidation("Plugin: tag-manager", true,
+		fmt.Sprintf("Alias tags: %s", strings.Join(cfg.Aliases, ", ")), false)
 }
 
 // isWorkspaceEnabled checks if workspace configuration is active,
@@ -99,6 +159,8 @@ var validVersionValidatorRuleTypes = map[string]bool{
 	"no-patch-bump":              true,
 	"max-prerelease-iterations":  true,
 	"require-even-minor":         true,
+	"channel-order":              true,
+	"release-line":               true,
 }
 
 // validateVersionValidatorConfig validates the version-validator plugin configuration.
@@ -191,11 +253,19 @@ func (v *Validator) validateDependencyCheckConfig(ctx context.Context) {
 	}
 
 	validFormats := map[string]bool{
-		"json":  true,
-		"yaml":  true,
-		"toml":  true,
-		"raw":   true,
-		"regex": true,
+		"json":       true,
+		"yaml":       true,
+		"toml":       true,
+		"raw":        true,
+		"regex":      true,
+		"xml":        true,
+		"properties": true,
+		"gradle":     true,
+		"ruby":       true,
+		"elixir":     true,
+		"swift":      true,
+		"go":         true,
+		"gomod":      true,
 	}
 
 	for i, file := range cfg.Files {
@@ -211,12 +281,54 @@ func (v *Validator) validateDependencyCheckConfig(ctx context.Context) {
 		if file.Format == "regex" && file.Pattern != "" {
 			v.validateRegex("Plugin: dependency-check", fmt.Sprintf("File %d", i+1), file.Pattern)
 		}
+
+		if file.Format == "xml" && file.Field == "" {
+			v.addValidation("Plugin: dependency-check", false,
+				fmt.Sprintf("File %d: xml format requires a field (e.g. 'project/version')", i+1), false)
+		}
+
+		v.validateDependencyReferences(label, file)
 	}
 
 	v.addValidation("Plugin: dependency-check", true,
 		fmt.Sprintf("Configured to check %d file(s)", len(cfg.Files)), false)
 }
 
+// validateDependencyReferences validates the references and policy of a
+// dependency-check file entry.
+func (v *Validator) validateDependencyReferences(label string, file DependencyFileConfig) {
+	if len(file.References) == 0 {
+		if file.Format == "gomod" {
+			v.addValidation("Plugin: dependency-check", false,
+				fmt.Sprintf("%s: gomod format requires references", label), false)
+		}
+		return
+	}
+
+	switch file.Format {
+	case "json", "toml", "gomod":
+	default:
+		v.addValidation("Plugin: dependency-check", false,
+			fmt.Sprintf("%s: references are not supported for %s format (use json, toml or gomod)", label, file.Format), false)
+	}
+
+	for j, ref := range file.References {
+		if ref.Name == "" {
+			v.addValidation("Plugin: dependency-check", false,
+				fmt.Sprintf("%s: reference %d has no name", label, j+1), false)
+		}
+	}
+
+	if file.Policy != "" {
+		validPolicies := map[string]bool{
+			"preserve": true,
+			"widen":    true,
+			"pin":      true,
+		}
+		v.validateEnum("Plugin: dependency-check", label+" policy", file.Policy, validPolicies)
+	}
+}
+
 // validateChangelogParserConfig validates the changelog-parser plugin configuration.
 func (v *Validator) validateChangelogParserConfig(ctx context.Context) {
 	if v.cfg.Plugins.ChangelogParser == nil || !v.cfg.Plugins.ChangelogParser.Enabled {
@@ -258,7 +370,7 @@ func (v *Validator) validateChangelogParserConfig(ctx context.Context) {
 }
 
 // validateChangelogGeneratorConfig validates the changelog-generator plugin configuration.
-func (v *Validator) validateChangelogGeneratorConfig() {
+func (v *Validator) validateChangelogGeneratorConfig(ctx context.Context) {
 	if v.cfg.Plugins.ChangelogGenerator == nil || !v.cfg.Plugins.ChangelogGenerator.Enabled {
 		return
 	}
@@ -279,8 +391,12 @@ func (v *Validator) validateChangelogGeneratorConfig() {
 		"keepachangelog": true,
 		"github":         true,
 		"minimal":        true,
+		"template":       true,
 	}
 	v.validateEnum("Plugin: changelog-generator", "format", cfg.GetFormat(), validFormats)
+	if cfg.GetFormat() == "template" {
+		v.validateChangelogTemplate(ctx, cfg.Template)
+	}
 
 	// Validate merge-after
 	validMergeAfter := map[string]bool{
@@ -300,6 +416,16 @@ func (v *Validator) validateChangelogGeneratorConfig() {
 		v.validateRegex("Plugin: changelog-generator", fmt.Sprintf("Exclude pattern %d", i+1), pattern)
 	}
 
+	if cfg.PullRequests != nil && cfg.PullRequests.Enabled {
+		v.validatePullRequestsConfig(cfg.PullRequests, cfg.Repository)
+	}
+
+	v.validateChangelogOutputs(cfg.Outputs)
+
+	if cfg.Routing != nil && cfg.Routing.Enabled {
+		v.validateChangelogRouting(cfg.Routing)
+	}
+
 	v.addValidation("Plugin: changelog-generator", true,
 		fmt.Sprintf("Mode: %s, Format: %s", cfg.GetMode(), cfg.GetFormat()), false)
 }
@@ -326,6 +452,108 @@ func (v *Validator) validateRepositoryConfig(repo *RepositoryConfig) {
 	}
 }
 
+// validateChangelogTemplate validates the template setting of the "template" format.
+func (v *Validator) validateChangelogTemplate(ctx context.Context, tmpl string) {
+	if tmpl == "" {
+		v.addValidation("Plugin: changelog-generator", false,
+			"Format 'template' requires the 'template' field", false)
+		return
+	}
+
+	if name, ok := strings.CutPrefix(tmpl, "builtin:"); ok {
+		validBuiltins := map[string]bool{
+			"grouped":        true,
+			"keepachangelog": true,
+			"github":         true,
+			"minimal":        true,
+		}
+		v.validateEnum("Plugin: changelog-generator", "built-in template", name, validBuiltins)
+		return
+	}
+
+	v.validateFileExists(ctx, "Plugin: changelog-generator", "Changelog template", tmpl)
+}
+
+// validatePullRequestsConfig validates pull request lookup for changelog generator.
+func (v *Validator) validatePullRequestsConfig(prs *PullRequestsConfig, repo *RepositoryConfig) {
+	if repo != nil && repo.Provider != "" {
+		supported := map[string]bool{"github": true, "gitlab": true, "gitea": true, "codeberg": true}
+		if !supported[repo.Provider] {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Pull request lookup is not supported for provider '%s'", repo.Provider), false)
+		}
+	}
+
+	if prs.BaseURL != "" {
+		u, err := url.Parse(prs.BaseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Invalid pull-requests base-url '%s': must be an http(s) URL", prs.BaseURL), false)
+		}
+	}
+}
+
+// validateChangelogOutputs validates the additional changelog outputs.
+func (v *Validator) validateChangelogOutputs(outputs []ChangelogOutputConfig) {
+	validFormats := map[string]bool{
+		"json":     true,
+		"asciidoc": true,
+		"rst":      true,
+		"html":     true,
+	}
+	seen := make(map[string]bool)
+	for i, out := range outputs {
+		if out.Path == "" {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Output %d requires the 'path' field", i+1), false)
+		} else if seen[out.Path] {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Output path '%s' is used more than once", out.Path), false)
+		}
+		seen[out.Path] = true
+		v.validateEnum("Plugin: changelog-generator", fmt.Sprintf("output %d format", i+1), out.Format, validFormats)
+	}
+}
+
+// validateChangelogRouting validates per-module changelog routing.
+func (v *Validator) validateChangelogRouting(routing *ChangelogRoutingConfig) {
+	if len(routing.Modules) == 0 {
+		v.addValidation("Plugin: changelog-generator", false,
+			"Routing requires at least one module", false)
+		return
+	}
+
+	names := make(map[string]bool)
+	scopes := make(map[string]string)
+	for i, route := range routing.Modules {
+		if route.Name == "" {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Routing module %d requires the 'name' field", i+1), false)
+		} else if names[route.Name] {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Routing module '%s' is defined more than once", route.Name), false)
+		}
+		names[route.Name] = true
+
+		if route.Path == "" && len(route.Scopes) == 0 {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Routing module '%s' requires a 'path' or 'scopes'", route.Name), false)
+		}
+		if route.Path == "" && route.ChangelogPath == "" {
+			v.addValidation("Plugin: changelog-generator", false,
+				fmt.Sprintf("Routing module '%s' without a 'path' requires 'changelog-path'", route.Name), false)
+		}
+
+		for _, scope := range route.Scopes {
+			if other, ok := scopes[scope]; ok && other != route.Name {
+				v.addValidation("Plugin: changelog-generator", true,
+					fmt.Sprintf("Scope '%s' is routed to both '%s' and '%s'", scope, other, route.Name), true)
+			}
+			scopes[scope] = route.Name
+		}
+	}
+}
+
 // validateReleaseGateConfig validates the release-gate plugin configuration.
 func (v *Validator) validateReleaseGateConfig() {
 	if v.cfg.Plugins.ReleaseGate == nil || !v.cfg.Plugins.ReleaseGate.Enabled {
@@ -339,6 +567,16 @@ func (v *Validator) validateReleaseGateConfig() {
 			"Both allowed and blocked branches configured (blocked takes precedence)", true)
 	}
 
+	if cfg.RequireSignedTag {
+		v.addValidation("Plugin: release-gate", true,
+			"Previous release tag must be validly signed", false)
+	}
+
+	if cfg.RequireChangelogLint {
+		v.addValidation("Plugin: release-gate", true,
+			fmt.Sprintf("Changelog lint required for %s", cfg.GetChangelogPath()), false)
+	}
+
 	v.addValidation("Plugin: release-gate", true,
 		"Release gate configuration is valid", false)
 }
@@ -361,4 +599,48 @@ func (v *Validator) validateAuditLogConfig() {
 		v.addValidation("Plugin: audit-log", true,
 			fmt.Sprintf("Audit log format: %s", format), false)
 	}
+
+	for i, sink := range cfg.Sinks {
+		v.validateAuditLogSink(i, sink)
+	}
+}
+
+// validateAuditLogSink validates a single audit-log sink.
+func (v *Validator) validateAuditLogSink(index int, sink AuditLogSinkConfig) {
+	validTypes := map[string]bool{
+		"file":      true,
+		"jsonl":     true,
+		"git-notes": true,
+		"syslog":    true,
+		"webhook":   true,
+	}
+
+	field := fmt.Sprintf("sink %d type", index+1)
+	if !v.validateEnum("Plugin: audit-log", field, sink.Type, validTypes) {
+		return
+	}
+
+	switch sink.Type {
+	case "file":
+		if sink.Format != "" {
+			v.validateEnum("Plugin: audit-log", fmt.Sprintf("sink %d format", index+1), sink.Format,
+				map[string]bool{"json": true, "yaml": true})
+		}
+	case "webhook":
+		if sink.URL == "" {
+			v.addValidation("Plugin: audit-log", false,
+				fmt.Sprintf("Sink %d (webhook) requires 'url'", index+1), false)
+		}
+		if sink.Timeout != "" {
+			if _, err := time.ParseDuration(sink.Timeout); err != nil {
+				v.addValidation("Plugin: audit-log", false,
+					fmt.Sprintf("Sink %d (webhook) has invalid timeout %q", index+1, sink.Timeout), false)
+			}
+		}
+	case "syslog":
+		if (sink.Network == "") != (sink.Address == "") {
+			v.addValidation("Plugin: audit-log", false,
+				fmt.Sprintf("Sink %d (syslog) requires both 'network' and 'address' for a remote server", index+1), false)
+		}
+	}
 }
@@ -152,6 +152,118 @@ func TestValidator_ValidateTagManagerConfig(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateTagSigning(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		tagManager  *TagManagerConfig
+		wantFail    bool
+		wantWarning bool
+	}{
+		{
+			name:       "ssh with allowed signers",
+			tagManager: &TagManagerConfig{Enabled: true, Sign: true, SigningFormat: "ssh", AllowedSignersFile: ".allowed_signers"},
+		},
+		{
+			name:        "ssh without allowed signers",
+			tagManager:  &TagManagerConfig{Enabled: true, Sign: true, SigningFormat: "ssh"},
+			wantWarning: true,
+		},
+		{
+			name:        "allowed signers with gpg",
+			tagManager:  &TagManagerConfig{Enabled: true, Sign: true, AllowedSignersFile: ".allowed_signers"},
+			wantWarning: true,
+		},
+		{
+			name:       "x509",
+			tagManager: &TagManagerConfig{Enabled: true, Sign: true, SigningFormat: "x509"},
+		},
+		{
+			name:       "invalid format",
+			tagManager: &TagManagerConfig{Enabled: true, Sign: true, SigningFormat: "pgp"},
+			wantFail:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			cfg := &Config{Plugins: &PluginConfig{TagManager: tt.tagManager}}
+			validator := NewValidator(core.NewMockFileSystem(), cfg, "", ".")
+
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			var failed, warned bool
+			for _, r := range results {
+				if r.Category != "Plugin: tag-manager" {
+					continue
+				}
+				if !r.Passed {
+					failed = true
+				}
+				if r.Warning {
+					warned = true
+				}
+			}
+			if failed != tt.wantFail {
+				t.Errorf("failed = %v, want %v", failed, tt.wantFail)
+			}
+			if warned != tt.wantWarning {
+				t.Errorf("warning = %v, want %v", warned, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateTagAliases(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		aliases     []string
+		wantFail    bool
+		wantMessage string
+	}{
+		{name: "built-in and custom", aliases: []string{"major", "minor", "latest", "{prefix}{major}-stable"}, wantMessage: "Alias tags: major, minor, latest, {prefix}{major}-stable"},
+		{name: "empty entry", aliases: []string{"major", ""}, wantFail: true},
+		{name: "whitespace", aliases: []string{"latest stable"}, wantFail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			cfg := &Config{Plugins: &PluginConfig{TagManager: &TagManagerConfig{Enabled: true, Aliases: tt.aliases}}}
+			validator := NewValidator(core.NewMockFileSystem(), cfg, "", ".")
+
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			var failed, found bool
+			for _, r := range results {
+				if r.Category != "Plugin: tag-manager" {
+					continue
+				}
+				if !r.Passed {
+					failed = true
+				}
+				if r.Message == tt.wantMessage {
+					found = true
+				}
+			}
+			if failed != tt.wantFail {
+				t.Errorf("failed = %v, want %v", failed, tt.wantFail)
+			}
+			if tt.wantMessage != "" && !found {
+				t.Errorf("expected message %q", tt.wantMessage)
+			}
+		})
+	}
+}
+
 func TestValidator_ValidateVersionValidatorConfig(t *testing.T) {
 
 	tests := []struct {
@@ -328,6 +440,111 @@ func TestValidator_ValidateDependencyCheckConfig(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "xml format without field",
+			config: &Config{
+				Plugins: &PluginConfig{
+					DependencyCheck: &DependencyCheckConfig{
+						Enabled: true,
+						Files: []DependencyFileConfig{
+							{
+								Path:   "pom.xml",
+								Format: "xml",
+							},
+						},
+					},
+				},
+			},
+			setupFS: func(ctx context.Context, fs *core.MockFileSystem) {
+				_ = fs.WriteFile(ctx, "pom.xml", []byte("<project/>"), 0644)
+			},
+			wantError: true,
+		},
+		{
+			name: "references with valid policy",
+			config: &Config{
+				Plugins: &PluginConfig{
+					DependencyCheck: &DependencyCheckConfig{
+						Enabled: true,
+						Files: []DependencyFileConfig{
+							{
+								Path:       "go.mod",
+								Format:     "gomod",
+								References: []DependencyReferenceConfig{{Name: "example.com/core", Module: "core"}},
+								Policy:     "pin",
+							},
+						},
+					},
+				},
+			},
+			setupFS: func(ctx context.Context, fs *core.MockFileSystem) {
+				_ = fs.WriteFile(ctx, "go.mod", []byte("module x\n"), 0644)
+			},
+			wantError: false,
+		},
+		{
+			name: "references with invalid policy",
+			config: &Config{
+				Plugins: &PluginConfig{
+					DependencyCheck: &DependencyCheckConfig{
+						Enabled: true,
+						Files: []DependencyFileConfig{
+							{
+								Path:       "package.json",
+								Format:     "json",
+								References: []DependencyReferenceConfig{{Name: "@acme/core"}},
+								Policy:     "loose",
+							},
+						},
+					},
+				},
+			},
+			setupFS: func(ctx context.Context, fs *core.MockFileSystem) {
+				_ = fs.WriteFile(ctx, "package.json", []byte("{}"), 0644)
+			},
+			wantError: true,
+		},
+		{
+			name: "references with unsupported format",
+			config: &Config{
+				Plugins: &PluginConfig{
+					DependencyCheck: &DependencyCheckConfig{
+						Enabled: true,
+						Files: []DependencyFileConfig{
+							{
+								Path:       "Chart.yaml",
+								Format:     "yaml",
+								References: []DependencyReferenceConfig{{Name: "core"}},
+							},
+						},
+					},
+				},
+			},
+			setupFS: func(ctx context.Context, fs *core.MockFileSystem) {
+				_ = fs.WriteFile(ctx, "Chart.yaml", []byte("version: 1.0.0\n"), 0644)
+			},
+			wantError: true,
+		},
+		{
+			name: "gomod format without references",
+			config: &Config{
+				Plugins: &PluginConfig{
+					DependencyCheck: &DependencyCheckConfig{
+						Enabled: true,
+						Files: []DependencyFileConfig{
+							{
+								Path:   "go.mod",
+								Format: "gomod",
+							},
+						},
+					},
+				},
+			},
+			setupFS: func(ctx context.Context, fs *core.MockFileSystem) {
+				_ = fs.WriteFile(ctx, "go.mod", []byte("module x\n"), 0644)
+			},
+			wantError: true,
+		},
 		{
 			name: "regex format with invalid pattern",
 			config: &Config{
@@ -536,6 +753,78 @@ func TestValidator_ValidateAuditLogConfig(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "valid sinks",
+			config: &Config{
+				Plugins: &PluginConfig{
+					AuditLog: &AuditLogConfig{
+						Enabled: true,
+						Sinks:   []AuditLogSinkConfig{{Type: "jsonl"}, {Type: "git-notes"}, {Type: "syslog"}, {Type: "webhook", URL: "https://example.com", Timeout: "5s"}},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "unknown sink type",
+			config: &Config{
+				Plugins: &PluginConfig{
+					AuditLog: &AuditLogConfig{
+						Enabled: true,
+						Sinks:   []AuditLogSinkConfig{{Type: "kafka"}},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "webhook sink without url",
+			config: &Config{
+				Plugins: &PluginConfig{
+					AuditLog: &AuditLogConfig{
+						Enabled: true,
+						Sinks:   []AuditLogSinkConfig{{Type: "webhook"}},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "webhook sink with invalid timeout",
+			config: &Config{
+				Plugins: &PluginConfig{
+					AuditLog: &AuditLogConfig{
+						Enabled: true,
+						Sinks:   []AuditLogSinkConfig{{Type: "webhook", URL: "https://example.com", Timeout: "soon"}},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "file sink with invalid format",
+			config: &Config{
+				Plugins: &PluginConfig{
+					AuditLog: &AuditLogConfig{
+						Enabled: true,
+						Sinks:   []AuditLogSinkConfig{{Type: "file", Format: "xml"}},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "syslog sink with address only",
+			config: &Config{
+				Plugins: &PluginConfig{
+					AuditLog: &AuditLogConfig{
+						Enabled: true,
+						Sinks:   []AuditLogSinkConfig{{Type: "syslog", Address: "logs:514"}},
+					},
+				},
+			},
+			wantError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -696,6 +985,18 @@ func TestValidator_ValidateReleaseGateConfig(t *testing.T) {
 			},
 			wantWarning: true,
 		},
+		{
+			name: "changelog lint required",
+			config: &Config{
+				Plugins: &PluginConfig{
+					ReleaseGate: &ReleaseGateConfig{
+						Enabled:              true,
+						RequireChangelogLint: true,
+					},
+				},
+			},
+			wantWarning: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -889,6 +1190,272 @@ func TestValidator_ValidateChangelogGeneratorCustomProvider(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateChangelogGeneratorTemplate(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		template  string
+		files     []string
+		wantError bool
+	}{
+		{name: "builtin template", template: "builtin:github", wantError: false},
+		{name: "unknown builtin", template: "builtin:fancy", wantError: true},
+		{name: "existing file", template: "changelog.tmpl", files: []string{"changelog.tmpl"}, wantError: false},
+		{name: "missing file", template: "changelog.tmpl", wantError: true},
+		{name: "missing template", template: "", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			for _, f := range tt.files {
+				fs.SetFile(f, []byte("{{.Version}}"))
+			}
+			cfg := &Config{
+				Plugins: &PluginConfig{
+					ChangelogGenerator: &ChangelogGeneratorConfig{
+						Enabled:  true,
+						Format:   "template",
+						Template: tt.template,
+					},
+				},
+			}
+
+			validator := NewValidator(fs, cfg, "", ".")
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			hasError := false
+			for _, r := range results {
+				if r.Category == "Plugin: changelog-generator" && !r.Passed && !r.Warning {
+					hasError = true
+					break
+				}
+			}
+
+			if hasError != tt.wantError {
+				t.Errorf("changelog-generator validation error = %v, want %v", hasError, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateChangelogGeneratorPullRequests(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		repo      *RepositoryConfig
+		prs       *PullRequestsConfig
+		wantError bool
+	}{
+		{
+			name:      "github with base url",
+			repo:      &RepositoryConfig{Provider: "github"},
+			prs:       &PullRequestsConfig{Enabled: true, BaseURL: "https://github.example.com/api/v3"},
+			wantError: false,
+		},
+		{
+			name:      "unsupported provider",
+			repo:      &RepositoryConfig{Provider: "bitbucket"},
+			prs:       &PullRequestsConfig{Enabled: true},
+			wantError: true,
+		},
+		{
+			name:      "invalid base url",
+			prs:       &PullRequestsConfig{Enabled: true, BaseURL: "api.github.com"},
+			wantError: true,
+		},
+		{
+			name:      "disabled is not validated",
+			repo:      &RepositoryConfig{Provider: "bitbucket"},
+			prs:       &PullRequestsConfig{Enabled: false},
+			wantError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Plugins: &PluginConfig{
+					ChangelogGenerator: &ChangelogGeneratorConfig{
+						Enabled:      true,
+						Repository:   tt.repo,
+						PullRequests: tt.prs,
+					},
+				},
+			}
+
+			validator := NewValidator(core.NewMockFileSystem(), cfg, "", ".")
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			hasError := false
+			for _, r := range results {
+				if r.Category == "Plugin: changelog-generator" && !r.Passed && !r.Warning {
+					hasError = true
+					break
+				}
+			}
+
+			if hasError != tt.wantError {
+				t.Errorf("changelog-generator validation error = %v, want %v", hasError, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateChangelogGeneratorOutputs(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		outputs   []ChangelogOutputConfig
+		wantError bool
+	}{
+		{
+			name:      "all formats",
+			outputs:   []ChangelogOutputConfig{{Path: "changelog.json", Format: "json"}, {Path: "CHANGELOG.adoc", Format: "asciidoc"}, {Path: "CHANGELOG.rst", Format: "rst"}, {Path: "changelog.html", Format: "html"}},
+			wantError: false,
+		},
+		{
+			name:      "unknown format",
+			outputs:   []ChangelogOutputConfig{{Path: "CHANGELOG.txt", Format: "text"}},
+			wantError: true,
+		},
+		{
+			name:      "missing path",
+			outputs:   []ChangelogOutputConfig{{Format: "json"}},
+			wantError: true,
+		},
+		{
+			name:      "duplicate path",
+			outputs:   []ChangelogOutputConfig{{Path: "out", Format: "json"}, {Path: "out", Format: "html"}},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Plugins: &PluginConfig{
+					ChangelogGenerator: &ChangelogGeneratorConfig{Enabled: true, Outputs: tt.outputs},
+				},
+			}
+
+			validator := NewValidator(core.NewMockFileSystem(), cfg, "", ".")
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			hasError := false
+			for _, r := range results {
+				if r.Category == "Plugin: changelog-generator" && !r.Passed && !r.Warning {
+					hasError = true
+					break
+				}
+			}
+
+			if hasError != tt.wantError {
+				t.Errorf("changelog-generator validation error = %v, want %v", hasError, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateChangelogGeneratorRouting(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		routing     *ChangelogRoutingConfig
+		wantError   bool
+		wantWarning bool
+	}{
+		{
+			name: "valid",
+			routing: &ChangelogRoutingConfig{Enabled: true, Modules: []ChangelogRouteConfig{
+				{Name: "api", Path: "services/api", Scopes: []string{"api"}},
+				{Name: "docs", Scopes: []string{"docs"}, ChangelogPath: "docs/CHANGELOG.md"},
+			}},
+		},
+		{
+			name:      "no modules",
+			routing:   &ChangelogRoutingConfig{Enabled: true},
+			wantError: true,
+		},
+		{
+			name:      "missing name",
+			routing:   &ChangelogRoutingConfig{Enabled: true, Modules: []ChangelogRouteConfig{{Path: "api"}}},
+			wantError: true,
+		},
+		{
+			name:      "duplicate name",
+			routing:   &ChangelogRoutingConfig{Enabled: true, Modules: []ChangelogRouteConfig{{Name: "api", Path: "a"}, {Name: "api", Path: "b"}}},
+			wantError: true,
+		},
+		{
+			name:      "no path or scopes",
+			routing:   &ChangelogRoutingConfig{Enabled: true, Modules: []ChangelogRouteConfig{{Name: "api"}}},
+			wantError: true,
+		},
+		{
+			name:      "scopes without changelog path",
+			routing:   &ChangelogRoutingConfig{Enabled: true, Modules: []ChangelogRouteConfig{{Name: "api", Scopes: []string{"api"}}}},
+			wantError: true,
+		},
+		{
+			name: "shared scope",
+			routing: &ChangelogRoutingConfig{Enabled: true, Modules: []ChangelogRouteConfig{
+				{Name: "api", Path: "api", Scopes: []string{"core"}},
+				{Name: "web", Path: "web", Scopes: []string{"core"}},
+			}},
+			wantWarning: true,
+		},
+		{
+			name:    "disabled is not validated",
+			routing: &ChangelogRoutingConfig{Enabled: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Plugins: &PluginConfig{
+					ChangelogGenerator: &ChangelogGeneratorConfig{Enabled: true, Routing: tt.routing},
+				},
+			}
+
+			validator := NewValidator(core.NewMockFileSystem(), cfg, "", ".")
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			hasError, hasWarning := false, false
+			for _, r := range results {
+				if r.Category != "Plugin: changelog-generator" {
+					continue
+				}
+				if r.Warning {
+					hasWarning = true
+				} else if !r.Passed {
+					hasError = true
+				}
+			}
+
+			if hasError != tt.wantError {
+				t.Errorf("changelog-generator validation error = %v, want %v", hasError, tt.wantError)
+			}
+			if hasWarning != tt.wantWarning {
+				t.Errorf("changelog-generator validation warning = %v, want %v", hasWarning, tt.wantWarning)
+			}
+		})
+	}
+}
+
 func TestValidator_ModulePathPrefixWarning(t *testing.T) {
 
 	enabled := true
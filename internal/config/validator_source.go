@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+)
+
+// validReleaseLine matches release line specs such as "1.x", "v1.3" or "1.3.x".
+var validReleaseLine = regexp.MustCompile(`^v?\d+(\.(\d+|x|\*))?(\.(x|\*))?$`)
+
+// validateVersionSource validates the source and describe settings.
+func (v *Validator) validateVersionSource() {
+	if v.cfg == nil {
+		return
+	}
+
+	if v.cfg.Source != "" {
+		valid := map[string]bool{SourceFile: true, SourceGitTags: true}
+		if !v.validateEnum("Version Source", "source", v.cfg.Source, valid) {
+			return
+		}
+	}
+
+	if v.cfg.Describe != nil && v.cfg.Describe.Enabled {
+		if v.cfg.Describe.Bump != "" {
+			valid := map[string]bool{"patch": true, "minor": true, "major": true}
+			v.validateEnum("Version Source", "describe bump", v.cfg.Describe.Bump, valid)
+		}
+		if !v.cfg.UsesGitTags() {
+			v.addValidation("Version Source", true,
+				"describe is only used with source: git-tags and will be ignored", true)
+		}
+	}
+
+	if !v.cfg.UsesGitTags() {
+		return
+	}
+
+	tm := v.cfg.Plugins
+	if tm == nil || tm.TagManager == nil || !tm.TagManager.Enabled || !tm.TagManager.GetAutoCreate() {
+		v.addValidation("Version Source", false,
+			"source is git-tags but tag-manager auto-create is disabled; bumps cannot be recorded", false)
+		return
+	}
+
+	v.addValidation("Version Source", true,
+		fmt.Sprintf("Versions are derived from git tags with prefix '%s'", tm.TagManager.GetPrefix()), false)
+}
+
+// validateReleaseLines validates the maintenance branch to release line mappings.
+func (v *Validator) validateReleaseLines() {
+	if v.cfg == nil || len(v.cfg.ReleaseLines) == 0 {
+		return
+	}
+
+	for i, rl := range v.cfg.ReleaseLines {
+		entry := i + 1
+		if rl.Branch == "" {
+			v.addValidation("Release Lines", false,
+				fmt.Sprintf("Release line %d: 'branch' is required", entry), false)
+			continue
+		}
+		if _, err := path.Match(rl.Branch, ""); err != nil {
+			v.addValidation("Release Lines", false,
+				fmt.Sprintf("Release line %d: invalid branch pattern '%s': %v", entry, rl.Branch, err), false)
+			continue
+		}
+		if rl.Line != "" && !validReleaseLine.MatchString(rl.Line) {
+			v.addValidation("Release Lines", false,
+				fmt.Sprintf("Release line %d: invalid line '%s': use a form like 1.x or 1.3.x", entry, rl.Line), false)
+		}
+	}
+
+	v.addValidation("Release Lines", true,
+		fmt.Sprintf("Configured %d maintenance release line(s)", len(v.cfg.ReleaseLines)), false)
+}
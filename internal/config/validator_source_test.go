@@ -0,0 +1,129 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+func TestValidator_ValidateVersionSource(t *testing.T) {
+	autoCreate := true
+	tagManager := &PluginConfig{TagManager: &TagManagerConfig{Enabled: true, AutoCreate: &autoCreate}}
+
+	tests := []struct {
+		name        string
+		cfg         *Config
+		wantFail    bool
+		wantWarning bool
+		wantMessage string
+	}{
+		{name: "default source", cfg: &Config{}},
+		{name: "explicit file", cfg: &Config{Source: SourceFile}},
+		{name: "invalid source", cfg: &Config{Source: "svn"}, wantFail: true},
+		{
+			name:        "git tags with tag manager",
+			cfg:         &Config{Source: SourceGitTags, Plugins: tagManager},
+			wantMessage: "Versions are derived from git tags with prefix 'v'",
+		},
+		{name: "git tags without tag manager", cfg: &Config{Source: SourceGitTags}, wantFail: true},
+		{
+			name:     "invalid describe bump",
+			cfg:      &Config{Source: SourceGitTags, Plugins: tagManager, Describe: &DescribeConfig{Enabled: true, Bump: "build"}},
+			wantFail: true,
+		},
+		{name: "describe without git tags", cfg: &Config{Describe: &DescribeConfig{Enabled: true}}, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(core.NewMockFileSystem(), tt.cfg, "", ".")
+
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			var failed, warned, found bool
+			for _, r := range results {
+				if r.Category != "Version Source" {
+					continue
+				}
+				if !r.Passed {
+					failed = true
+				}
+				if r.Warning {
+					warned = true
+				}
+				if r.Message == tt.wantMessage {
+					found = true
+				}
+			}
+			if failed != tt.wantFail {
+				t.Errorf("failed = %v, want %v", failed, tt.wantFail)
+			}
+			if warned != tt.wantWarning {
+				t.Errorf("warned = %v, want %v", warned, tt.wantWarning)
+			}
+			if tt.wantMessage != "" && !found {
+				t.Errorf("expected message %q", tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateReleaseLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    []ReleaseLineConfig
+		wantFail bool
+	}{
+		{name: "none"},
+		{name: "derived from branch", lines: []ReleaseLineConfig{{Branch: "release/*"}}},
+		{name: "explicit line", lines: []ReleaseLineConfig{{Branch: "maint", Line: "1.3.x"}}},
+		{name: "missing branch", lines: []ReleaseLineConfig{{Line: "1.x"}}, wantFail: true},
+		{name: "invalid pattern", lines: []ReleaseLineConfig{{Branch: "release/["}}, wantFail: true},
+		{name: "invalid line", lines: []ReleaseLineConfig{{Branch: "release/*", Line: "1.3.4"}}, wantFail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(core.NewMockFileSystem(), &Config{ReleaseLines: tt.lines}, "", ".")
+
+			results, err := validator.Validate(context.Background())
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			var failed bool
+			for _, r := range results {
+				if r.Category == "Release Lines" && !r.Passed {
+					failed = true
+				}
+			}
+			if failed != tt.wantFail {
+				t.Errorf("failed = %v, want %v", failed, tt.wantFail)
+			}
+		})
+	}
+}
+
+func TestConfig_ReleaseLineForBranch(t *testing.T) {
+	cfg := &Config{ReleaseLines: []ReleaseLineConfig{
+		{Branch: "release/1.3.x", Line: "1.3"},
+		{Branch: "release/*"},
+	}}
+
+	if rl, ok := cfg.ReleaseLineForBranch("release/1.3.x"); !ok || rl.Line != "1.3" {
+		t.Errorf("ReleaseLineForBranch(release/1.3.x) = %+v, %v", rl, ok)
+	}
+	if rl, ok := cfg.ReleaseLineForBranch("release/2.x"); !ok || rl.Branch != "release/*" {
+		t.Errorf("ReleaseLineForBranch(release/2.x) = %+v, %v", rl, ok)
+	}
+	if _, ok := cfg.ReleaseLineForBranch("main"); ok {
+		t.Error("ReleaseLineForBranch(main) expected no match")
+	}
+	if _, ok := (*Config)(nil).ReleaseLineForBranch("release/1.x"); ok {
+		t.Error("nil config expected no match")
+	}
+}
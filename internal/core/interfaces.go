@@ -5,6 +5,7 @@ package core
 import (
 	"context"
 	"io/fs"
+	"time"
 )
 
 // FileSystem abstracts file system operations for testability.
@@ -102,6 +103,23 @@ type MarshalUnmarshaler interface {
 	Unmarshaler
 }
 
+// TagSigning configures how git tags are signed and verified.
+type TagSigning struct {
+	// Format is the signature format: gpg (default), ssh or x509.
+	Format string
+
+	// Key is the signing key: a GPG key ID, an SSH key path or public key,
+	// or an X.509 identity. If empty, git uses user.signingkey.
+	Key string
+
+	// AllowedSignersFile is the SSH allowed signers file used to verify
+	// signatures. If empty, git uses gpg.ssh.allowedSignersFile.
+	AllowedSignersFile string
+
+	// Program overrides the signing program (e.g. "gitsign" for x509).
+	Program string
+}
+
 // GitTagOperations abstracts git tag operations for testability.
 type GitTagOperations interface {
 	// CreateAnnotatedTag creates an annotated git tag with the given name and message.
@@ -110,9 +128,12 @@ type GitTagOperations interface {
 	// CreateLightweightTag creates a lightweight git tag with the given name.
 	CreateLightweightTag(ctx context.Context, name string) error
 
-	// CreateSignedTag creates a GPG-signed git tag with the given name, message, and optional key ID.
-	// If keyID is empty, git uses the default signing key from user.signingkey config.
-	CreateSignedTag(ctx context.Context, name, message, keyID string) error
+	// CreateSignedTag creates a signed git tag with the given name and message.
+	CreateSignedTag(ctx context.Context, name, message string, signing TagSigning) error
+
+	// VerifyTag verifies the signature of a git tag and returns git's
+	// verification output, which identifies the signer.
+	VerifyTag(ctx context.Context, name string, signing TagSigning) (string, error)
 
 	// TagExists checks if a git tag with the given name exists.
 	TagExists(ctx context.Context, name string) (bool, error)
@@ -131,6 +152,65 @@ type GitTagOperations interface {
 
 	// DeleteRemoteTag deletes a tag from the remote repository.
 	DeleteRemoteTag(ctx context.Context, name string) error
+
+	// MoveTag points the lightweight tag name at the commit target refers to,
+	// replacing any existing tag. It returns the commit the tag pointed at
+	// before, or "" if the tag did not exist.
+	MoveTag(ctx context.Context, name, target string) (string, error)
+
+	// ForcePushTag force-pushes a tag to the remote with a lease: the push is
+	// refused unless the remote tag still points at expected ("" requires
+	// the remote tag to be absent).
+	ForcePushTag(ctx context.Context, name, expected string) error
+}
+
+// TagDetails describes a git tag and the commit it points at.
+type TagDetails struct {
+	Name string
+
+	// Annotated is false for lightweight tags, which have no tagger or message.
+	Annotated bool
+
+	// Tagger is "Name <email>" for annotated tags.
+	Tagger string
+
+	// Date is the tag creation date (the commit date for lightweight tags).
+	Date time.Time
+
+	// Commit is the full hash of the tagged commit.
+	Commit string
+
+	// Message is the tag message, or the commit message for lightweight tags.
+	Message string
+
+	// SignatureFormat is gpg, ssh or x509 for signed tags, "" otherwise.
+	SignatureFormat string
+}
+
+// TagRef is a tag name with its creation date.
+type TagRef struct {
+	Name string
+	Date time.Time
+}
+
+// GitTagReader inspects git tags and the history between them.
+type GitTagReader interface {
+	// ShowTag returns the details of a tag.
+	ShowTag(ctx context.Context, name string) (TagDetails, error)
+
+	// ListTagRefs returns the local tags matching a glob pattern with their dates.
+	ListTagRefs(ctx context.Context, pattern string) ([]TagRef, error)
+
+	// ListRemoteTags returns the tag names present on the remote.
+	ListRemoteTags(ctx context.Context) ([]string, error)
+
+	// CommitsBetween returns one-line summaries of the commits reachable from
+	// to but not from from, newest first.
+	CommitsBetween(ctx context.Context, from, to string) ([]string, error)
+
+	// ChangedFiles returns the files changed between two refs, relative to
+	// the working directory.
+	ChangedFiles(ctx context.Context, from, to string) ([]string, error)
 }
 
 // GitCommitOperations provides git staging and commit capabilities.
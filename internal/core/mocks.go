@@ -350,23 +350,30 @@ type MockGitTagOperations struct {
 	CreateAnnotatedTagErr   error
 	CreateLightweightTagErr error
 	CreateSignedTagErr      error
+	VerifyTagErr            error
 	TagExistsErr            error
 	GetLatestTagErr         error
 	PushTagErr              error
 	ListTagsErr             error
 	DeleteTagErr            error
 	DeleteRemoteTagErr      error
+	MoveTagErr              error
+	ForcePushTagErr         error
 
 	// Response values
 	TagExistsResult  bool
 	GetLatestTagName string
 	ListTagsResult   []string
+	VerifyTagOutput  string
+	MoveTagPrevious  string
 
 	// Call tracking
 	CreatedTags    []string
 	PushedTags     []string
 	DeletedTags    []string
 	DeletedRemote  []string
+	MovedTags      []string
+	ForcePushed    []string
 	ListTagsCalled bool
 }
 
@@ -399,7 +406,7 @@ func (m *MockGitTagOperations) CreateLightweightTag(ctx context.Context, name st
 	return nil
 }
 
-func (m *MockGitTagOperations) CreateSignedTag(ctx context.Context, name, message, keyID string) error {
+func (m *MockGitTagOperations) CreateSignedTag(ctx context.Context, name, message string, signing TagSigning) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	if m.CreateSignedTagErr != nil {
@@ -409,6 +416,15 @@ func (m *MockGitTagOperations) CreateSignedTag(ctx context.Context, name, messag
 	return nil
 }
 
+func (m *MockGitTagOperations) VerifyTag(ctx context.Context, name string, signing TagSigning) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.VerifyTagErr != nil {
+		return "", m.VerifyTagErr
+	}
+	return m.VerifyTagOutput, nil
+}
+
 func (m *MockGitTagOperations) TagExists(ctx context.Context, name string) (bool, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -467,6 +483,26 @@ func (m *MockGitTagOperations) DeleteRemoteTag(ctx context.Context, name string)
 	return nil
 }
 
+func (m *MockGitTagOperations) MoveTag(ctx context.Context, name, target string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.MoveTagErr != nil {
+		return "", m.MoveTagErr
+	}
+	m.MovedTags = append(m.MovedTags, name)
+	return m.MoveTagPrevious, nil
+}
+
+func (m *MockGitTagOperations) ForcePushTag(ctx context.Context, name, expected string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ForcePushTagErr != nil {
+		return m.ForcePushTagErr
+	}
+	m.ForcePushed = append(m.ForcePushed, name)
+	return nil
+}
+
 // MockGitCommitOperations is a mock git commit operations for testing.
 type MockGitCommitOperations struct {
 	mu sync.Mutex
@@ -140,7 +140,7 @@ func TestMockGitTagOperations_CreateSignedTag(t *testing.T) {
 	mock := NewMockGitTagOperations()
 	ctx := context.Background()
 
-	err := mock.CreateSignedTag(ctx, "v1.0.0", "Release 1.0.0", "ABCD1234")
+	err := mock.CreateSignedTag(ctx, "v1.0.0", "Release 1.0.0", TagSigning{Key: "ABCD1234"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -150,7 +150,7 @@ func TestMockGitTagOperations_CreateSignedTag(t *testing.T) {
 
 	// Test with error
 	mock.CreateSignedTagErr = errors.New("signed tag error")
-	err = mock.CreateSignedTag(ctx, "v1.0.1", "msg", "KEY123")
+	err = mock.CreateSignedTag(ctx, "v1.0.1", "msg", TagSigning{Key: "KEY123"})
 	if err == nil || err.Error() != "signed tag error" {
 		t.Errorf("expected 'signed tag error', got %v", err)
 	}
@@ -320,6 +320,7 @@ func (s *Service) discoverAllManifests(ctx context.Context, root string, maxDept
 // discoverManifestsInDir finds manifest files in a specific directory.
 func (s *Service) discoverManifestsInDir(ctx context.Context, dir, root string) ([]ManifestSource, error) {
 	var manifests []ManifestSource
+	var entries []fs.DirEntry // read lazily for glob patterns
 
 	for _, known := range DefaultKnownManifests() {
 		// Check for context cancellation
@@ -327,46 +328,79 @@ func (s *Service) discoverManifestsInDir(ctx context.Context, dir, root string)
 			return nil, err
 		}
 
-		path := filepath.Join(dir, known.Filename)
-
-		// Check if file exists
-		if _, err := s.fs.Stat(ctx, path); err != nil {
-			continue
+		filenames := []string{known.Filename}
+		if known.IsPattern() {
+			if entries == nil {
+				var err error
+				if entries, err = s.fs.ReadDir(ctx, dir); err != nil {
+					entries = []fs.DirEntry{}
+				}
+			}
+			filenames = matchEntries(entries, known.Filename)
 		}
 
-		// Try to read the version
-		version, err := s.parser.ReadVersion(ctx, parser.FileConfig{
-			Path:   path,
-			Format: known.Format,
-			Field:  known.Field,
-		})
-		if err != nil {
-			continue
+		for _, filename := range filenames {
+			if m, ok := s.loadManifest(ctx, filepath.Join(dir, filename), root, filename, known); ok {
+				manifests = append(manifests, m)
+			}
 		}
+	}
 
-		// Validate it looks like a semver
-		if !isValidSemver(version) {
-			continue
-		}
+	return manifests, nil
+}
 
-		// Calculate relative path from root
-		relPath, err := filepath.Rel(root, path)
-		if err != nil {
-			relPath = path
-		}
+// loadManifest reads the version from a known manifest file. It reports false
+// if the file does not exist or does not hold a valid semantic version.
+func (s *Service) loadManifest(ctx context.Context, path, root, filename string, known KnownManifest) (ManifestSource, bool) {
+	// Check if file exists
+	if _, err := s.fs.Stat(ctx, path); err != nil {
+		return ManifestSource{}, false
+	}
 
-		manifests = append(manifests, ManifestSource{
-			Path:        path,
-			RelPath:     relPath,
-			Filename:    known.Filename,
-			Version:     version,
-			Format:      known.Format,
-			Field:       known.Field,
-			Description: known.Description,
-		})
+	// Try to read the version
+	version, err := s.parser.ReadVersion(ctx, parser.FileConfig{
+		Path:   path,
+		Format: known.Format,
+		Field:  known.Field,
+	})
+	if err != nil {
+		return ManifestSource{}, false
 	}
 
-	return manifests, nil
+	// Validate it looks like a semver
+	if !isValidSemver(version) {
+		return ManifestSource{}, false
+	}
+
+	// Calculate relative path from root
+	relPath, err := filepath.Rel(root, path)
+	if err != nil {
+		relPath = path
+	}
+
+	return ManifestSource{
+		Path:        path,
+		RelPath:     relPath,
+		Filename:    filename,
+		Version:     version,
+		Format:      known.Format,
+		Field:       known.Field,
+		Description: known.Description,
+	}, true
+}
+
+// matchEntries returns the names of the regular files matching pattern.
+func matchEntries(entries []fs.DirEntry, pattern string) []string {
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if matched, _ := filepath.Match(pattern, entry.Name()); matched {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
 }
 
 // generateSyncCandidates creates SyncCandidates from discovered manifests.
@@ -304,6 +304,47 @@ func TestService_Discover_MultipleManifestTypes(t *testing.T) {
 	}
 }
 
+func TestService_Discover_EcosystemManifests(t *testing.T) {
+	t.Parallel()
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/project/pom.xml", []byte("<project><parent><version>9.0.0</version></parent><version>1.0.0</version></project>"))
+	fs.SetFile("/project/build.gradle.kts", []byte("version = \"1.0.0\"\n"))
+	fs.SetFile("/project/gradle.properties", []byte("version=1.0.0\n"))
+	fs.SetFile("/project/App.csproj", []byte("<Project><PropertyGroup><Version>1.0.0</Version></PropertyGroup></Project>"))
+	fs.SetFile("/project/Package.swift", []byte("let version = \"1.0.0\"\n"))
+	fs.SetFile("/project/app.gemspec", []byte("spec.version = \"1.0.0\"\n"))
+	fs.SetFile("/project/mix.exs", []byte("[app: :app, version: \"1.0.0\"]\n"))
+	fs.SetFile("/project/version.go", []byte("package version\n\nconst Version = \"1.0.0\"\n"))
+
+	svc := NewService(fs, nil)
+	result, err := svc.Discover(context.Background(), "/project")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]parser.Format{
+		"pom.xml":           parser.FormatXML,
+		"build.gradle.kts":  parser.FormatGradle,
+		"gradle.properties": parser.FormatProperties,
+		"App.csproj":        parser.FormatXML,
+		"Package.swift":     parser.FormatSwift,
+		"app.gemspec":       parser.FormatRuby,
+		"mix.exs":           parser.FormatElixir,
+		"version.go":        parser.FormatGo,
+	}
+	if len(result.SyncCandidates) != len(want) {
+		t.Fatalf("len(SyncCandidates) = %d, want %d: %+v", len(result.SyncCandidates), len(want), result.SyncCandidates)
+	}
+	for _, c := range result.SyncCandidates {
+		if want[c.Path] != c.Format {
+			t.Errorf("candidate %s has format %q, want %q", c.Path, c.Format, want[c.Path])
+		}
+		if c.Version != "1.0.0" {
+			t.Errorf("candidate %s has version %q, want 1.0.0", c.Path, c.Version)
+		}
+	}
+}
+
 func TestService_Discover_InvalidManifestVersion(t *testing.T) {
 	t.Parallel()
 	fs := core.NewMockFileSystem()
@@ -1,6 +1,10 @@
 package discovery
 
-import "github.com/indaco/sley/internal/parser"
+import (
+	"strings"
+
+	"github.com/indaco/sley/internal/parser"
+)
 
 // DetectionMode indicates the type of project structure detected.
 type DetectionMode int
@@ -172,7 +176,7 @@ type Mismatch struct {
 
 // KnownManifest describes a known manifest file type for discovery.
 type KnownManifest struct {
-	// Filename is the expected filename.
+	// Filename is the expected filename, or a glob pattern such as "*.csproj".
 	Filename string
 
 	// Format is the file format.
@@ -188,6 +192,11 @@ type KnownManifest struct {
 	Priority int
 }
 
+// IsPattern reports whether Filename is a glob pattern rather than a file name.
+func (k KnownManifest) IsPattern() bool {
+	return strings.ContainsAny(k.Filename, "*?[")
+}
+
 // DefaultKnownManifests returns the list of known manifest files to discover.
 func DefaultKnownManifests() []KnownManifest {
 	return []KnownManifest{
@@ -233,19 +242,103 @@ func DefaultKnownManifests() []KnownManifest {
 			Description: "PHP (composer.json)",
 			Priority:    6,
 		},
+		{
+			Filename:    "pom.xml",
+			Format:      parser.FormatXML,
+			Field:       "project/version",
+			Description: "Maven (pom.xml)",
+			Priority:    7,
+		},
+		{
+			Filename:    "build.gradle",
+			Format:      parser.FormatGradle,
+			Field:       "version",
+			Description: "Gradle (build.gradle)",
+			Priority:    8,
+		},
+		{
+			Filename:    "build.gradle.kts",
+			Format:      parser.FormatGradle,
+			Field:       "version",
+			Description: "Gradle Kotlin DSL (build.gradle.kts)",
+			Priority:    9,
+		},
+		{
+			Filename:    "gradle.properties",
+			Format:      parser.FormatProperties,
+			Field:       "version",
+			Description: "Gradle (gradle.properties)",
+			Priority:    10,
+		},
+		{
+			Filename:    "*.csproj",
+			Format:      parser.FormatXML,
+			Field:       "Project/PropertyGroup/Version",
+			Description: ".NET (*.csproj)",
+			Priority:    11,
+		},
+		{
+			Filename:    "*.csproj",
+			Format:      parser.FormatXML,
+			Field:       "Project/PropertyGroup/VersionPrefix",
+			Description: ".NET (*.csproj)",
+			Priority:    12,
+		},
+		{
+			Filename:    "Directory.Build.props",
+			Format:      parser.FormatXML,
+			Field:       "Project/PropertyGroup/Version",
+			Description: ".NET (Directory.Build.props)",
+			Priority:    13,
+		},
+		{
+			Filename:    "Package.swift",
+			Format:      parser.FormatSwift,
+			Field:       "version",
+			Description: "Swift (Package.swift)",
+			Priority:    14,
+		},
+		{
+			Filename:    "*.gemspec",
+			Format:      parser.FormatRuby,
+			Field:       "version",
+			Description: "Ruby (*.gemspec)",
+			Priority:    15,
+		},
+		{
+			Filename:    "version.rb",
+			Format:      parser.FormatRuby,
+			Field:       "VERSION",
+			Description: "Ruby (version.rb)",
+			Priority:    16,
+		},
+		{
+			Filename:    "mix.exs",
+			Format:      parser.FormatElixir,
+			Field:       "version",
+			Description: "Elixir (mix.exs)",
+			Priority:    17,
+		},
+		{
+			Filename:    "version.go",
+			Format:      parser.FormatGo,
+			Field:       "Version",
+			Description: "Go (version.go)",
+			Priority:    18,
+		},
 		{
 			Filename:    "version.txt",
 			Format:      parser.FormatRaw,
 			Field:       "",
 			Description: "Plain text (version.txt)",
-			Priority:    10,
+			Priority:    19,
 		},
 		{
 			Filename:    "VERSION",
 			Format:      parser.FormatRaw,
 			Field:       "",
 			Description: "Plain text (VERSION)",
-			Priority:    11,
+			Priority:    20,
 		},
 	}
 }
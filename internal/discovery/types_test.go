@@ -263,7 +263,7 @@ func TestDefaultKnownManifests(t *testing.T) {
 	}
 
 	// Check for expected files
-	expectedFiles := []string{"package.json", "Cargo.toml", "pyproject.toml", "Chart.yaml"}
+	expectedFiles := []string{"package.json", "Cargo.toml", "pyproject.toml", "Chart.yaml", "pom.xml", "build.gradle", "*.csproj", "mix.exs", "version.go"}
 	for _, expected := range expectedFiles {
 		found := false
 		for _, m := range manifests {
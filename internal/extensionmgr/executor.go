@@ -11,29 +11,17 @@ import (
 	"time"
 
 	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/extensions"
 	"github.com/indaco/sley/internal/pathutil"
+	"github.com/indaco/sley/sdk"
 )
 
-// HookInput represents the JSON input passed to an extension script
-type HookInput struct {
-	Hook            string         `json:"hook"`
-	Version         string         `json:"version"`
-	PreviousVersion string         `json:"previous_version,omitempty"`
-	BumpType        string         `json:"bump_type,omitempty"`
-	Prerelease      *string        `json:"prerelease,omitempty"`
-	Metadata        *string        `json:"metadata,omitempty"`
-	ProjectRoot     string         `json:"project_root"`
-	ModuleDir       string         `json:"module_dir,omitempty"`  // Directory containing the .version file (for monorepo support)
-	ModuleName      string         `json:"module_name,omitempty"` // Module identifier (for monorepo support)
-	Config          map[string]any `json:"config,omitempty"`      // Extension-specific configuration
-}
+// HookInput represents the JSON input passed to an extension script.
+// It is shared with the extension SDK so both protocols use the same document.
+type HookInput = sdk.HookInput
 
 // HookOutput represents the JSON output expected from an extension script
-type HookOutput struct {
-	Success bool           `json:"success"`
-	Message string         `json:"message,omitempty"`
-	Data    map[string]any `json:"data,omitempty"`
-}
+type HookOutput = sdk.HookOutput
 
 // Executor defines the interface for executing extension scripts
 type Executor interface {
@@ -66,8 +54,32 @@ func NewScriptExecutorWithTimeout(timeout time.Duration) *ScriptExecutor {
 	}
 }
 
+// SandboxedExecutor is implemented by executors that can confine an extension
+// to the permissions declared in its manifest.
+type SandboxedExecutor interface {
+	ExecuteSandboxed(ctx context.Context, scriptPath string, input *HookInput, perms *extensions.Permissions) (*HookOutput, error)
+}
+
 // Execute runs an extension script with the provided input and returns the output
 func (e *ScriptExecutor) Execute(ctx context.Context, scriptPath string, input *HookInput) (*HookOutput, error) {
+	return e.execute(ctx, scriptPath, input, nil)
+}
+
+// ExecuteSandboxed runs an extension script restricted to the given permissions.
+// The script receives a scrubbed environment and, on Linux, runs in its own
+// user and mount namespaces with the project mounted read-only except the
+// declared write paths, and without network unless requested.
+// Where namespaces are unavailable it falls back to a warning-only mode.
+func (e *ScriptExecutor) ExecuteSandboxed(ctx context.Context, scriptPath string, input *HookInput, perms *extensions.Permissions) (*HookOutput, error) {
+	if perms == nil {
+		perms = &extensions.Permissions{}
+	}
+	return e.execute(ctx, scriptPath, input, perms)
+}
+
+// execute validates the script, runs it and parses its output.
+// A nil perms runs the script unrestricted.
+func (e *ScriptExecutor) execute(ctx context.Context, scriptPath string, input *HookInput, perms *extensions.Permissions) (*HookOutput, error) {
 	// Clean and validate script path to prevent path traversal attacks
 	cleanPath := filepath.Clean(scriptPath)
 
@@ -102,17 +114,8 @@ func (e *ScriptExecutor) Execute(ctx context.Context, scriptPath string, input *
 	execCtx, cancel := context.WithTimeout(ctx, e.Timeout)
 	defer cancel()
 
-	// Prepare command with working directory set to the script's directory
-	cmd := exec.CommandContext(execCtx, absPath)
-	cmd.Dir = filepath.Dir(absPath)
-	cmd.Stdin = bytes.NewReader(inputJSON)
-
 	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	// Execute the script
-	if err := cmd.Run(); err != nil {
+	if err := e.run(execCtx, absPath, inputJSON, input.ProjectRoot, perms, &stdout, &stderr); err != nil {
 		// Check if it was a timeout
 		if execCtx.Err() == context.DeadlineExceeded {
 			return nil, fmt.Errorf("script execution timeout after %v: %s", e.Timeout, stderr.String())
@@ -141,6 +144,93 @@ func (e *ScriptExecutor) Execute(ctx context.Context, scriptPath string, input *
 	return &output, nil
 }
 
+// run starts the script and waits for it to finish. When the sandbox helper
+// reports that it could not confine the script, the script is run again with
+// a scrubbed environment only.
+func (e *ScriptExecutor) run(ctx context.Context, absPath string, inputJSON []byte, projectRoot string, perms *extensions.Permissions, stdout, stderr *bytes.Buffer) error {
+	newCmd := func() (*exec.Cmd, error) {
+		// Prepare command with working directory set to the script's directory
+		cmd := exec.CommandContext(ctx, absPath)
+		cmd.Dir = filepath.Dir(absPath)
+		cmd.Stdin = bytes.NewReader(inputJSON)
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		return cmd, nil
+	}
+
+	cmd, status, err := startScript(newCmd, absPath, projectRoot, perms)
+	if err != nil {
+		return err
+	}
+	err = cmd.Wait()
+
+	if setupErr := status.setupFailure(); setupErr != nil {
+		stdout.Reset()
+		stderr.Reset()
+		if cmd, err = startScrubbed(newCmd, absPath, perms, setupErr); err != nil {
+			return err
+		}
+		err = cmd.Wait()
+	}
+	return err
+}
+
+// startScript starts the command built by newCmd. With permissions set it
+// first tries to start the script inside the sandbox, falling back to a
+// scrubbed environment only (with a warning) when namespaces are unavailable.
+// newCmd may be called twice, so it must build a fresh command each time.
+// The returned status is non-nil when the script was started through the
+// sandbox helper; the caller checks it for setup failures once the process
+// has exited.
+func startScript(newCmd func() (*exec.Cmd, error), absPath, projectRoot string, perms *extensions.Permissions) (*exec.Cmd, *sandboxStatus, error) {
+	cmd, err := newCmd()
+	if err != nil {
+		return nil, nil, err
+	}
+	if perms == nil {
+		return cmd, nil, cmd.Start()
+	}
+
+	cmd.Env = scrubEnv(perms.Env)
+
+	spec, warnings := newSandboxSpec(absPath, projectRoot, perms)
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+
+	err = configureSandbox(cmd, spec)
+	if err == nil {
+		status, statusErr := newSandboxStatus(cmd)
+		if statusErr != nil {
+			return nil, nil, statusErr
+		}
+		if err = cmd.Start(); err == nil {
+			status.started()
+			return cmd, status, nil
+		}
+		status.close()
+	}
+	if !isSandboxUnavailable(err) {
+		return nil, nil, err
+	}
+
+	cmd, err = startScrubbed(newCmd, absPath, perms, err)
+	return cmd, nil, err
+}
+
+// startScrubbed warns that the sandbox could not be used because of reason
+// and starts the command built by newCmd with a scrubbed environment only.
+func startScrubbed(newCmd func() (*exec.Cmd, error), absPath string, perms *extensions.Permissions, reason error) (*exec.Cmd, error) {
+	fmt.Fprintf(os.Stderr, "Warning: cannot sandbox %s (%v); running with a scrubbed environment only\n", filepath.Base(absPath), reason)
+
+	cmd, err := newCmd()
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = scrubEnv(perms.Env)
+	return cmd, cmd.Start()
+}
+
 // ExecuteExtensionHook is a convenience function to execute an extension hook
 // It resolves the full script path relative to the extension directory and validates
 // that the script remains within the extension directory to prevent path traversal attacks
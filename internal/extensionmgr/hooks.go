@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"sync"
 
 	"github.com/indaco/sley/internal/config"
 	"github.com/indaco/sley/internal/extensions"
@@ -34,6 +35,7 @@ type ExtensionHookRunner struct {
 	Config         *config.Config
 	Executor       Executor
 	ManifestLoader ManifestLoader
+	RPCHost        RPCHost // Answers queries from extensions using the rpc protocol
 }
 
 // NewExtensionHookRunner creates a new ExtensionHookRunner
@@ -42,6 +44,7 @@ func NewExtensionHookRunner(cfg *config.Config) *ExtensionHookRunner {
 		Config:         cfg,
 		Executor:       NewScriptExecutor(),
 		ManifestLoader: &DefaultManifestLoader{},
+		RPCHost:        NewDefaultRPCHost(cfg),
 	}
 }
 
@@ -82,13 +85,14 @@ func (r *ExtensionHookRunner) RunHooks(ctx context.Context, hookType HookType, i
 		ty := printer.Typography()
 		fmt.Printf("Running extension %s (%s)... ", printer.Info(extCfg.Name), ty.Small(string(hookType)))
 
-		output, err := r.Executor.Execute(ctx, scriptPath, &extInput)
+		output, err := r.execute(ctx, scriptPath, &extInput, manifest)
 		if err != nil {
 			fmt.Println(ty.ErrorBadge("FAIL"))
 			return fmt.Errorf("extension %q hook %q failed: %w", extCfg.Name, hookType, err)
 		}
 
 		fmt.Println(ty.SuccessBadge("OK"))
+		recordExecution(HookExecution{Hook: string(hookType), Extension: extCfg.Name, ModuleDir: input.ModuleDir})
 
 		if output.Message != "" {
 			fmt.Printf("  %s\n", ty.Small(output.Message))
@@ -100,6 +104,43 @@ func (r *ExtensionHookRunner) RunHooks(ctx context.Context, hookType HookType, i
 	return nil
 }
 
+// execute runs a single extension hook. RPC extensions are sent the event
+// over their long-running session; others are executed once, confined to
+// their declared permissions when the executor supports sandboxing.
+func (r *ExtensionHookRunner) execute(ctx context.Context, scriptPath string, input *HookInput, manifest *extensions.ExtensionManifest) (*HookOutput, error) {
+	if manifest.IsRPC() {
+		return r.executeRPC(ctx, scriptPath, input, manifest)
+	}
+
+	if manifest.Permissions != nil {
+		if sandboxed, ok := r.Executor.(SandboxedExecutor); ok {
+			return sandboxed.ExecuteSandboxed(ctx, scriptPath, input, manifest.Permissions)
+		}
+	}
+	return r.Executor.Execute(ctx, scriptPath, input)
+}
+
+// executeRPC sends a hook event to the extension's RPC session, starting
+// the extension process on first use.
+func (r *ExtensionHookRunner) executeRPC(ctx context.Context, scriptPath string, input *HookInput, manifest *extensions.ExtensionManifest) (*HookOutput, error) {
+	absPath, err := filepath.Abs(filepath.Clean(scriptPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve script path %s: %w", scriptPath, err)
+	}
+
+	host := r.RPCHost
+	if host == nil {
+		host = NewDefaultRPCHost(r.Config)
+	}
+	host = scopeRPCHost(host, manifest.Permissions)
+
+	session, err := rpcSessionFor(ctx, manifest.Name, absPath, input, manifest.Permissions, host, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return session.hook(ctx, input, DefaultTimeout)
+}
+
 // hasHook checks if a hook type is present in the hooks slice
 func hasHook(hooks []string, hookType string) bool {
 	return slices.Contains(hooks, hookType)
@@ -160,6 +201,44 @@ type ModuleInfo struct {
 	Name string // Module identifier
 }
 
+// HookExecution records an extension hook that completed successfully.
+type HookExecution struct {
+	Hook      string
+	Extension string
+	ModuleDir string // Empty for the project root
+}
+
+// executions holds the hook executions of this run until they are taken
+// for the audit log.
+var executions = struct {
+	sync.Mutex
+	list []HookExecution
+}{}
+
+func recordExecution(e HookExecution) {
+	executions.Lock()
+	defer executions.Unlock()
+	executions.list = append(executions.list, e)
+}
+
+// TakeExecutions returns the hook executions recorded for the module
+// directory ("" for the project root) and forgets them.
+func TakeExecutions(moduleDir string) []HookExecution {
+	executions.Lock()
+	defer executions.Unlock()
+
+	var taken, kept []HookExecution
+	for _, e := range executions.list {
+		if e.ModuleDir == moduleDir {
+			taken = append(taken, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	executions.list = kept
+	return taken
+}
+
 // RunPreBumpHooks is a convenience function to run pre-bump hooks
 func RunPreBumpHooks(ctx context.Context, cfg *config.Config, version, previousVersion, bumpType string, moduleInfo *ModuleInfo) error {
 	if cfg == nil {
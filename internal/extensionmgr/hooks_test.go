@@ -1166,3 +1166,24 @@ echo "{\"success\": true, \"message\": \"ext2: key2=$key2\"}"
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestTakeExecutions(t *testing.T) {
+	t.Parallel()
+	dirA := filepath.Join(t.TempDir(), "a")
+	dirB := filepath.Join(t.TempDir(), "b")
+
+	recordExecution(HookExecution{Hook: "pre-bump", Extension: "ext-1", ModuleDir: dirA})
+	recordExecution(HookExecution{Hook: "pre-bump", Extension: "ext-1", ModuleDir: dirB})
+	recordExecution(HookExecution{Hook: "post-bump", Extension: "ext-2", ModuleDir: dirA})
+
+	got := TakeExecutions(dirA)
+	if len(got) != 2 || got[0].Extension != "ext-1" || got[1].Hook != "post-bump" {
+		t.Errorf("unexpected executions for module a: %+v", got)
+	}
+	if again := TakeExecutions(dirA); len(again) != 0 {
+		t.Errorf("expected executions to be consumed, got %+v", again)
+	}
+	if got := TakeExecutions(dirB); len(got) != 1 {
+		t.Errorf("expected module b executions to be kept, got %+v", got)
+	}
+}
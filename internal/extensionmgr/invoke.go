@@ -0,0 +1,58 @@
+package extensionmgr
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/extensions"
+	"github.com/indaco/sley/internal/pathutil"
+	"github.com/indaco/sley/internal/semver"
+)
+
+// NewSampleHookInput builds a synthetic HookInput for the given hook and version,
+// deriving the previous version and bump type as a patch bump would.
+func NewSampleHookInput(hookType HookType, version, projectRoot string) *HookInput {
+	input := &HookInput{
+		Hook:        string(hookType),
+		Version:     version,
+		BumpType:    "patch",
+		ProjectRoot: projectRoot,
+	}
+
+	if v, err := semver.ParseVersion(version); err == nil && v.Patch > 0 {
+		prev := semver.SemVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}
+		input.PreviousVersion = prev.String()
+	}
+
+	return input
+}
+
+// InvokeExtension runs a single hook of the extension in extensionPath through
+// the real ScriptExecutor, applying the permissions declared in its manifest.
+// It is used to test extensions outside of a version bump; cfg backs the
+// queries of RPC extensions and may be nil.
+func InvokeExtension(ctx context.Context, cfg *config.Config, extensionPath string, hookType HookType, input *HookInput) (*extensions.ExtensionManifest, *HookOutput, error) {
+	if err := ValidateExtensionHook(string(hookType)); err != nil {
+		return nil, nil, err
+	}
+
+	manifest, err := extensions.LoadExtensionManifest(extensionPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !hasHook(manifest.Hooks, string(hookType)) {
+		return manifest, nil, fmt.Errorf("extension %q does not declare the %q hook", manifest.Name, hookType)
+	}
+
+	scriptPath := filepath.Join(extensionPath, manifest.Entry)
+	if _, err := pathutil.ValidatePath(scriptPath, extensionPath); err != nil {
+		return manifest, nil, fmt.Errorf("invalid script path: %w", err)
+	}
+
+	runner := &ExtensionHookRunner{Config: cfg, Executor: NewScriptExecutor(), RPCHost: NewDefaultRPCHost(cfg)}
+	output, err := runner.execute(ctx, scriptPath, input, manifest)
+	return manifest, output, err
+}
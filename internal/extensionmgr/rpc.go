@@ -0,0 +1,222 @@
+package extensionmgr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/indaco/sley/internal/extensions"
+	"github.com/indaco/sley/internal/version"
+	"github.com/indaco/sley/sdk"
+)
+
+// rpcShutdownTimeout bounds how long a stopping RPC extension may take
+// to answer "shutdown" and exit before it is killed.
+const rpcShutdownTimeout = 5 * time.Second
+
+// rpcSession is a running RPC extension process.
+type rpcSession struct {
+	name   string
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	conn   *sdk.Conn
+	cancel context.CancelFunc
+	stderr *syncBuffer
+}
+
+// rpcSessions holds the RPC extensions started during this run, keyed by
+// entry path, so that each extension process is started only once.
+var rpcSessions = struct {
+	sync.Mutex
+	m map[string]*rpcSession
+}{m: make(map[string]*rpcSession)}
+
+// rpcSessionFor returns the running session for the extension entry,
+// starting and initializing the process on first use.
+func rpcSessionFor(ctx context.Context, name, absPath string, input *HookInput, perms *extensions.Permissions, host RPCHost, timeout time.Duration) (*rpcSession, error) {
+	rpcSessions.Lock()
+	defer rpcSessions.Unlock()
+
+	if s, ok := rpcSessions.m[absPath]; ok {
+		select {
+		case <-s.conn.Done():
+			// The process exited; start a fresh one below.
+			s.stop()
+			delete(rpcSessions.m, absPath)
+		default:
+			return s, nil
+		}
+	}
+
+	s, err := startRPCSession(ctx, name, absPath, input, perms, host, timeout)
+	if err != nil {
+		return nil, err
+	}
+	rpcSessions.m[absPath] = s
+	return s, nil
+}
+
+// startRPCSession starts the extension process and performs the
+// "initialize" handshake. When the sandbox helper reports that it could not
+// confine the extension, the process is started again with a scrubbed
+// environment only.
+func startRPCSession(ctx context.Context, name, absPath string, input *HookInput, perms *extensions.Permissions, host RPCHost, timeout time.Duration) (*rpcSession, error) {
+	s, err := launchRPCSession(ctx, name, absPath, input, host, timeout, func(newCmd func() (*exec.Cmd, error)) (*exec.Cmd, *sandboxStatus, error) {
+		return startScript(newCmd, absPath, input.ProjectRoot, perms)
+	})
+
+	var setupErr *sandboxSetupError
+	if errors.As(err, &setupErr) {
+		return launchRPCSession(ctx, name, absPath, input, host, timeout, func(newCmd func() (*exec.Cmd, error)) (*exec.Cmd, *sandboxStatus, error) {
+			cmd, err := startScrubbed(newCmd, absPath, perms, setupErr)
+			return cmd, nil, err
+		})
+	}
+	return s, err
+}
+
+// launchRPCSession starts the extension process with start and performs the
+// "initialize" handshake.
+func launchRPCSession(ctx context.Context, name, absPath string, input *HookInput, host RPCHost, timeout time.Duration, start func(newCmd func() (*exec.Cmd, error)) (*exec.Cmd, *sandboxStatus, error)) (*rpcSession, error) {
+	// The process outlives the hook that started it, so it is bound to its
+	// own context rather than the caller's.
+	procCtx, cancel := context.WithCancel(context.Background())
+
+	s := &rpcSession{name: name, cancel: cancel, stderr: &syncBuffer{}}
+	var stdout io.ReadCloser
+
+	cmd, status, err := start(func() (*exec.Cmd, error) {
+		cmd := exec.CommandContext(procCtx, absPath)
+		cmd.Dir = filepath.Dir(absPath)
+		cmd.Stderr = s.stderr
+
+		var err error
+		if s.stdin, err = cmd.StdinPipe(); err != nil {
+			return nil, err
+		}
+		if stdout, err = cmd.StdoutPipe(); err != nil {
+			return nil, err
+		}
+		return cmd, nil
+	})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to start rpc extension: %w", err)
+	}
+	s.cmd = cmd
+
+	s.conn = sdk.NewConn(stdout, s.stdin, rpcHostHandler(host))
+	go func() { _ = s.conn.Run(procCtx) }()
+
+	initCtx, initCancel := context.WithTimeout(ctx, timeout)
+	defer initCancel()
+
+	params := sdk.InitializeParams{
+		ProtocolVersion: sdk.ProtocolVersion,
+		SleyVersion:     version.GetVersion(),
+		ProjectRoot:     input.ProjectRoot,
+		Config:          input.Config,
+	}
+	var result sdk.InitializeResult
+	if err := s.conn.Call(initCtx, sdk.MethodInitialize, params, &result); err != nil {
+		s.stop()
+		if setupErr := status.setupFailure(); setupErr != nil {
+			return nil, setupErr
+		}
+		return nil, s.wrapError("initialize", err)
+	}
+	status.close()
+
+	return s, nil
+}
+
+// hook sends a hook event and waits for the extension's output.
+func (s *rpcSession) hook(ctx context.Context, input *HookInput, timeout time.Duration) (*HookOutput, error) {
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var output HookOutput
+	if err := s.conn.Call(callCtx, sdk.MethodHook, input, &output); err != nil {
+		if callCtx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("rpc extension timeout after %v: %s", timeout, s.stderr.String())
+		}
+		return nil, s.wrapError("hook", err)
+	}
+
+	if !output.Success {
+		return &output, fmt.Errorf("script reported failure: %s", output.Message)
+	}
+	return &output, nil
+}
+
+// stop asks the extension to shut down, closes its stdin and waits for it
+// to exit, killing it if it does not exit in time.
+func (s *rpcSession) stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcShutdownTimeout)
+	defer cancel()
+
+	_ = s.conn.Call(ctx, sdk.MethodShutdown, nil, nil)
+	_ = s.stdin.Close()
+
+	exited := make(chan struct{})
+	go func() {
+		_ = s.cmd.Wait()
+		close(exited)
+	}()
+
+	select {
+	case <-exited:
+	case <-ctx.Done():
+		s.cancel()
+		<-exited
+	}
+	s.cancel()
+}
+
+func (s *rpcSession) wrapError(method string, err error) error {
+	if stderr := strings.TrimSpace(s.stderr.String()); stderr != "" {
+		return fmt.Errorf("rpc extension %q %s failed: %w\nstderr: %s", s.name, method, err, stderr)
+	}
+	return fmt.Errorf("rpc extension %q %s failed: %w", s.name, method, err)
+}
+
+// CloseRPCSessions stops every RPC extension started during this run.
+// It is safe to call when no session was started.
+func CloseRPCSessions() {
+	rpcSessions.Lock()
+	defer rpcSessions.Unlock()
+
+	for path, s := range rpcSessions.m {
+		s.stop()
+		delete(rpcSessions.m, path)
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes and reads, used to
+// collect the stderr of a long-running extension.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.buf.Len()+len(p) > MaxOutputSize {
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,204 @@
+package extensionmgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/extensions"
+	"github.com/indaco/sley/internal/plugins/commitparser/gitlog"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/workspace"
+	"github.com/indaco/sley/sdk"
+)
+
+// RPCHost answers the queries RPC extensions make back into sley.
+type RPCHost interface {
+	Version(ctx context.Context, module string) (string, error)
+	Modules(ctx context.Context) ([]sdk.Module, error)
+	Config(ctx context.Context) (map[string]any, error)
+	Commits(ctx context.Context, since, until string) ([]string, error)
+}
+
+// DefaultRPCHost implements RPCHost on top of the version file, workspace
+// discovery, the loaded configuration and git.
+type DefaultRPCHost struct {
+	cfg        *config.Config
+	getCommits gitlog.GetCommitsFn
+}
+
+// NewDefaultRPCHost creates an RPCHost for the given configuration.
+func NewDefaultRPCHost(cfg *config.Config) *DefaultRPCHost {
+	if cfg == nil {
+		cfg = &config.Config{Path: ".version"}
+	}
+	return &DefaultRPCHost{
+		cfg:        cfg,
+		getCommits: gitlog.DefaultGetCommitsFn(),
+	}
+}
+
+// Version returns the version of the named module, or of the configured
+// version file when module is empty.
+func (h *DefaultRPCHost) Version(ctx context.Context, module string) (string, error) {
+	if module == "" {
+		v, err := semver.NewSourcedVersionManager(core.NewOSFileSystem()).Read(ctx, h.cfg.Path)
+		if err != nil {
+			return "", err
+		}
+		return v.String(), nil
+	}
+
+	modules, err := h.Modules(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range modules {
+		if m.Name == module {
+			return m.Version, nil
+		}
+	}
+	return "", fmt.Errorf("module %q not found", module)
+}
+
+// Modules returns the modules discovered in the current workspace.
+func (h *DefaultRPCHost) Modules(ctx context.Context) ([]sdk.Module, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	detector := workspace.NewDetector(core.NewOSFileSystem(), h.cfg)
+	wsCtx, err := detector.DetectContext(ctx, cwd)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make([]sdk.Module, 0, len(wsCtx.Modules))
+	switch wsCtx.Mode {
+	case workspace.MultiModule:
+		for _, m := range wsCtx.Modules {
+			modules = append(modules, sdk.Module{Name: m.Name, Dir: m.Dir, Path: m.Path, Version: m.CurrentVersion})
+		}
+	case workspace.SingleModule:
+		version, _ := h.Version(ctx, "")
+		dir := filepath.Dir(wsCtx.Path)
+		if abs, err := filepath.Abs(dir); err == nil {
+			dir = abs
+		}
+		modules = append(modules, sdk.Module{Name: filepath.Base(dir), Dir: dir, Path: wsCtx.Path, Version: version})
+	}
+	return modules, nil
+}
+
+// Config returns the configuration as a generic map keyed like .sley.yaml.
+func (h *DefaultRPCHost) Config(_ context.Context) (map[string]any, error) {
+	data, err := yaml.Marshal(h.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	var out map[string]any
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	return out, nil
+}
+
+// Commits returns the commit subjects between since and until.
+func (h *DefaultRPCHost) Commits(_ context.Context, since, until string) ([]string, error) {
+	return h.getCommits(since, until)
+}
+
+// scopedRPCHost limits the configuration an RPC host exposes to the
+// top-level keys a sandboxed extension declared in permissions.config.
+type scopedRPCHost struct {
+	RPCHost
+	keys []string
+}
+
+// scopeRPCHost wraps host so Config honours permissions. Extensions without
+// a permissions block run unrestricted and see the whole configuration.
+func scopeRPCHost(host RPCHost, permissions *extensions.Permissions) RPCHost {
+	if permissions == nil {
+		return host
+	}
+	return &scopedRPCHost{RPCHost: host, keys: permissions.Config}
+}
+
+// Config returns only the configuration keys the extension may read.
+func (h *scopedRPCHost) Config(ctx context.Context) (map[string]any, error) {
+	cfg, err := h.RPCHost.Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]any, len(h.keys))
+	for _, key := range h.keys {
+		if v, ok := cfg[key]; ok {
+			out[key] = v
+		}
+	}
+	return out, nil
+}
+
+// rpcHostHandler serves extension callbacks using host.
+func rpcHostHandler(host RPCHost) sdk.HandlerFunc {
+	return func(ctx context.Context, method string, params json.RawMessage) (any, error) {
+		switch method {
+		case sdk.MethodVersion:
+			var p sdk.VersionParams
+			if err := decodeRPCParams(params, &p); err != nil {
+				return nil, err
+			}
+			v, err := host.Version(ctx, p.Module)
+			if err != nil {
+				return nil, err
+			}
+			return sdk.VersionResult{Version: v}, nil
+
+		case sdk.MethodModules:
+			modules, err := host.Modules(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return sdk.ModulesResult{Modules: modules}, nil
+
+		case sdk.MethodConfig:
+			cfg, err := host.Config(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return sdk.ConfigResult{Config: cfg}, nil
+
+		case sdk.MethodCommits:
+			var p sdk.CommitsParams
+			if err := decodeRPCParams(params, &p); err != nil {
+				return nil, err
+			}
+			commits, err := host.Commits(ctx, p.Since, p.Until)
+			if err != nil {
+				return nil, err
+			}
+			return sdk.CommitsResult{Commits: commits}, nil
+
+		default:
+			return nil, &sdk.Error{Code: sdk.CodeMethodNotFound, Message: "method not found: " + method}
+		}
+	}
+}
+
+func decodeRPCParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return &sdk.Error{Code: sdk.CodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package extensionmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/extensions"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/indaco/sley/sdk"
+)
+
+// testRPCExtensionEnv makes the test binary serve testRPCExtension over stdio.
+const testRPCExtensionEnv = "SLEY_TEST_RPC_EXTENSION"
+
+// testRPCExtension answers every hook with the process id and the values
+// returned by the host callbacks.
+func testRPCExtension() *sdk.Extension {
+	return &sdk.Extension{
+		Hooks: map[string]sdk.Handler{
+			string(PostBumpHook): func(ctx context.Context, host *sdk.Host, in sdk.HookInput) (sdk.HookOutput, error) {
+				current, err := host.Version(ctx, "")
+				if err != nil {
+					return sdk.HookOutput{}, err
+				}
+				modules, err := host.Modules(ctx)
+				if err != nil {
+					return sdk.HookOutput{}, err
+				}
+				cfg, err := host.Config(ctx)
+				if err != nil {
+					return sdk.HookOutput{}, err
+				}
+				commits, err := host.Commits(ctx, "v"+in.PreviousVersion, "HEAD")
+				if err != nil {
+					return sdk.HookOutput{}, err
+				}
+				return sdk.HookOutput{
+					Success: true,
+					Message: fmt.Sprintf("pid=%d version=%s modules=%d path=%v commits=%s",
+						os.Getpid(), current, len(modules), cfg["path"], strings.Join(commits, ";")),
+				}, nil
+			},
+			string(ValidateHook): func(context.Context, *sdk.Host, sdk.HookInput) (sdk.HookOutput, error) {
+				return sdk.HookOutput{}, errors.New("validation failed")
+			},
+		},
+	}
+}
+
+// writeRPCExtensionEntry writes an entry script that starts the test binary
+// as an RPC extension.
+func writeRPCExtensionEntry(t *testing.T) string {
+	t.Helper()
+
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := filepath.Join(t.TempDir(), "run.sh")
+	script := fmt.Sprintf("#!/bin/sh\nexec env %s=1 %q\n", testRPCExtensionEnv, self)
+	if err := os.WriteFile(entry, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return entry
+}
+
+type mockRPCHost struct{}
+
+func (mockRPCHost) Version(_ context.Context, _ string) (string, error) {
+	return "1.3.0", nil
+}
+
+func (mockRPCHost) Modules(_ context.Context) ([]sdk.Module, error) {
+	return []sdk.Module{{Name: "api"}, {Name: "web"}}, nil
+}
+
+func (mockRPCHost) Config(_ context.Context) (map[string]any, error) {
+	return map[string]any{"path": ".version"}, nil
+}
+
+func (mockRPCHost) Commits(_ context.Context, since, until string) ([]string, error) {
+	return []string{since + ".." + until}, nil
+}
+
+func TestExtensionHookRunner_RPC_ReusesProcess(t *testing.T) {
+	t.Cleanup(CloseRPCSessions)
+
+	entry := writeRPCExtensionEntry(t)
+	runner := &ExtensionHookRunner{RPCHost: mockRPCHost{}}
+	manifest := &extensions.ExtensionManifest{Name: "rpc-test", Protocol: extensions.ProtocolRPC}
+	input := &HookInput{Hook: string(PostBumpHook), Version: "1.3.0", PreviousVersion: "1.2.0"}
+
+	first, err := runner.execute(context.Background(), entry, input, manifest)
+	if err != nil {
+		t.Fatalf("first hook error = %v", err)
+	}
+	second, err := runner.execute(context.Background(), entry, input, manifest)
+	if err != nil {
+		t.Fatalf("second hook error = %v", err)
+	}
+
+	want := "version=1.3.0 modules=2 path=.version commits=v1.2.0..HEAD"
+	if !strings.HasSuffix(first.Message, want) {
+		t.Errorf("unexpected message %q, want suffix %q", first.Message, want)
+	}
+
+	pid := strings.Fields(first.Message)[0]
+	if !strings.HasPrefix(second.Message, pid+" ") {
+		t.Errorf("expected the same process for both hooks, got %q and %q", first.Message, second.Message)
+	}
+}
+
+func TestExtensionHookRunner_RPC_Failure(t *testing.T) {
+	t.Cleanup(CloseRPCSessions)
+
+	entry := writeRPCExtensionEntry(t)
+	runner := &ExtensionHookRunner{RPCHost: mockRPCHost{}}
+	manifest := &extensions.ExtensionManifest{Name: "rpc-test", Protocol: extensions.ProtocolRPC}
+
+	_, err := runner.execute(context.Background(), entry, &HookInput{Hook: string(ValidateHook)}, manifest)
+	if err == nil || !strings.Contains(err.Error(), "validation failed") {
+		t.Errorf("expected the extension failure, got %v", err)
+	}
+}
+
+func TestExtensionHookRunner_RPC_StartFailure(t *testing.T) {
+	t.Cleanup(CloseRPCSessions)
+
+	entry := filepath.Join(t.TempDir(), "run.sh")
+	if err := os.WriteFile(entry, []byte("#!/bin/sh\necho broken >&2\nexit 1\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := &ExtensionHookRunner{RPCHost: mockRPCHost{}}
+	manifest := &extensions.ExtensionManifest{Name: "broken", Protocol: extensions.ProtocolRPC}
+
+	_, err := runner.execute(context.Background(), entry, &HookInput{Hook: string(PostBumpHook)}, manifest)
+	if err == nil || !strings.Contains(err.Error(), "initialize") {
+		t.Errorf("expected initialize error, got %v", err)
+	}
+}
+
+type configRPCHost struct{ mockRPCHost }
+
+func (configRPCHost) Config(_ context.Context) (map[string]any, error) {
+	return map[string]any{"path": ".version", "tag": "v", "changelog": "unified"}, nil
+}
+
+func TestScopeRPCHost_Config(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		permissions *extensions.Permissions
+		want        map[string]any
+	}{
+		{"no permissions block", nil, map[string]any{"path": ".version", "tag": "v", "changelog": "unified"}},
+		{"no config permission", &extensions.Permissions{Network: true}, map[string]any{}},
+		{"declared keys only", &extensions.Permissions{Config: []string{"tag", "missing"}}, map[string]any{"tag": "v"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := scopeRPCHost(configRPCHost{}, tt.permissions).Config(context.Background())
+			if err != nil {
+				t.Fatalf("Config() error = %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("Config() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtensionHookRunner_RPC_SandboxSetupFailureFallsBack(t *testing.T) {
+	t.Cleanup(CloseRPCSessions)
+	t.Setenv(testSandboxSetupFailEnv, "1")
+
+	entry := writeRPCExtensionEntry(t)
+	runner := &ExtensionHookRunner{RPCHost: mockRPCHost{}}
+	manifest := &extensions.ExtensionManifest{
+		Name:        "rpc-sandboxed",
+		Protocol:    extensions.ProtocolRPC,
+		Permissions: &extensions.Permissions{Env: []string{testSandboxSetupFailEnv}, Config: []string{"path"}},
+	}
+	input := &HookInput{Hook: string(PostBumpHook), Version: "1.3.0", PreviousVersion: "1.2.0"}
+
+	var output *HookOutput
+	var execErr error
+	stderr, _ := testutils.CaptureStdout(func() {
+		output, execErr = runner.execute(context.Background(), entry, input, manifest)
+	})
+	if !strings.Contains(stderr, "forced setup failure") {
+		t.Skipf("sandbox helper not reached: %s", stderr)
+	}
+	if execErr != nil {
+		t.Fatalf("expected the extension to be restarted without the sandbox, got %v", execErr)
+	}
+	if !strings.Contains(output.Message, "path=.version") {
+		t.Errorf("unexpected message %q", output.Message)
+	}
+}
@@ -0,0 +1,198 @@
+package extensionmgr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/indaco/sley/internal/extensions"
+)
+
+// sandboxSpecEnv carries the sandbox specification from sley to the
+// re-executed sandbox helper process that sets up the namespaces.
+const sandboxSpecEnv = "SLEY_SANDBOX_SPEC"
+
+// sandboxHelperExitCode is returned by the helper when it fails before
+// the extension script is started.
+const sandboxHelperExitCode = 126
+
+// sandboxStatusFD is the descriptor of the status pipe in the helper
+// process (the first entry of ExtraFiles). The helper reports setup failures
+// on it and marks it close-on-exec, so the extension script cannot write to it.
+const sandboxStatusFD = 3
+
+// baseEnvVars are passed through to every sandboxed extension, in addition
+// to the variables declared in the manifest permissions.
+var baseEnvVars = []string{"PATH", "HOME", "USER", "LANG", "LC_ALL", "TMPDIR", "TERM"}
+
+// errSandboxUnsupported is returned when the platform cannot enforce permissions.
+var errSandboxUnsupported = errors.New("sandboxing is not supported on this platform")
+
+// sandboxSetupError reports that the sandbox helper could not confine the
+// extension, e.g. because a mount or prctl call was refused.
+type sandboxSetupError struct {
+	err error
+}
+
+func (e *sandboxSetupError) Error() string { return e.err.Error() }
+
+func (e *sandboxSetupError) Unwrap() error { return e.err }
+
+// sandboxSpec describes the confinement applied to a single extension run.
+type sandboxSpec struct {
+	Script      string   `json:"script"`
+	ProjectRoot string   `json:"project_root,omitempty"`
+	WritePaths  []string `json:"write_paths,omitempty"`
+	Network     bool     `json:"network"`
+}
+
+// newSandboxSpec resolves the declared permissions against the project root.
+// Declared write paths that do not exist yet cannot be bind-mounted; they are
+// returned as warnings and stay read-only.
+func newSandboxSpec(scriptPath, projectRoot string, perms *extensions.Permissions) (sandboxSpec, []string) {
+	spec := sandboxSpec{
+		Script:  scriptPath,
+		Network: perms.Network,
+	}
+
+	if projectRoot == "" {
+		return spec, nil
+	}
+
+	root, err := filepath.Abs(projectRoot)
+	if err != nil {
+		return spec, []string{fmt.Sprintf("cannot resolve project root %q: %v", projectRoot, err)}
+	}
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return spec, []string{fmt.Sprintf("project root %q is not a directory, skipping read-only mount", root)}
+	}
+	spec.ProjectRoot = root
+
+	var warnings []string
+	for _, p := range perms.Write {
+		full := filepath.Join(root, p)
+		if _, err := os.Stat(full); err != nil {
+			warnings = append(warnings, fmt.Sprintf("declared write path %q does not exist and stays read-only", p))
+			continue
+		}
+		spec.WritePaths = append(spec.WritePaths, full)
+	}
+
+	return spec, warnings
+}
+
+// scrubEnv returns the base environment plus the explicitly allowed variables.
+func scrubEnv(allowed []string) []string {
+	names := append(append([]string{}, baseEnvVars...), allowed...)
+	seen := make(map[string]bool, len(names))
+
+	var env []string
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env
+}
+
+// IsSandboxHelper reports whether the current process was started by sley
+// as the sandbox helper for an extension.
+func IsSandboxHelper() bool {
+	return os.Getenv(sandboxSpecEnv) != ""
+}
+
+// RunSandboxHelper sets up the sandbox described by the environment and
+// replaces the current process with the extension script. It only returns
+// control to the caller by exiting the process on failure.
+//
+// It must be called at the very start of main, before any other work.
+func RunSandboxHelper() {
+	var spec sandboxSpec
+	if err := json.Unmarshal([]byte(os.Getenv(sandboxSpecEnv)), &spec); err != nil {
+		fmt.Fprintf(os.Stderr, "sley sandbox: invalid specification: %v\n", err)
+		os.Exit(sandboxHelperExitCode)
+	}
+
+	env := make([]string, 0, len(os.Environ()))
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, sandboxSpecEnv+"=") {
+			env = append(env, kv)
+		}
+	}
+
+	// enterSandbox only returns on failure.
+	err := enterSandbox(spec, env)
+	var setupErr *sandboxSetupError
+	if errors.As(err, &setupErr) {
+		exitSandboxSetup(setupErr)
+	}
+	fmt.Fprintf(os.Stderr, "sley sandbox: %v\n", err)
+	os.Exit(sandboxHelperExitCode)
+}
+
+// exitSandboxSetup reports a sandbox setup failure to sley on the status
+// pipe and exits the helper process.
+func exitSandboxSetup(err error) {
+	fmt.Fprintf(os.Stderr, "sley sandbox: %v\n", err)
+	if status := os.NewFile(sandboxStatusFD, "sandbox-status"); status != nil {
+		_, _ = fmt.Fprint(status, err.Error())
+	}
+	os.Exit(sandboxHelperExitCode)
+}
+
+// sandboxStatus is sley's end of the status pipe shared with the sandbox
+// helper. Only the helper writes to it, and only before it execs the
+// extension script, so the script cannot fake a setup failure.
+type sandboxStatus struct {
+	r, w *os.File
+}
+
+// newSandboxStatus creates the status pipe and passes its write end to cmd.
+func newSandboxStatus(cmd *exec.Cmd) (*sandboxStatus, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox status pipe: %w", err)
+	}
+	cmd.ExtraFiles = []*os.File{w}
+	return &sandboxStatus{r: r, w: w}, nil
+}
+
+// started drops sley's copy of the write end once the helper is running.
+func (s *sandboxStatus) started() {
+	if s != nil {
+		_ = s.w.Close()
+	}
+}
+
+// close releases both ends of the pipe.
+func (s *sandboxStatus) close() {
+	if s != nil {
+		_ = s.r.Close()
+		_ = s.w.Close()
+	}
+}
+
+// setupFailure returns the error reported by the helper, or nil when it set
+// up the sandbox and started the extension. It must be called after the
+// process has exited.
+func (s *sandboxStatus) setupFailure() *sandboxSetupError {
+	if s == nil {
+		return nil
+	}
+	defer s.close()
+
+	data, err := io.ReadAll(s.r)
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	return &sandboxSetupError{err: errors.New(strings.TrimSpace(string(data)))}
+}
@@ -0,0 +1,147 @@
+//go:build linux
+
+package extensionmgr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// remountLockedFlags are the mount flags the kernel refuses to clear when
+// remounting inside a user namespace, so they are carried over as-is.
+const remountLockedFlags = unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC |
+	unix.MS_NOATIME | unix.MS_NODIRATIME | unix.MS_RELATIME
+
+// Secure bits from <linux/securebits.h>, not exported by x/sys/unix.
+const (
+	secbitNoRoot       = 1 << 0
+	secbitNoRootLocked = 1 << 1
+)
+
+// configureSandbox rewrites cmd so that it starts the sley sandbox helper in
+// fresh user and mount namespaces (plus a network namespace when network
+// access was not requested). The helper then execs the extension script.
+func configureSandbox(cmd *exec.Cmd, spec sandboxSpec) error {
+	self, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate sley executable: %w", err)
+	}
+
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to serialize sandbox specification: %w", err)
+	}
+
+	cloneFlags := uintptr(syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS)
+	if !spec.Network {
+		cloneFlags |= syscall.CLONE_NEWNET
+	}
+
+	uid, gid := os.Getuid(), os.Getgid()
+
+	cmd.Path = self
+	cmd.Args = []string{self}
+	cmd.Env = append(cmd.Env, sandboxSpecEnv+"="+string(specJSON))
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags:                 cloneFlags,
+		UidMappings:                []syscall.SysProcIDMap{{ContainerID: uid, HostID: uid, Size: 1}},
+		GidMappings:                []syscall.SysProcIDMap{{ContainerID: gid, HostID: gid, Size: 1}},
+		GidMappingsEnableSetgroups: false,
+		AmbientCaps:                []uintptr{unix.CAP_SYS_ADMIN, unix.CAP_SETPCAP},
+		Pdeathsig:                  syscall.SIGKILL,
+	}
+
+	return nil
+}
+
+// isSandboxUnavailable reports whether starting a sandboxed command failed
+// because the kernel does not allow the requested namespaces.
+func isSandboxUnavailable(err error) bool {
+	return errors.Is(err, syscall.EPERM) ||
+		errors.Is(err, syscall.EINVAL) ||
+		errors.Is(err, syscall.ENOSPC) ||
+		errors.Is(err, syscall.ENOSYS) ||
+		errors.Is(err, syscall.EACCES)
+}
+
+// enterSandbox runs inside the helper process: it makes the project
+// read-only except the declared write paths, drops every capability and
+// replaces itself with the extension script. It only returns on failure.
+func enterSandbox(spec sandboxSpec, env []string) error {
+	// The status pipe must not reach the extension script.
+	syscall.CloseOnExec(sandboxStatusFD)
+
+	if err := setupSandbox(spec); err != nil {
+		return &sandboxSetupError{err: err}
+	}
+	return syscall.Exec(spec.Script, []string{spec.Script}, env)
+}
+
+// setupSandbox confines the helper process: it makes the project read-only
+// except the declared write paths and drops every capability.
+func setupSandbox(spec sandboxSpec) error {
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %w", err)
+	}
+
+	if spec.ProjectRoot != "" {
+		// Capture the original flags first: a bind mount clones the flags of
+		// its source, so write paths would otherwise inherit the read-only bit.
+		paths := append([]string{spec.ProjectRoot}, spec.WritePaths...)
+		flags := make([]uintptr, len(paths))
+		for i, path := range paths {
+			var st unix.Statfs_t
+			if err := unix.Statfs(path, &st); err != nil {
+				return fmt.Errorf("failed to stat mount %s: %w", path, err)
+			}
+			flags[i] = uintptr(st.Flags) & remountLockedFlags
+		}
+
+		for i, path := range paths {
+			if err := bindMount(path, flags[i], i == 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	return dropPrivileges()
+}
+
+// bindMount bind-mounts path onto itself and remounts it with the requested
+// write access, preserving the original flags locked by the parent namespace.
+func bindMount(path string, origFlags uintptr, readOnly bool) error {
+	if err := unix.Mount(path, path, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return fmt.Errorf("failed to bind %s: %w", path, err)
+	}
+
+	flags := uintptr(unix.MS_BIND|unix.MS_REMOUNT) | origFlags
+	if readOnly {
+		flags |= unix.MS_RDONLY
+	}
+
+	if err := unix.Mount("", path, "", flags, ""); err != nil {
+		return fmt.Errorf("failed to remount %s: %w", path, err)
+	}
+	return nil
+}
+
+// dropPrivileges ensures the extension script cannot regain the capabilities
+// used to set up the sandbox, even when it runs as root inside the namespace.
+func dropPrivileges() error {
+	if err := unix.Prctl(unix.PR_SET_SECUREBITS, secbitNoRoot|secbitNoRootLocked, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set secure bits: %w", err)
+	}
+	if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to clear ambient capabilities: %w", err)
+	}
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set no_new_privs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+
+package extensionmgr
+
+import "os/exec"
+
+// configureSandbox reports that namespaces are unavailable, so extensions run
+// in warning-only mode with a scrubbed environment.
+func configureSandbox(_ *exec.Cmd, _ sandboxSpec) error {
+	return errSandboxUnsupported
+}
+
+// isSandboxUnavailable reports whether err means the sandbox cannot be used.
+func isSandboxUnavailable(err error) bool {
+	return err == errSandboxUnsupported
+}
+
+// enterSandbox is never reached on platforms without sandbox support.
+func enterSandbox(_ sandboxSpec, _ []string) error {
+	return errSandboxUnsupported
+}
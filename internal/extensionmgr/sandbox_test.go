@@ -0,0 +1,273 @@
+package extensionmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/extensions"
+	"github.com/indaco/sley/internal/testutils"
+	"github.com/indaco/sley/sdk"
+)
+
+// testSandboxSetupFailEnv makes the sandbox helper fail its setup, as it
+// would when a mount or prctl call is refused inside the namespaces.
+const testSandboxSetupFailEnv = "SLEY_TEST_SANDBOX_SETUP_FAIL"
+
+// TestMain lets the test binary act as the sandbox helper, the same way the
+// sley binary does when it re-executes itself for a sandboxed extension, and
+// as the RPC extension used by the rpc tests.
+func TestMain(m *testing.M) {
+	if IsSandboxHelper() {
+		if os.Getenv(testSandboxSetupFailEnv) != "" {
+			exitSandboxSetup(errors.New("forced setup failure"))
+		}
+		RunSandboxHelper()
+	}
+	if os.Getenv(testRPCExtensionEnv) != "" {
+		sdk.Run(testRPCExtension())
+	}
+	os.Exit(m.Run())
+}
+
+func TestScrubEnv(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin:/bin")
+	t.Setenv("SLEY_TEST_SECRET", "secret")
+	t.Setenv("SLEY_TEST_ALLOWED", "allowed")
+
+	env := scrubEnv([]string{"SLEY_TEST_ALLOWED", "PATH"})
+
+	if !slices.Contains(env, "PATH=/usr/bin:/bin") {
+		t.Errorf("expected PATH to be kept, got %v", env)
+	}
+	if !slices.Contains(env, "SLEY_TEST_ALLOWED=allowed") {
+		t.Errorf("expected declared variable to be kept, got %v", env)
+	}
+	for _, kv := range env {
+		if strings.HasPrefix(kv, "SLEY_TEST_SECRET=") {
+			t.Errorf("expected undeclared variable to be removed, got %v", env)
+		}
+		if strings.HasPrefix(kv, "PATH=") && kv != "PATH=/usr/bin:/bin" {
+			t.Errorf("expected PATH once, got %v", env)
+		}
+	}
+}
+
+func TestNewSandboxSpec(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "dist"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	perms := &extensions.Permissions{
+		Network: true,
+		Write:   []string{"dist", "missing"},
+	}
+
+	spec, warnings := newSandboxSpec("/ext/hook.sh", root, perms)
+
+	if spec.Script != "/ext/hook.sh" || !spec.Network {
+		t.Errorf("unexpected spec: %+v", spec)
+	}
+	if spec.ProjectRoot != root {
+		t.Errorf("expected project root %q, got %q", root, spec.ProjectRoot)
+	}
+	if len(spec.WritePaths) != 1 || spec.WritePaths[0] != filepath.Join(root, "dist") {
+		t.Errorf("expected only existing write path, got %v", spec.WritePaths)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "missing") {
+		t.Errorf("expected a warning for the missing path, got %v", warnings)
+	}
+}
+
+func TestNewSandboxSpec_InvalidProjectRoot(t *testing.T) {
+	spec, warnings := newSandboxSpec("/ext/hook.sh", filepath.Join(t.TempDir(), "nope"), &extensions.Permissions{})
+
+	if spec.ProjectRoot != "" {
+		t.Errorf("expected no project root, got %q", spec.ProjectRoot)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("expected one warning, got %v", warnings)
+	}
+}
+
+func TestScriptExecutor_ExecuteSandboxed_ScrubsEnvironment(t *testing.T) {
+	t.Setenv("SLEY_TEST_SECRET", "secret")
+	t.Setenv("SLEY_TEST_ALLOWED", "allowed")
+
+	tmpDir := t.TempDir()
+	scriptPath := filepath.Join(tmpDir, "hook.sh")
+	script := `#!/bin/sh
+cat > /dev/null
+echo "{\"success\": true, \"message\": \"secret=${SLEY_TEST_SECRET:-none} allowed=${SLEY_TEST_ALLOWED:-none}\"}"
+`
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	executor := NewScriptExecutor()
+	perms := &extensions.Permissions{Env: []string{"SLEY_TEST_ALLOWED"}}
+	output, err := executor.ExecuteSandboxed(context.Background(), scriptPath, &HookInput{Hook: "post-bump", Version: "1.2.3"}, perms)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Message != "secret=none allowed=allowed" {
+		t.Errorf("unexpected environment seen by script: %q", output.Message)
+	}
+}
+
+func TestScriptExecutor_ExecuteSandboxed_ReadOnlyProject(t *testing.T) {
+	projectRoot := t.TempDir()
+	if err := os.Mkdir(filepath.Join(projectRoot, "out"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpDir := t.TempDir()
+	scriptPath := filepath.Join(tmpDir, "hook.sh")
+	script := `#!/bin/sh
+root=$(sed 's/.*"project_root":"\([^"]*\)".*/\1/')
+if touch "$root/blocked.txt" 2>/dev/null; then blocked=no; else blocked=yes; fi
+if touch "$root/out/allowed.txt" 2>/dev/null; then allowed=yes; else allowed=no; fi
+echo "{\"success\": true, \"message\": \"blocked=$blocked allowed=$allowed\"}"
+`
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	executor := NewScriptExecutor()
+	perms := &extensions.Permissions{Write: []string{"out"}}
+	input := &HookInput{Hook: "post-bump", Version: "1.2.3", ProjectRoot: projectRoot}
+
+	var output *HookOutput
+	var execErr error
+	stderr, _ := testutils.CaptureStdout(func() {
+		output, execErr = executor.ExecuteSandboxed(context.Background(), scriptPath, input, perms)
+	})
+	if execErr != nil {
+		t.Fatalf("unexpected error: %v", execErr)
+	}
+
+	if !strings.Contains(output.Message, "allowed=yes") {
+		t.Errorf("expected declared write path to be writable, got %q", output.Message)
+	}
+
+	// Where namespaces are unavailable the executor only warns.
+	if strings.Contains(stderr, "cannot sandbox") {
+		t.Skipf("sandbox unavailable, ran in warning-only mode: %s", stderr)
+	}
+	if !strings.Contains(output.Message, "blocked=yes") {
+		t.Errorf("expected project root to be read-only, got %q", output.Message)
+	}
+}
+
+func TestScriptExecutor_ExecuteSandboxed_SetupFailureFallsBack(t *testing.T) {
+	t.Setenv(testSandboxSetupFailEnv, "1")
+	t.Setenv("SLEY_TEST_SECRET", "secret")
+
+	scriptPath := filepath.Join(t.TempDir(), "hook.sh")
+	script := `#!/bin/sh
+cat > /dev/null
+echo "{\"success\": true, \"message\": \"secret=${SLEY_TEST_SECRET:-none}\"}"
+`
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	executor := NewScriptExecutor()
+	perms := &extensions.Permissions{Env: []string{testSandboxSetupFailEnv}}
+
+	var output *HookOutput
+	var execErr error
+	stderr, _ := testutils.CaptureStdout(func() {
+		output, execErr = executor.ExecuteSandboxed(context.Background(), scriptPath, &HookInput{Hook: "post-bump", Version: "1.2.3"}, perms)
+	})
+	if !strings.Contains(stderr, "forced setup failure") {
+		t.Skipf("sandbox helper not reached: %s", stderr)
+	}
+	if execErr != nil {
+		t.Fatalf("expected the hook to be re-run without the sandbox, got %v", execErr)
+	}
+	if !strings.Contains(stderr, "running with a scrubbed environment only") {
+		t.Errorf("expected a fallback warning, got %q", stderr)
+	}
+	if output.Message != "secret=none" {
+		t.Errorf("expected the fallback to keep the scrubbed environment, got %q", output.Message)
+	}
+}
+
+func TestScriptExecutor_ExecuteSandboxed_ScriptCannotFakeSetupFailure(t *testing.T) {
+	tmpDir := t.TempDir()
+	runs := filepath.Join(tmpDir, "runs")
+	scriptPath := filepath.Join(tmpDir, "hook.sh")
+	script := fmt.Sprintf(`#!/bin/sh
+cat > /dev/null
+echo run >> %q
+echo "sley sandbox: setup failed: mount refused" >&2
+echo "mount refused" >&3 2>/dev/null
+exit 125
+`, runs)
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	executor := NewScriptExecutor()
+	var execErr error
+	stderr, _ := testutils.CaptureStdout(func() {
+		_, execErr = executor.ExecuteSandboxed(context.Background(), scriptPath, &HookInput{Hook: "post-bump", Version: "1.2.3"}, &extensions.Permissions{})
+	})
+	if execErr == nil {
+		t.Fatal("expected the script failure to be reported")
+	}
+
+	data, err := os.ReadFile(runs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(data), "run"); n != 1 {
+		t.Errorf("expected the script to run once, ran %d times (stderr: %s)", n, stderr)
+	}
+}
+
+func TestExtensionHookRunner_UsesSandboxWhenPermissionsDeclared(t *testing.T) {
+	var sandboxed bool
+	runner := &ExtensionHookRunner{
+		Executor: &mockSandboxedExecutor{
+			onSandboxed: func(perms *extensions.Permissions) { sandboxed = perms != nil },
+		},
+	}
+
+	perms := &extensions.Permissions{Network: true}
+	if _, err := runner.execute(context.Background(), "hook.sh", &HookInput{}, &extensions.ExtensionManifest{Permissions: perms}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sandboxed {
+		t.Error("expected sandboxed execution when permissions are declared")
+	}
+
+	sandboxed = false
+	if _, err := runner.execute(context.Background(), "hook.sh", &HookInput{}, &extensions.ExtensionManifest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sandboxed {
+		t.Error("expected plain execution without declared permissions")
+	}
+}
+
+type mockSandboxedExecutor struct {
+	onSandboxed func(perms *extensions.Permissions)
+}
+
+func (m *mockSandboxedExecutor) Execute(_ context.Context, _ string, _ *HookInput) (*HookOutput, error) {
+	return &HookOutput{Success: true}, nil
+}
+
+func (m *mockSandboxedExecutor) ExecuteSandboxed(_ context.Context, _ string, _ *HookInput, perms *extensions.Permissions) (*HookOutput, error) {
+	m.onSandboxed(perms)
+	return &HookOutput{Success: true}, nil
+}
@@ -0,0 +1,230 @@
+package extensionmgr
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/goccy/go-yaml"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/extensions"
+)
+
+//go:embed scaffold
+var scaffoldFS embed.FS
+
+// ScaffoldLanguages lists the languages supported by Scaffold.
+var ScaffoldLanguages = []string{"sh", "python", "go", "node"}
+
+// scaffoldEntries maps each language to the entry script named in the manifest.
+var scaffoldEntries = map[string]string{
+	"sh":     "hook.sh",
+	"python": "hook.py",
+	"node":   "hook.js",
+	"go":     "run.sh",
+}
+
+// extensionNameRegex matches valid extension names (e.g. "docker-tag-sync").
+var extensionNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// ScaffoldOptions configures the generation of a new extension.
+type ScaffoldOptions struct {
+	Name        string   // Extension name, also used as the directory name
+	Lang        string   // One of ScaffoldLanguages
+	Hooks       []string // Hook points the extension handles
+	Dir         string   // Parent directory for the extension directory
+	Description string
+	Author      string
+	Repository  string
+}
+
+// scaffoldData is the data passed to the scaffold templates.
+type scaffoldData struct {
+	ScaffoldOptions
+	Entry string
+}
+
+// Scaffold generates a new extension directory containing a manifest, an entry
+// script that parses HookInput and emits HookOutput, a README and a test harness
+// with sample inputs. It returns the extension directory and the created files.
+func Scaffold(opts ScaffoldOptions) (string, []string, error) {
+	if err := validateScaffoldOptions(&opts); err != nil {
+		return "", nil, err
+	}
+
+	extDir := filepath.Join(opts.Dir, opts.Name)
+	if entries, err := os.ReadDir(extDir); err == nil && len(entries) > 0 {
+		return "", nil, fmt.Errorf("directory %q already exists and is not empty", extDir)
+	}
+
+	data := scaffoldData{ScaffoldOptions: opts, Entry: scaffoldEntries[opts.Lang]}
+
+	files, err := renderScaffold(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The manifest must be loadable exactly as LoadExtensionManifest would load it.
+	if err := validateScaffoldManifest(files["extension.yaml"]); err != nil {
+		return "", nil, err
+	}
+
+	for _, hook := range opts.Hooks {
+		sample, err := sampleHookInput(hook)
+		if err != nil {
+			return "", nil, err
+		}
+		files[path.Join("testdata", hook+".json")] = sample
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	created := make([]string, 0, len(names))
+	for _, name := range names {
+		target := filepath.Join(extDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), core.PermDirDefault); err != nil {
+			return "", nil, fmt.Errorf("failed to create directory for %s: %w", name, err)
+		}
+
+		perm := core.PermPublicRead
+		if name == data.Entry || strings.HasSuffix(name, ".sh") {
+			perm |= core.PermExecutable
+		}
+		if err := os.WriteFile(target, files[name], perm); err != nil {
+			return "", nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		created = append(created, target)
+	}
+
+	return extDir, created, nil
+}
+
+// validateScaffoldOptions checks the options and fills in defaults.
+func validateScaffoldOptions(opts *ScaffoldOptions) error {
+	if !extensionNameRegex.MatchString(opts.Name) {
+		return fmt.Errorf("invalid extension name %q: use lowercase letters, digits and dashes", opts.Name)
+	}
+	if !slices.Contains(ScaffoldLanguages, opts.Lang) {
+		return fmt.Errorf("unsupported language %q, must be one of: %s", opts.Lang, strings.Join(ScaffoldLanguages, ", "))
+	}
+	if len(opts.Hooks) == 0 {
+		opts.Hooks = []string{string(PostBumpHook)}
+	}
+	for _, hook := range opts.Hooks {
+		if err := ValidateExtensionHook(hook); err != nil {
+			return err
+		}
+	}
+
+	if opts.Dir == "" {
+		opts.Dir = "."
+	}
+	if opts.Description == "" {
+		opts.Description = fmt.Sprintf("sley extension %s", opts.Name)
+	}
+	if opts.Author == "" {
+		opts.Author = "unknown"
+	}
+	if opts.Repository == "" {
+		opts.Repository = fmt.Sprintf("https://example.com/%s", opts.Name)
+	}
+	return nil
+}
+
+// scaffoldFuncs are the functions available to the scaffold templates.
+// quote renders user input as a double-quoted YAML scalar, so values
+// containing "#", ": " or a leading "-" or "[" survive intact. line, pydoc
+// and jsdoc render user input inside source comments: line collapses it to
+// a single line for "//" and "#" comments, pydoc and jsdoc also escape the
+// sequences that would close a Python docstring or a JS block comment.
+var scaffoldFuncs = template.FuncMap{
+	"quote": strconv.Quote,
+	"line":  commentLine,
+	"pydoc": func(s string) string {
+		s = strings.ReplaceAll(commentLine(s), `\`, `\\`)
+		return strings.ReplaceAll(s, `"""`, `\"\"\"`)
+	},
+	"jsdoc": func(s string) string {
+		return strings.ReplaceAll(commentLine(s), "*/", `*\/`)
+	},
+}
+
+// commentLine collapses every run of whitespace, including newlines, into a
+// single space.
+func commentLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// renderScaffold renders the common templates and the language templates,
+// keyed by their path relative to the extension directory.
+func renderScaffold(data scaffoldData) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	for _, dir := range []string{"scaffold/common", "scaffold/" + data.Lang} {
+		err := fs.WalkDir(scaffoldFS, dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			raw, err := scaffoldFS.ReadFile(p)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := template.New(p).Funcs(scaffoldFuncs).Parse(string(raw))
+			if err != nil {
+				return fmt.Errorf("failed to parse template %s: %w", p, err)
+			}
+
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return fmt.Errorf("failed to render template %s: %w", p, err)
+			}
+
+			name := strings.TrimSuffix(strings.TrimPrefix(p, dir+"/"), ".tmpl")
+			files[name] = buf.Bytes()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// validateScaffoldManifest decodes and validates a generated manifest.
+func validateScaffoldManifest(data []byte) error {
+	var manifest extensions.ExtensionManifest
+	if err := yaml.NewDecoder(bytes.NewReader(data), yaml.Strict()).Decode(&manifest); err != nil {
+		return fmt.Errorf("generated manifest is invalid: %w", err)
+	}
+	if err := manifest.ValidateManifest(); err != nil {
+		return fmt.Errorf("generated manifest is invalid: %w", err)
+	}
+	return nil
+}
+
+// sampleHookInput returns an indented sample HookInput for the given hook.
+func sampleHookInput(hook string) ([]byte, error) {
+	input := NewSampleHookInput(HookType(hook), "1.2.3", "/path/to/project")
+	data, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize sample input: %w", err)
+	}
+	return append(data, '\n'), nil
+}
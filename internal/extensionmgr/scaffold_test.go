@@ -0,0 +1,196 @@
+package extensionmgr
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/extensions"
+)
+
+func TestScaffold_AllLanguages(t *testing.T) {
+	interpreters := map[string]string{"sh": "sh", "python": "python3", "node": "node", "go": "go"}
+
+	for _, lang := range ScaffoldLanguages {
+		t.Run(lang, func(t *testing.T) {
+			dir := t.TempDir()
+			extDir, files, err := Scaffold(ScaffoldOptions{
+				Name:  "demo-" + lang,
+				Lang:  lang,
+				Hooks: []string{"pre-bump", "post-bump"},
+				Dir:   dir,
+			})
+			if err != nil {
+				t.Fatalf("Scaffold() error = %v", err)
+			}
+
+			for _, name := range []string{"extension.yaml", "README.md", "test.sh", "testdata/pre-bump.json", "testdata/post-bump.json", scaffoldEntries[lang]} {
+				if _, err := os.Stat(filepath.Join(extDir, name)); err != nil {
+					t.Errorf("expected %s to be created: %v", name, err)
+				}
+			}
+			if len(files) == 0 {
+				t.Error("expected created files to be reported")
+			}
+
+			manifest, err := extensions.LoadExtensionManifest(extDir)
+			if err != nil {
+				t.Fatalf("generated manifest failed to load: %v", err)
+			}
+			if manifest.Name != "demo-"+lang || manifest.Entry != scaffoldEntries[lang] {
+				t.Errorf("unexpected manifest: %+v", manifest)
+			}
+			if manifest.Permissions == nil || manifest.Permissions.Network {
+				t.Errorf("expected least-privilege permissions, got %+v", manifest.Permissions)
+			}
+
+			if _, err := exec.LookPath(interpreters[lang]); err != nil {
+				t.Skipf("%s not available", interpreters[lang])
+			}
+
+			input := NewSampleHookInput(PostBumpHook, "1.2.3", dir)
+			_, output, err := InvokeExtension(context.Background(), nil, extDir, PostBumpHook, input)
+			if err != nil {
+				t.Fatalf("InvokeExtension() error = %v", err)
+			}
+			if !output.Success || !strings.Contains(output.Message, "post-bump for 1.2.3") {
+				t.Errorf("unexpected output: %+v", output)
+			}
+		})
+	}
+}
+
+func TestScaffold_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts ScaffoldOptions
+		want string
+	}{
+		{"invalid name", ScaffoldOptions{Name: "My Extension", Lang: "sh"}, "invalid extension name"},
+		{"unsupported language", ScaffoldOptions{Name: "demo", Lang: "ruby"}, "unsupported language"},
+		{"invalid hook", ScaffoldOptions{Name: "demo", Lang: "sh", Hooks: []string{"on-release"}}, "invalid hook type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Dir = t.TempDir()
+			_, _, err := Scaffold(tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestScaffold_QuotesManifestValues(t *testing.T) {
+	opts := ScaffoldOptions{
+		Name:        "demo",
+		Lang:        "sh",
+		Dir:         t.TempDir(),
+		Description: "- syncs tags: fast # really",
+		Author:      `[Jane "JD" Doe]`,
+		Repository:  "https://example.com/demo#readme",
+	}
+	extDir, _, err := Scaffold(opts)
+	if err != nil {
+		t.Fatalf("Scaffold() error = %v", err)
+	}
+
+	manifest, err := extensions.LoadExtensionManifest(extDir)
+	if err != nil {
+		t.Fatalf("generated manifest failed to load: %v", err)
+	}
+	if manifest.Description != opts.Description {
+		t.Errorf("Description = %q, want %q", manifest.Description, opts.Description)
+	}
+	if manifest.Author != opts.Author {
+		t.Errorf("Author = %q, want %q", manifest.Author, opts.Author)
+	}
+	if manifest.Repository != opts.Repository {
+		t.Errorf("Repository = %q, want %q", manifest.Repository, opts.Repository)
+	}
+}
+
+func TestScaffold_DescriptionInSourceComments(t *testing.T) {
+	interpreters := map[string]string{"sh": "sh", "python": "python3", "node": "node", "go": "go"}
+	description := "Syncs tags\nexit 1\n\"\"\" + 1 */ process.exit(1) \\"
+
+	for _, lang := range ScaffoldLanguages {
+		t.Run(lang, func(t *testing.T) {
+			dir := t.TempDir()
+			extDir, _, err := Scaffold(ScaffoldOptions{Name: "demo-" + lang, Lang: lang, Dir: dir, Description: description})
+			if err != nil {
+				t.Fatalf("Scaffold() error = %v", err)
+			}
+
+			entry := scaffoldEntries[lang]
+			if lang == "go" {
+				entry = "main.go"
+			}
+			source, err := os.ReadFile(filepath.Join(extDir, entry))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(source), "Syncs tags exit 1") {
+				t.Errorf("expected the description on a single line in %s:\n%s", entry, source)
+			}
+
+			if _, err := exec.LookPath(interpreters[lang]); err != nil {
+				t.Skipf("%s not available", interpreters[lang])
+			}
+
+			input := NewSampleHookInput(PostBumpHook, "1.2.3", dir)
+			_, output, err := InvokeExtension(context.Background(), nil, extDir, PostBumpHook, input)
+			if err != nil {
+				t.Fatalf("InvokeExtension() error = %v", err)
+			}
+			if !output.Success {
+				t.Errorf("unexpected output: %+v", output)
+			}
+		})
+	}
+}
+
+func TestScaffold_RefusesNonEmptyDirectory(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "demo"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "demo", "keep.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := Scaffold(ScaffoldOptions{Name: "demo", Lang: "sh", Dir: dir})
+	if err == nil || !strings.Contains(err.Error(), "not empty") {
+		t.Errorf("expected non-empty directory error, got %v", err)
+	}
+}
+
+func TestNewSampleHookInput(t *testing.T) {
+	input := NewSampleHookInput(PreBumpHook, "1.4.2", "/project")
+	if input.Hook != "pre-bump" || input.Version != "1.4.2" || input.ProjectRoot != "/project" {
+		t.Errorf("unexpected input: %+v", input)
+	}
+	if input.PreviousVersion != "1.4.1" {
+		t.Errorf("expected previous version 1.4.1, got %q", input.PreviousVersion)
+	}
+
+	if got := NewSampleHookInput(PreBumpHook, "2.0.0", "").PreviousVersion; got != "" {
+		t.Errorf("expected no previous version for x.y.0, got %q", got)
+	}
+}
+
+func TestInvokeExtension_UndeclaredHook(t *testing.T) {
+	extDir, _, err := Scaffold(ScaffoldOptions{Name: "demo", Lang: "sh", Hooks: []string{"pre-bump"}, Dir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = InvokeExtension(context.Background(), nil, extDir, PostBumpHook, &HookInput{Hook: "post-bump"})
+	if err == nil || !strings.Contains(err.Error(), "does not declare") {
+		t.Errorf("expected undeclared hook error, got %v", err)
+	}
+}
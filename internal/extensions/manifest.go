@@ -2,9 +2,19 @@ package extensions
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 )
 
+// Extension protocols supported in the manifest "protocol" field.
+const (
+	// ProtocolExec runs the entry once per hook with the input on stdin (default).
+	ProtocolExec = "exec"
+
+	// ProtocolRPC starts the entry once per run and exchanges JSON-RPC messages over stdio.
+	ProtocolRPC = "rpc"
+)
+
 const (
 	// CurrentSchemaVersion is the latest manifest schema version supported by this build.
 	CurrentSchemaVersion = 1
@@ -60,7 +70,12 @@ func (e *ManifestNotFoundError) Suggestion() string {
 	sb.WriteString("  author: Your Name\n")
 	sb.WriteString("  repository: https://github.com/user/repo\n")
 	sb.WriteString("  entry: script.sh\n")
-	sb.WriteString("  hooks: [post-bump]  # optional\n\n")
+	sb.WriteString("  hooks: [post-bump]  # optional\n")
+	sb.WriteString("  protocol: exec      # optional, exec or rpc\n")
+	sb.WriteString("  permissions:        # optional, runs the extension sandboxed\n")
+	sb.WriteString("    network: false\n")
+	sb.WriteString("    write: [CHANGELOG.md]\n")
+	sb.WriteString("    env: [GITHUB_TOKEN]\n\n")
 	sb.WriteString("Documentation: https://sley.dev/extensions/manifest\n")
 
 	return sb.String()
@@ -124,15 +139,68 @@ func (e *ManifestValidationError) Suggestion() string {
 // - Repository: URL of the extension's source repository
 // - Entry: Path to the executable script or binary (relative to extension directory)
 // - Hooks: List of hook points this extension supports (optional)
+// - Permissions: Capabilities the extension needs at runtime (optional)
+// - Protocol: How sley talks to the entry, "exec" (default) or "rpc" (optional)
 type ExtensionManifest struct {
-	SchemaVersion int      `yaml:"schema_version,omitempty"`
-	Name          string   `yaml:"name"`
-	Version       string   `yaml:"version"`
-	Description   string   `yaml:"description"`
-	Author        string   `yaml:"author"`
-	Repository    string   `yaml:"repository"`
-	Entry         string   `yaml:"entry"`
-	Hooks         []string `yaml:"hooks,omitempty"`
+	SchemaVersion int          `yaml:"schema_version,omitempty"`
+	Name          string       `yaml:"name"`
+	Version       string       `yaml:"version"`
+	Description   string       `yaml:"description"`
+	Author        string       `yaml:"author"`
+	Repository    string       `yaml:"repository"`
+	Entry         string       `yaml:"entry"`
+	Hooks         []string     `yaml:"hooks,omitempty"`
+	Permissions   *Permissions `yaml:"permissions,omitempty"`
+	Protocol      string       `yaml:"protocol,omitempty"`
+}
+
+// IsRPC reports whether the extension uses the long-running JSON-RPC protocol.
+func (m *ExtensionManifest) IsRPC() bool {
+	return m.Protocol == ProtocolRPC
+}
+
+// Permissions declares what an extension is allowed to do when it runs.
+//
+// When a manifest declares permissions, sley runs the extension sandboxed:
+// - Network: Whether the extension may access the network
+// - Write: Paths (relative to the project root) the extension may modify;
+// the rest of the project is mounted read-only
+// - Env: Names of environment variables passed through to the extension
+// - Config: Top-level .sley.yaml keys an RPC extension may read through
+// sley/config; every other key is withheld
+//
+// Manifests without a permissions block run unrestricted, as before.
+type Permissions struct {
+	Network bool     `yaml:"network,omitempty"`
+	Write   []string `yaml:"write,omitempty"`
+	Env     []string `yaml:"env,omitempty"`
+	Config  []string `yaml:"config,omitempty"`
+}
+
+// validate returns a description of every invalid permission entry.
+func (p *Permissions) validate() []string {
+	var invalid []string
+
+	for i, path := range p.Write {
+		clean := filepath.Clean(path)
+		if path == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+			invalid = append(invalid, fmt.Sprintf("permissions.write[%d] (must be a relative path inside the project)", i))
+		}
+	}
+
+	for i, name := range p.Env {
+		if name == "" || strings.ContainsAny(name, "= \t") {
+			invalid = append(invalid, fmt.Sprintf("permissions.env[%d] (must be an environment variable name)", i))
+		}
+	}
+
+	for i, key := range p.Config {
+		if key == "" || strings.ContainsAny(key, ". \t") {
+			invalid = append(invalid, fmt.Sprintf("permissions.config[%d] (must be a top-level config key)", i))
+		}
+	}
+
+	return invalid
 }
 
 // ValidateManifest ensures all required fields are present and the manifest version is supported.
@@ -178,6 +246,12 @@ func (m *ExtensionManifest) ValidateManifest() error {
 	if m.Entry == "" {
 		missingFields = append(missingFields, "entry")
 	}
+	if m.Permissions != nil {
+		missingFields = append(missingFields, m.Permissions.validate()...)
+	}
+	if m.Protocol != "" && m.Protocol != ProtocolExec && m.Protocol != ProtocolRPC {
+		missingFields = append(missingFields, "protocol (must be exec or rpc)")
+	}
 
 	if len(missingFields) > 0 {
 		return &ManifestValidationError{
@@ -608,3 +608,96 @@ func TestManifestValidation_MultipleErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestExtensionManifest_ValidatePermissions(t *testing.T) {
+	t.Parallel()
+	base := ExtensionManifest{
+		Name:        "sandboxed",
+		Version:     "1.0.0",
+		Description: "Sandboxed extension",
+		Author:      "indaco",
+		Repository:  "https://github.com/indaco/sandboxed",
+		Entry:       "hook.sh",
+	}
+
+	tests := []struct {
+		name        string
+		permissions *Permissions
+		wantField   string
+	}{
+		{"valid permissions", &Permissions{Network: true, Write: []string{"CHANGELOG.md", "dist/"}, Env: []string{"GITHUB_TOKEN"}, Config: []string{"changelog", "tag"}}, ""},
+		{"absolute write path", &Permissions{Write: []string{"/etc"}}, "permissions.write[0]"},
+		{"write path escaping project", &Permissions{Write: []string{"ok", "../outside"}}, "permissions.write[1]"},
+		{"empty write path", &Permissions{Write: []string{""}}, "permissions.write[0]"},
+		{"env with equals sign", &Permissions{Env: []string{"TOKEN=value"}}, "permissions.env[0]"},
+		{"empty env name", &Permissions{Env: []string{""}}, "permissions.env[0]"},
+		{"nested config key", &Permissions{Config: []string{"changelog.format"}}, "permissions.config[0]"},
+		{"empty config key", &Permissions{Config: []string{"path", ""}}, "permissions.config[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := base
+			m.Permissions = tt.permissions
+
+			err := m.ValidateManifest()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			var valErr *ManifestValidationError
+			if !errors.As(err, &valErr) {
+				t.Fatalf("expected ManifestValidationError, got %v", err)
+			}
+			found := slices.ContainsFunc(valErr.MissingFields, func(f string) bool {
+				return strings.HasPrefix(f, tt.wantField)
+			})
+			if !found {
+				t.Errorf("expected invalid field %q, got %v", tt.wantField, valErr.MissingFields)
+			}
+		})
+	}
+}
+
+func TestExtensionManifest_ValidateProtocol(t *testing.T) {
+	t.Parallel()
+	base := ExtensionManifest{
+		Name:        "rpc",
+		Version:     "1.0.0",
+		Description: "RPC extension",
+		Author:      "indaco",
+		Repository:  "https://github.com/indaco/rpc",
+		Entry:       "run.sh",
+	}
+
+	tests := []struct {
+		protocol string
+		wantErr  bool
+		wantRPC  bool
+	}{
+		{"", false, false},
+		{ProtocolExec, false, false},
+		{ProtocolRPC, false, true},
+		{"grpc", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run("protocol="+tt.protocol, func(t *testing.T) {
+			t.Parallel()
+			m := base
+			m.Protocol = tt.protocol
+
+			err := m.ValidateManifest()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateManifest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if m.IsRPC() != tt.wantRPC {
+				t.Errorf("IsRPC() = %v, want %v", m.IsRPC(), tt.wantRPC)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ type BumpOperation struct {
 	preRelease       string
 	metadata         string
 	preserveMetadata bool
+	channels         []string
 }
 
 // NewBumpOperation creates a new bump operation.
@@ -44,6 +45,13 @@ func NewBumpOperation(fs core.FileSystem, bumper semver.VersionBumper, bumpType
 	}
 }
 
+// WithNextChannel makes BumpPre move the pre-release to the next of the
+// ordered channels (e.g. alpha.3 -> beta.1) instead of incrementing it.
+func (op *BumpOperation) WithNextChannel(channels []string) *BumpOperation {
+	op.channels = channels
+	return op
+}
+
 // Execute performs the bump operation on the module.
 func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) error {
 	// Check for context cancellation
@@ -54,7 +62,7 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 	}
 
 	// Create version manager
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 
 	// Read current version
 	currentVer, err := vm.Read(ctx, mod.Path)
@@ -87,12 +95,8 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 // calculateNewVersion computes the new version based on bump type.
 func (op *BumpOperation) calculateNewVersion(currentVer semver.SemVersion) (semver.SemVersion, error) {
 	switch op.bumpType {
-	case BumpPatch:
-		return op.bumpPatch(currentVer), nil
-	case BumpMinor:
-		return op.bumpMinor(currentVer), nil
-	case BumpMajor:
-		return op.bumpMajor(currentVer), nil
+	case BumpPatch, BumpMinor, BumpMajor:
+		return semver.BumpByLabel(currentVer, string(op.bumpType))
 	case BumpRelease:
 		return op.bumpRelease(currentVer), nil
 	case BumpAuto:
@@ -104,30 +108,6 @@ func (op *BumpOperation) calculateNewVersion(currentVer semver.SemVersion) (semv
 	}
 }
 
-func (op *BumpOperation) bumpPatch(current semver.SemVersion) semver.SemVersion {
-	return semver.SemVersion{
-		Major: current.Major,
-		Minor: current.Minor,
-		Patch: current.Patch + 1,
-	}
-}
-
-func (op *BumpOperation) bumpMinor(current semver.SemVersion) semver.SemVersion {
-	return semver.SemVersion{
-		Major: current.Major,
-		Minor: current.Minor + 1,
-		Patch: 0,
-	}
-}
-
-func (op *BumpOperation) bumpMajor(current semver.SemVersion) semver.SemVersion {
-	return semver.SemVersion{
-		Major: current.Major + 1,
-		Minor: 0,
-		Patch: 0,
-	}
-}
-
 func (op *BumpOperation) bumpRelease(current semver.SemVersion) semver.SemVersion {
 	return semver.SemVersion{
 		Major: current.Major,
@@ -166,12 +146,15 @@ func (op *BumpOperation) bumpPre(current semver.SemVersion) (semver.SemVersion,
 
 // calculatePreRelease determines the pre-release string for BumpPre.
 func (op *BumpOperation) calculatePreRelease(current semver.SemVersion) (string, error) {
+	if len(op.channels) > 0 {
+		return semver.NextChannel(current.PreRelease, op.channels)
+	}
 	if op.preRelease != "" {
-		return semver.IncrementPreRelease(current.PreRelease, op.preRelease), nil
+		return semver.IncrementPreRelease(current.PreRelease, op.preRelease)
 	}
 	if current.PreRelease != "" {
 		base := semver.ExtractPreReleaseBase(current.PreRelease)
-		return semver.IncrementPreRelease(current.PreRelease, base), nil
+		return semver.IncrementPreRelease(current.PreRelease, base)
 	}
 	return "", fmt.Errorf("current version has no pre-release; use --label to specify one")
 }
@@ -214,7 +197,7 @@ func (op *BumpOperation) Preview(ctx context.Context, path string) (BumpResult,
 	default:
 	}
 
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 	currentVer, err := vm.Read(ctx, path)
 	if err != nil {
 		return BumpResult{}, fmt.Errorf("failed to read version from %s: %w", path, err)
@@ -244,6 +227,6 @@ func (op *BumpOperation) Write(ctx context.Context, path string, version semver.
 	default:
 	}
 
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 	return vm.Save(ctx, path, version)
 }
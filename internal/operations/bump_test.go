@@ -653,6 +653,49 @@ func TestBumpOperation_Execute_Pre_WithLabel(t *testing.T) {
 	}
 }
 
+func TestBumpOperation_Execute_Pre_NextChannel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		initial  string
+		expected string
+		wantErr  bool
+	}{
+		{name: "alpha to beta", initial: "1.4.0-alpha.3\n", expected: "1.4.0-beta.1\n"},
+		{name: "beta to rc", initial: "1.4.0-beta.2\n", expected: "1.4.0-rc.1\n"},
+		{name: "last channel", initial: "1.4.0-rc.1\n", wantErr: true},
+		{name: "stable version", initial: "1.4.0\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/test/.version", []byte(tt.initial))
+
+			op := NewBumpOperation(fs, semver.NewDefaultBumper(), BumpPre, "", "", false).
+				WithNextChannel(semver.DefaultChannels)
+			mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+			err := op.Execute(context.Background(), mod)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+
+			data, _ := fs.GetFile("/test/.version")
+			if string(data) != tt.expected {
+				t.Errorf("version = %q, want %q", string(data), tt.expected)
+			}
+		})
+	}
+}
+
 func TestBumpOperation_Execute_Pre_NoExistingPreRelease(t *testing.T) {
 	t.Parallel()
 	fs := core.NewMockFileSystem()
@@ -61,6 +61,40 @@ func SyncDependencies(registry *plugins.PluginRegistry, version semver.SemVersio
 	return nil
 }
 
+// SyncDependencyReferences updates the dependency references that follow the
+// given workspace module to its new version.
+// Returns nil if dependency checker is not enabled or auto-sync is disabled.
+func SyncDependencyReferences(registry *plugins.PluginRegistry, module string, version semver.SemVersion) error {
+	dc := registry.GetDependencyChecker()
+	if dc == nil || module == "" {
+		return nil
+	}
+
+	if !dc.IsEnabled() || !dc.GetConfig().AutoSync {
+		return nil
+	}
+
+	var items []string
+	for _, file := range dc.GetConfig().Files {
+		for _, ref := range file.References {
+			if ref.Module == module {
+				items = append(items, fmt.Sprintf("%s %s %s%s", printer.SuccessBadge("✓"), ref.Name, printer.Faint("("+file.Path+")"), printer.Faint(": "+version.String())))
+			}
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	if err := dc.SyncReferences(module, version.String()); err != nil {
+		return fmt.Errorf("failed to sync dependency references: %w", err)
+	}
+
+	ty := printer.Typography()
+	fmt.Println(ty.Section(ty.H4("Sync dependency references"), ty.UL(items...)))
+	return nil
+}
+
 // DeriveDependencyName extracts a display name from a file path.
 // For .version files, uses the parent directory name.
 // For other files (package.json, etc.), uses the filename.
@@ -15,6 +15,7 @@ type mockDependencyChecker struct {
 	config   *dependencycheck.Config
 	syncErr  error
 	syncCall string // captures the version string passed to SyncVersions
+	refsCall string // captures "module@version" passed to SyncReferences
 }
 
 func (m *mockDependencyChecker) Name() string                       { return "mock-dep-check" }
@@ -29,6 +30,10 @@ func (m *mockDependencyChecker) SyncVersions(newVersion string) error {
 	m.syncCall = newVersion
 	return m.syncErr
 }
+func (m *mockDependencyChecker) SyncReferences(module, newVersion string) error {
+	m.refsCall = module + "@" + newVersion
+	return m.syncErr
+}
 
 func newTestRegistry(dc dependencycheck.DependencyChecker) *plugins.PluginRegistry {
 	r := plugins.NewPluginRegistry()
@@ -231,6 +236,47 @@ func TestSyncDependencies_HappyPath(t *testing.T) {
 	}
 }
 
+func TestSyncDependencyReferences(t *testing.T) {
+	t.Parallel()
+
+	files := []dependencycheck.FileConfig{{
+		Path:       "apps/web/package.json",
+		Format:     "json",
+		References: []dependencycheck.Reference{{Name: "@acme/core", Module: "core"}},
+	}}
+
+	tests := []struct {
+		name     string
+		module   string
+		autoSync bool
+		want     string
+	}{
+		{"matching module", "core", true, "core@1.5.0"},
+		{"other module", "web", true, ""},
+		{"single-module bump", "", true, ""},
+		{"auto-sync disabled", "core", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dc := &mockDependencyChecker{
+				enabled: true,
+				config:  &dependencycheck.Config{Enabled: true, AutoSync: tt.autoSync, Files: files},
+			}
+			registry := newTestRegistry(dc)
+
+			err := SyncDependencyReferences(registry, tt.module, semver.SemVersion{Major: 1, Minor: 5})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dc.refsCall != tt.want {
+				t.Errorf("SyncReferences called with %q, want %q", dc.refsCall, tt.want)
+			}
+		})
+	}
+}
+
 func TestDeriveDependencyName(t *testing.T) {
 	t.Parallel()
 
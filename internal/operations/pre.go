@@ -14,6 +14,7 @@ type PreOperation struct {
 	fs        core.FileSystem
 	label     string
 	increment bool
+	metadata  string
 }
 
 // NewPreOperation creates a new pre-release operation.
@@ -25,6 +26,13 @@ func NewPreOperation(fs core.FileSystem, label string, increment bool) *PreOpera
 	}
 }
 
+// WithMetadata sets build metadata applied to the new version.
+// Existing metadata is kept when meta is empty.
+func (op *PreOperation) WithMetadata(meta string) *PreOperation {
+	op.metadata = meta
+	return op
+}
+
 // Execute performs the pre-release operation on the module.
 func (op *PreOperation) Execute(ctx context.Context, mod *workspace.Module) error {
 	// Check for context cancellation
@@ -35,7 +43,7 @@ func (op *PreOperation) Execute(ctx context.Context, mod *workspace.Module) erro
 	}
 
 	// Create version manager
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 
 	// Read current version
 	currentVer, err := vm.Read(ctx, mod.Path)
@@ -50,16 +58,28 @@ func (op *PreOperation) Execute(ctx context.Context, mod *workspace.Module) erro
 	newVer := currentVer
 
 	if op.increment {
-		newVer.PreRelease = semver.IncrementPreRelease(currentVer.PreRelease, op.label)
+		if newVer.PreRelease, err = semver.IncrementPreRelease(currentVer.PreRelease, op.label); err != nil {
+			return err
+		}
 	} else {
 		// If there's no existing pre-release, bump patch first
 		if currentVer.PreRelease == "" {
-			newVer.Patch++
+			bumped, err := semver.BumpByLabel(currentVer, "patch")
+			if err != nil {
+				return err
+			}
+			newVer.Patch = bumped.Patch
 		}
 		newVer.PreRelease = op.label
 	}
+	if op.metadata != "" {
+		newVer.Build = op.metadata
+	}
 
 	// Write the new version
+	if err := vm.CheckRecordable(newVer); err != nil {
+		return err
+	}
 	if err := vm.Save(ctx, mod.Path, newVer); err != nil {
 		return fmt.Errorf("failed to write version to %s: %w", mod.Path, err)
 	}
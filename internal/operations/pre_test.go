@@ -2,10 +2,12 @@ package operations
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/workspace"
 )
 
@@ -197,6 +199,31 @@ func TestPreOperation_Execute_UpdatesModuleVersion(t *testing.T) {
 	}
 }
 
+func TestPreOperation_Execute_WithMetadata(t *testing.T) {
+	t.Parallel()
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/test/.version", []byte("1.2.3+old\n"))
+
+	tests := []struct {
+		meta string
+		want string
+	}{
+		{meta: "abc1234.17", want: "1.2.4-alpha+abc1234.17"},
+		{meta: "", want: "1.2.4-alpha+old"},
+	}
+	for _, tt := range tests {
+		mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+		fs.SetFile("/test/.version", []byte("1.2.3+old\n"))
+
+		if err := NewPreOperation(fs, "alpha", false).WithMetadata(tt.meta).Execute(context.Background(), mod); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+		if mod.CurrentVersion != tt.want {
+			t.Errorf("meta %q: CurrentVersion = %q, want %q", tt.meta, mod.CurrentVersion, tt.want)
+		}
+	}
+}
+
 func TestPreOperation_Execute_ContextCancellation(t *testing.T) {
 	t.Parallel()
 	fs := core.NewMockFileSystem()
@@ -367,3 +394,17 @@ func TestPreOperation_Execute_WithBuildMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestPreOperation_Execute_GitTagSource(t *testing.T) {
+	restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(
+		semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.2.3"}})))
+	defer restore()
+
+	op := NewPreOperation(core.NewMockFileSystem(), "rc.1", false)
+	mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+	err := op.Execute(context.Background(), mod)
+	if err == nil || !strings.Contains(err.Error(), "cannot record version 1.2.4-rc.1: source is git-tags") {
+		t.Fatalf("expected git-tags error, got %v", err)
+	}
+}
@@ -39,9 +39,12 @@ func (op *SetOperation) Execute(ctx context.Context, mod *workspace.Module) erro
 	}
 
 	// Create version manager
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 
 	// Write the new version
+	if err := vm.CheckRecordable(newVer); err != nil {
+		return err
+	}
 	if err := vm.Save(ctx, mod.Path, newVer); err != nil {
 		return fmt.Errorf("failed to write version to %s: %w", mod.Path, err)
 	}
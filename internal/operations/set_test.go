@@ -2,10 +2,12 @@ package operations
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/workspace"
 )
 
@@ -280,3 +282,17 @@ func TestSetOperation_Execute_NoFileExists(t *testing.T) {
 		t.Errorf("module CurrentVersion = %q, want %q", mod.CurrentVersion, "1.0.0")
 	}
 }
+
+func TestSetOperation_Execute_GitTagSource(t *testing.T) {
+	restore := semver.SetDefaultManager(semver.DefaultVersionManager().WithTagSource(
+		semver.NewTagSource("v", &semver.MockVersionTagLister{Tags: []string{"v1.0.0"}})))
+	defer restore()
+
+	op := NewSetOperation(core.NewMockFileSystem(), "2.0.0")
+	mod := &workspace.Module{Name: "test", Path: "/test/.version"}
+
+	err := op.Execute(context.Background(), mod)
+	if err == nil || !strings.Contains(err.Error(), "source is git-tags") {
+		t.Fatalf("expected git-tags error, got %v", err)
+	}
+}
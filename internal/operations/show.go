@@ -32,10 +32,10 @@ func (op *ShowOperation) Execute(ctx context.Context, mod *workspace.Module) err
 	}
 
 	// Create version manager
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 
 	// Read current version
-	ver, err := vm.Read(ctx, mod.Path)
+	ver, err := vm.Describe(ctx, mod.Path)
 	if err != nil {
 		return fmt.Errorf("failed to read version from %s: %w", mod.Path, err)
 	}
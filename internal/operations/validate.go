@@ -21,7 +21,8 @@ func NewValidateOperation(fs core.FileSystem) *ValidateOperation {
 	}
 }
 
-// Execute validates the version file in the module.
+// Execute validates the version file in the module against the strict
+// SemVer 2.0.0 grammar.
 // The current version is stored in the module's CurrentVersion field on success.
 func (op *ValidateOperation) Execute(ctx context.Context, mod *workspace.Module) error {
 	// Check for context cancellation
@@ -32,10 +33,10 @@ func (op *ValidateOperation) Execute(ctx context.Context, mod *workspace.Module)
 	}
 
 	// Create version manager
-	vm := semver.NewVersionManager(op.fs, nil)
+	vm := semver.NewSourcedVersionManager(op.fs)
 
 	// Read and validate version
-	ver, err := vm.Read(ctx, mod.Path)
+	ver, err := vm.ReadStrict(ctx, mod.Path)
 	if err != nil {
 		return fmt.Errorf("invalid version file at %s: %w", mod.Path, err)
 	}
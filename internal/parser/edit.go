@@ -0,0 +1,297 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goccy/go-yaml/ast"
+	yamlparser "github.com/goccy/go-yaml/parser"
+	"github.com/pelletier/go-toml/v2/unstable"
+)
+
+// The editors in this file replace the bytes of a single value in place and
+// leave everything else (comments, key order, indentation, quoting of other
+// values) untouched. They report found=false when the field does not exist,
+// so that the caller can fall back to re-serializing the document.
+
+// editYAMLValue replaces the scalar at the dotted field path, keeping the
+// original quoting style.
+func editYAMLValue(data []byte, field, version string) (updated []byte, found bool, err error) {
+	file, err := yamlparser.ParseBytes(data, yamlparser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+
+	node := lookupYAMLField(file, field)
+	if node == nil {
+		return nil, false, nil
+	}
+
+	switch node.(type) {
+	case *ast.StringNode, *ast.IntegerNode, *ast.FloatNode, *ast.NullNode:
+	default:
+		return nil, true, fmt.Errorf("field %q is not a plain or quoted scalar", field)
+	}
+
+	tk := node.GetToken()
+	start, ok := lineColumnOffset(data, tk.Position.Line, tk.Position.Column)
+	if !ok {
+		return nil, true, fmt.Errorf("cannot locate field %q", field)
+	}
+
+	var end int
+	var replacement string
+	switch data[start] {
+	case '"':
+		end = scanQuoted(data, start, '"', '\\')
+		replacement = quoteBasic(version)
+	case '\'':
+		end = scanQuoted(data, start, '\'', '\'')
+		replacement = "'" + strings.ReplaceAll(version, "'", "''") + "'"
+	default:
+		// Plain scalars have no escapes, so on a single line the raw bytes
+		// are the value itself.
+		end = start + len(tk.Value)
+		if end > len(data) || string(data[start:end]) != tk.Value {
+			return nil, true, fmt.Errorf("field %q spans multiple lines", field)
+		}
+		replacement = version
+	}
+	if end < 0 {
+		return nil, true, fmt.Errorf("unterminated string for field %q", field)
+	}
+
+	return splice(data, start, end, replacement), true, nil
+}
+
+// lookupYAMLField walks the dotted field path from the document root,
+// looking through anchors and tags. It returns nil when the field is missing
+// or only reachable through an alias or a merge key: their value is shared
+// with another part of the document, so it cannot be edited in place.
+func lookupYAMLField(file *ast.File, field string) ast.Node {
+	if len(file.Docs) == 0 {
+		return nil
+	}
+	node := file.Docs[0].Body
+	for part := range strings.SplitSeq(field, ".") {
+		if node = yamlMappingValue(unwrapYAMLNode(node), part); node == nil {
+			return nil
+		}
+	}
+	node = unwrapYAMLNode(node)
+	if _, ok := node.(*ast.AliasNode); ok {
+		return nil
+	}
+	return node
+}
+
+// unwrapYAMLNode returns the value behind anchor and tag nodes.
+func unwrapYAMLNode(node ast.Node) ast.Node {
+	for {
+		switch n := node.(type) {
+		case *ast.AnchorNode:
+			node = n.Value
+		case *ast.TagNode:
+			node = n.Value
+		default:
+			return node
+		}
+	}
+}
+
+// yamlMappingValue returns the value of key in a mapping node, or nil when
+// node is not a mapping or has no such key.
+func yamlMappingValue(node ast.Node, key string) ast.Node {
+	var values []*ast.MappingValueNode
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		values = n.Values
+	case *ast.MappingValueNode:
+		values = []*ast.MappingValueNode{n}
+	default:
+		return nil
+	}
+	for _, mv := range values {
+		if tk := mv.Key.GetToken(); tk != nil && tk.Value == key {
+			return mv.Value
+		}
+	}
+	return nil
+}
+
+// editTOMLValue replaces the string at the dotted field path, keeping the
+// original quoting style. Fields may be set in a [table], through dotted keys
+// or inside an inline table.
+func editTOMLValue(data []byte, field, version string) (updated []byte, found bool, err error) {
+	parts := strings.Split(field, ".")
+
+	var p unstable.Parser
+	p.Reset(data)
+
+	var table []string
+	inArray := false
+	for p.NextExpression() {
+		expr := p.Expression()
+		switch expr.Kind {
+		case unstable.Table:
+			table, inArray = tomlKey(expr.Key()), false
+		case unstable.ArrayTable:
+			// Entries of an array of tables cannot be addressed by a dotted path.
+			inArray = true
+		case unstable.KeyValue:
+			if inArray {
+				continue
+			}
+			value, err := findTOMLValue(expr, table, parts, field)
+			if err != nil {
+				return nil, true, err
+			}
+			if value != nil {
+				return replaceTOMLString(data, value, version), true, nil
+			}
+		}
+	}
+	if err := p.Error(); err != nil {
+		return nil, false, err
+	}
+
+	return nil, false, nil
+}
+
+// findTOMLValue returns the value node of the key/value expression if its
+// full key (prefixed with the enclosing table) matches parts, descending
+// into inline tables.
+func findTOMLValue(kv *unstable.Node, table, parts []string, field string) (*unstable.Node, error) {
+	key := append(append([]string{}, table...), tomlKey(kv.Key())...)
+	if !hasPrefix(parts, key) {
+		if hasPrefix(key, parts) {
+			return nil, fmt.Errorf("field %q is not a string", field)
+		}
+		return nil, nil
+	}
+
+	value := kv.Value()
+	if len(key) == len(parts) {
+		if value.Kind != unstable.String {
+			return nil, fmt.Errorf("field %q is not a string", field)
+		}
+		return value, nil
+	}
+
+	if value.Kind != unstable.InlineTable {
+		return nil, fmt.Errorf("field %q is not an object at path %q", field, strings.Join(key, "."))
+	}
+	it := value.Children()
+	for it.Next() {
+		child := it.Node()
+		if child.Kind != unstable.KeyValue {
+			continue
+		}
+		found, err := findTOMLValue(child, key, parts, field)
+		if found != nil || err != nil {
+			return found, err
+		}
+	}
+	return nil, nil
+}
+
+// replaceTOMLString swaps the raw bytes of a string node for version, using
+// the same delimiters as the original value.
+func replaceTOMLString(data []byte, value *unstable.Node, version string) []byte {
+	start := int(value.Raw.Offset)
+	end := start + int(value.Raw.Length)
+	raw := data[start:end]
+
+	var replacement string
+	switch {
+	case bytes.HasPrefix(raw, []byte(`'''`)) && !strings.Contains(version, "'''"):
+		replacement = "'''" + version + "'''"
+	case bytes.HasPrefix(raw, []byte(`'`)) && !strings.Contains(version, "'"):
+		replacement = "'" + version + "'"
+	default:
+		replacement = quoteBasic(version)
+	}
+
+	return splice(data, start, end, replacement)
+}
+
+// tomlKey collects the decoded parts of a (possibly dotted) key.
+func tomlKey(it unstable.Iterator) []string {
+	var key []string
+	for it.Next() {
+		key = append(key, string(it.Node().Data))
+	}
+	return key
+}
+
+// hasPrefix reports whether prefix is a prefix of s.
+func hasPrefix(s, prefix []string) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lineColumnOffset converts a 1-based line and rune column into a byte offset.
+func lineColumnOffset(data []byte, line, column int) (int, bool) {
+	offset := 0
+	for l := 1; l < line; l++ {
+		i := bytes.IndexByte(data[offset:], '\n')
+		if i < 0 {
+			return 0, false
+		}
+		offset += i + 1
+	}
+	for c := 1; c < column; c++ {
+		if offset >= len(data) || data[offset] == '\n' {
+			return 0, false
+		}
+		_, size := utf8.DecodeRune(data[offset:])
+		offset += size
+	}
+	if offset >= len(data) {
+		return 0, false
+	}
+	return offset, true
+}
+
+// scanQuoted returns the offset just past the closing quote of the string
+// starting at data[start], or -1 if the string is not terminated. escape is
+// the character that escapes a quote: '\\' for double-quoted strings and the
+// quote itself for single-quoted YAML strings.
+func scanQuoted(data []byte, start int, quote, escape byte) int {
+	for i := start + 1; i < len(data); i++ {
+		switch {
+		case escape != quote && data[i] == escape:
+			i++
+		case data[i] == quote:
+			if escape == quote && i+1 < len(data) && data[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// quoteBasic returns s as a double-quoted string valid in JSON, YAML and TOML.
+func quoteBasic(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(s) + `"`
+}
+
+// splice returns a copy of data with data[start:end] replaced by s.
+func splice(data []byte, start, end int, s string) []byte {
+	out := make([]byte, 0, len(data)-(end-start)+len(s))
+	out = append(out, data[:start]...)
+	out = append(out, s...)
+	return append(out, data[end:]...)
+}
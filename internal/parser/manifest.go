@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// valueSpan locates a version value inside a file: reading returns value and
+// writing replaces data[start:end] with the encoded version.
+type valueSpan struct {
+	start, end int
+	value      string
+	encode     func(version string) (string, error)
+}
+
+// locateValue finds the version value for one of the manifest formats that
+// have no generic document model (XML, properties and source files).
+func locateValue(format Format, data []byte, field string) (valueSpan, error) {
+	if field == "" {
+		field = format.DefaultField()
+	}
+	if field == "" {
+		return valueSpan{}, fmt.Errorf("field is required for %s format", format)
+	}
+
+	switch format {
+	case FormatXML:
+		return locateXML(data, field)
+	case FormatGo:
+		return locateGo(data, field)
+	default:
+		return locateSource(format, data, field)
+	}
+}
+
+// sourcePatterns builds, per format, a pattern whose first capturing group is
+// the version value. Patterns are anchored at the start of a line where the
+// language allows it, so commented-out assignments are not matched.
+var sourcePatterns = map[Format]func(name string) string{
+	// version=1.2.3 or version: 1.2.3
+	FormatProperties: func(name string) string {
+		return `(?m)^[ \t]*` + name + `[ \t]*[=:][ \t]*([^\r\n]*?)[ \t]*$`
+	},
+	// version = "1.2.3", version '1.2.3' or project.version = "1.2.3"
+	FormatGradle: func(name string) string {
+		return `(?m)^[ \t]*(?:project\.)?` + name + `[ \t]*(?:=[ \t]*)?["']([^"'\r\n]*)["']`
+	},
+	// spec.version = "1.2.3" or VERSION = "1.2.3".freeze
+	FormatRuby: func(name string) string {
+		return `(?m)^[ \t]*(?:\w+\.)?` + name + `[ \t]*=[ \t]*["']([^"'\r\n]*)["']`
+	},
+	// version: "1.2.3" in the project keyword list or @version "1.2.3"
+	FormatElixir: func(name string) string {
+		return `(?m)(?:^[ \t]*@` + name + `[ \t]+|\b` + name + `:[ \t]*)"([^"\r\n]*)"`
+	},
+	// let version = "1.2.3" or public static var version: String = "1.2.3"
+	FormatSwift: func(name string) string {
+		return `(?m)^[ \t]*(?:(?:public|internal|private|fileprivate|static)[ \t]+)*(?:let|var)[ \t]+` +
+			name + `[ \t]*(?::[ \t]*String[ \t]*)?=[ \t]*"([^"\r\n]*)"`
+	},
+}
+
+// locateSource finds the value assigned to field in a properties file or a
+// source file.
+func locateSource(format Format, data []byte, field string) (valueSpan, error) {
+	pattern, ok := sourcePatterns[format]
+	if !ok {
+		return valueSpan{}, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	re := regexp.MustCompile(pattern(regexp.QuoteMeta(field)))
+	loc := re.FindSubmatchIndex(data)
+	if loc == nil {
+		return valueSpan{}, fmt.Errorf("field %q not found", field)
+	}
+
+	return valueSpan{
+		start: loc[2],
+		end:   loc[3],
+		value: string(data[loc[2]:loc[3]]),
+		encode: func(version string) (string, error) {
+			if strings.ContainsAny(version, "\"'\r\n") {
+				return "", fmt.Errorf("version %q cannot be written to a %s file", version, format)
+			}
+			return version, nil
+		},
+	}, nil
+}
+
+// locateGo finds a string constant or variable named field, declared at the
+// top level of a Go source file.
+func locateGo(data []byte, field string) (valueSpan, error) {
+	fset := token.NewFileSet()
+	file, err := goparser.ParseFile(fset, "", data, goparser.SkipObjectResolution)
+	if err != nil {
+		return valueSpan{}, fmt.Errorf("failed to parse Go source: %w", err)
+	}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if name.Name != field {
+					continue
+				}
+				if i >= len(vs.Values) {
+					return valueSpan{}, fmt.Errorf("%s %q has no value", gen.Tok, field)
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return valueSpan{}, fmt.Errorf("%s %q is not a string literal", gen.Tok, field)
+				}
+				value, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return valueSpan{}, fmt.Errorf("invalid string literal for %q: %w", field, err)
+				}
+				start := fset.Position(lit.Pos()).Offset
+				raw := strings.HasPrefix(lit.Value, "`")
+				return valueSpan{
+					start: start,
+					end:   start + len(lit.Value),
+					value: value,
+					encode: func(version string) (string, error) {
+						if raw && strconv.CanBackquote(version) {
+							return "`" + version + "`", nil
+						}
+						return strconv.Quote(version), nil
+					},
+				}, nil
+			}
+		}
+	}
+
+	return valueSpan{}, fmt.Errorf("constant or variable %q not found", field)
+}
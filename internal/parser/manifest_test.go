@@ -0,0 +1,261 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+func TestReader_ReadManifest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		format  Format
+		content string
+		field   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "xml element path",
+			format:  FormatXML,
+			content: "<project><parent><version>9.9.9</version></parent><version>1.2.3</version></project>",
+			field:   "project/version",
+			want:    "1.2.3",
+		},
+		{
+			name:    "xml leading slash and namespace",
+			format:  FormatXML,
+			content: `<p:project xmlns:p="urn:x"><p:version> 1.2.3 </p:version></p:project>`,
+			field:   "/project/p:version",
+			want:    "1.2.3",
+		},
+		{
+			name:    "xml descendant",
+			format:  FormatXML,
+			content: "<Project><PropertyGroup><Version>1.2.3</Version></PropertyGroup></Project>",
+			field:   "//Version",
+			want:    "1.2.3",
+		},
+		{
+			name:    "xml attribute",
+			format:  FormatXML,
+			content: `<plugin id="x" version='1.2.3'/>`,
+			field:   "plugin/@version",
+			want:    "1.2.3",
+		},
+		{
+			name:    "xml cdata",
+			format:  FormatXML,
+			content: "<project><version><![CDATA[1.2.3]]></version></project>",
+			field:   "project/version",
+			want:    "1.2.3",
+		},
+		{
+			name:    "xml element not found",
+			format:  FormatXML,
+			content: "<project><name>x</name></project>",
+			field:   "project/version",
+			wantErr: true,
+		},
+		{
+			name:    "xml element with children",
+			format:  FormatXML,
+			content: "<project><version><major>1</major></version></project>",
+			field:   "project/version",
+			wantErr: true,
+		},
+		{
+			name:    "xml missing field",
+			format:  FormatXML,
+			content: "<project/>",
+			wantErr: true,
+		},
+		{
+			name:    "xml invalid path",
+			format:  FormatXML,
+			content: "<project/>",
+			field:   "project//version",
+			wantErr: true,
+		},
+		{
+			name:    "properties colon separator",
+			format:  FormatProperties,
+			content: "name=x\nversion: 1.2.3\n",
+			want:    "1.2.3",
+		},
+		{
+			name:    "gradle project property",
+			format:  FormatGradle,
+			content: "project.version = '1.2.3'\n",
+			want:    "1.2.3",
+		},
+		{
+			name:    "gradle commented out",
+			format:  FormatGradle,
+			content: "// version = '1.2.3'\n",
+			wantErr: true,
+		},
+		{
+			name:    "ruby constant",
+			format:  FormatRuby,
+			content: "module X\n  VERSION = '1.2.3'\nend\n",
+			field:   "VERSION",
+			want:    "1.2.3",
+		},
+		{
+			name:    "elixir keyword",
+			format:  FormatElixir,
+			content: "[app: :x, version: \"1.2.3\"]\n",
+			want:    "1.2.3",
+		},
+		{
+			name:    "swift typed constant",
+			format:  FormatSwift,
+			content: "public static let version: String = \"1.2.3\"\n",
+			want:    "1.2.3",
+		},
+		{
+			name:    "go variable with raw string",
+			format:  FormatGo,
+			content: "package v\n\nvar Version = `1.2.3`\n",
+			want:    "1.2.3",
+		},
+		{
+			name:    "go constant not a string",
+			format:  FormatGo,
+			content: "package v\n\nconst Version = 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "go constant not found",
+			format:  FormatGo,
+			content: "package v\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/manifest", []byte(tt.content))
+
+			got, err := NewReader(fs).ReadVersion(context.Background(), FileConfig{
+				Path:   "/manifest",
+				Format: tt.format,
+				Field:  tt.field,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got version %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got version %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_WriteManifest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		format  Format
+		content string
+		field   string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "xml escapes text",
+			format:  FormatXML,
+			content: "<project><version>1.0.0</version></project>",
+			field:   "project/version",
+			version: "2.0.0+a&b",
+			want:    "<project><version>2.0.0+a&amp;b</version></project>",
+		},
+		{
+			name:    "xml keeps cdata",
+			format:  FormatXML,
+			content: "<project><version><![CDATA[1.0.0]]></version></project>",
+			field:   "project/version",
+			version: "2.0.0",
+			want:    "<project><version><![CDATA[2.0.0]]></version></project>",
+		},
+		{
+			name:    "xml empty element",
+			format:  FormatXML,
+			content: "<project><version></version></project>",
+			field:   "project/version",
+			version: "2.0.0",
+			want:    "<project><version>2.0.0</version></project>",
+		},
+		{
+			name:    "xml attribute",
+			format:  FormatXML,
+			content: `<plugin version="1.0.0" id="x"/>`,
+			field:   "plugin/@version",
+			version: "2.0.0",
+			want:    `<plugin version="2.0.0" id="x"/>`,
+		},
+		{
+			name:    "xml self-closing element",
+			format:  FormatXML,
+			content: "<project><version/></project>",
+			field:   "project/version",
+			version: "2.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "go keeps raw string",
+			format:  FormatGo,
+			content: "package v\n\nvar Version = `1.0.0`\n",
+			version: "2.0.0",
+			want:    "package v\n\nvar Version = `2.0.0`\n",
+		},
+		{
+			name:    "source rejects quotes",
+			format:  FormatRuby,
+			content: "VERSION = '1.0.0'\n",
+			field:   "VERSION",
+			version: "2.0.0'",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/manifest", []byte(tt.content))
+
+			err := NewWriter(fs).Write(context.Background(), FileConfig{
+				Path:   "/manifest",
+				Format: tt.format,
+				Field:  tt.field,
+			}, tt.version)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, _ := fs.ReadFile(context.Background(), "/manifest")
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -49,6 +49,10 @@ func (r *Reader) Read(ctx context.Context, cfg FileConfig) (*Result, error) {
 		version, err = r.readRaw(data)
 	case FormatRegex:
 		version, err = r.readRegex(data, cfg.Path, cfg.Pattern)
+	case FormatXML, FormatProperties, FormatGradle, FormatRuby, FormatElixir, FormatSwift, FormatGo:
+		version, err = r.readManifest(data, cfg.Path, cfg.Format, cfg.Field)
+	case FormatGoMod:
+		return nil, fmt.Errorf("format %s only supports dependency references", cfg.Format)
 	default:
 		return nil, fmt.Errorf("unsupported format: %s", cfg.Format)
 	}
@@ -170,6 +174,15 @@ func (r *Reader) readRegex(data []byte, path, pattern string) (string, error) {
 	return string(matches[1]), nil
 }
 
+// readManifest extracts a version from an XML, properties or source file.
+func (r *Reader) readManifest(data []byte, path string, format Format, field string) (string, error) {
+	span, err := locateValue(format, data, field)
+	if err != nil {
+		return "", fmt.Errorf("in file %q: %w", path, err)
+	}
+	return span.value, nil
+}
+
 // getNestedValue retrieves a value from a nested map using dot notation.
 // Example: "tool.poetry.version" accesses obj["tool"]["poetry"]["version"]
 func getNestedValue(obj map[string]any, field string) (any, error) {
@@ -529,6 +529,17 @@ func TestFormatForFile(t *testing.T) {
 		{"unknown.xyz", FormatRaw},
 		{"/path/to/package.json", FormatJSON},
 		{"/path/to/Cargo.toml", FormatTOML},
+		{"pom.xml", FormatXML},
+		{"App.csproj", FormatXML},
+		{"gradle.properties", FormatProperties},
+		{"build.gradle", FormatGradle},
+		{"build.gradle.kts", FormatGradle},
+		{"app.gemspec", FormatRuby},
+		{"lib/app/version.rb", FormatRuby},
+		{"mix.exs", FormatElixir},
+		{"Package.swift", FormatSwift},
+		{"version.go", FormatGo},
+		{"services/api/go.mod", FormatGoMod},
 	}
 
 	for _, tt := range tests {
@@ -554,6 +565,10 @@ func TestFieldForFormat(t *testing.T) {
 		{"Chart.yaml", "version"},
 		{"unknown.json", "version"},
 		{"/path/to/package.json", "version"},
+		{"pom.xml", "project/version"},
+		{"src/App/App.csproj", "Project/PropertyGroup/Version"},
+		{"lib/app/version.rb", "VERSION"},
+		{"internal/version/version.go", "Version"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,277 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/indaco/sley/internal/core"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"github.com/tidwall/gjson"
+)
+
+// jsonDependencySections lists the package.json and composer.json objects
+// that map dependency names to version constraints.
+var jsonDependencySections = []string{
+	"dependencies", "devDependencies", "peerDependencies", "optionalDependencies",
+	"require", "require-dev",
+}
+
+// tomlDependencySections lists the table names holding dependencies in
+// Cargo.toml (also under [workspace] and [target.*]) and Poetry's pyproject.toml.
+var tomlDependencySections = []string{"dependencies", "dev-dependencies", "build-dependencies"}
+
+// Reference is one occurrence of a dependency in a manifest.
+type Reference struct {
+	// Section is where the reference was found (e.g. "devDependencies",
+	// "workspace.dependencies" or "require").
+	Section string
+
+	// Constraint is the version constraint as written, e.g. "^1.4.0", "1.4" or "v1.4.0".
+	Constraint string
+
+	start, end int
+}
+
+// SupportsReferences reports whether dependency references can be located in
+// files of the given format.
+func SupportsReferences(f Format) bool {
+	return f == FormatJSON || f == FormatTOML || f == FormatGoMod
+}
+
+// FindReferences returns every reference to the dependency name in data, in
+// file order.
+func FindReferences(format Format, data []byte, name string) ([]Reference, error) {
+	if name == "" {
+		return nil, fmt.Errorf("dependency name is required")
+	}
+
+	switch format {
+	case FormatJSON:
+		return findJSONReferences(data, name)
+	case FormatTOML:
+		return findTOMLReferences(data, name)
+	case FormatGoMod:
+		return findGoModReferences(data, name), nil
+	default:
+		return nil, fmt.Errorf("format %s does not support dependency references", format)
+	}
+}
+
+// ReplaceReferences returns data with the constraint of each reference
+// replaced by update(reference). References must come from FindReferences on
+// the same data.
+func ReplaceReferences(data []byte, refs []Reference, update func(Reference) string) []byte {
+	out := data
+	// Replace from the end so earlier offsets stay valid.
+	for _, ref := range slices.Backward(refs) {
+		out = splice(out, ref.start, ref.end, update(ref))
+	}
+	return out
+}
+
+// ReadReferences reads a file and returns the references to the dependency name.
+func (r *Reader) ReadReferences(ctx context.Context, path string, format Format, name string) ([]Reference, error) {
+	data, err := r.fs.ReadFile(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	refs, err := FindReferences(format, data, name)
+	if err != nil {
+		return nil, fmt.Errorf("in file %q: %w", path, err)
+	}
+	return refs, nil
+}
+
+// WriteReferences rewrites the constraint of every reference to the
+// dependency name using update, leaving the rest of the file untouched. It
+// returns the number of references found.
+func (w *Writer) WriteReferences(ctx context.Context, path string, format Format, name string, update func(Reference) (string, error)) (int, error) {
+	data, err := w.fs.ReadFile(ctx, path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	refs, err := FindReferences(format, data, name)
+	if err != nil {
+		return 0, fmt.Errorf("in file %q: %w", path, err)
+	}
+	if len(refs) == 0 {
+		return 0, nil
+	}
+
+	constraints := make(map[int]string, len(refs))
+	for _, ref := range refs {
+		c, err := update(ref)
+		if err != nil {
+			return 0, fmt.Errorf("in file %q: %s: %w", path, name, err)
+		}
+		constraints[ref.start] = c
+	}
+
+	updated := ReplaceReferences(data, refs, func(ref Reference) string { return constraints[ref.start] })
+	if bytes.Equal(updated, data) {
+		return len(refs), nil
+	}
+	if err := w.fs.WriteFile(ctx, path, updated, core.PermOwnerRW); err != nil {
+		return 0, fmt.Errorf("failed to write file %q: %w", path, err)
+	}
+	return len(refs), nil
+}
+
+// findJSONReferences looks the name up in each dependency section.
+func findJSONReferences(data []byte, name string) ([]Reference, error) {
+	if !gjson.ValidBytes(data) {
+		return nil, fmt.Errorf("failed to parse JSON")
+	}
+
+	var refs []Reference
+	for _, section := range jsonDependencySections {
+		res := gjson.GetBytes(data, section+"."+gjson.Escape(name))
+		if !res.Exists() {
+			continue
+		}
+		if res.Type != gjson.String {
+			return nil, fmt.Errorf("%s.%s is not a string", section, name)
+		}
+		// Raw includes the quotes; the constraint is what is between them.
+		refs = append(refs, Reference{
+			Section:    section,
+			Constraint: res.Str,
+			start:      res.Index + 1,
+			end:        res.Index + len(res.Raw) - 1,
+		})
+	}
+
+	slices.SortFunc(refs, func(a, b Reference) int { return a.start - b.start })
+	return refs, nil
+}
+
+// findTOMLReferences matches `name = "1.4"` and `name = { version = "1.4" }`
+// in a dependency table, as well as `version = "1.4"` in [dependencies.name].
+func findTOMLReferences(data []byte, name string) ([]Reference, error) {
+	var refs []Reference
+	err := walkTOML(data, func(key []string, value *unstable.Node) {
+		if value.Kind != unstable.String {
+			return
+		}
+
+		n := len(key)
+		var section []string
+		switch {
+		case n >= 2 && key[n-1] == name && slices.Contains(tomlDependencySections, key[n-2]):
+			section = key[:n-1]
+		case n >= 3 && key[n-1] == "version" && key[n-2] == name && slices.Contains(tomlDependencySections, key[n-3]):
+			section = key[:n-2]
+		default:
+			return
+		}
+
+		// Raw includes the delimiters, which may be ' or " (or tripled).
+		start, end := int(value.Raw.Offset), int(value.Raw.Offset+value.Raw.Length)
+		quote := 1
+		if end-start >= 6 && (bytes.HasPrefix(data[start:], []byte(`"""`)) || bytes.HasPrefix(data[start:], []byte(`'''`))) {
+			quote = 3
+		}
+		refs = append(refs, Reference{
+			Section:    strings.Join(section, "."),
+			Constraint: string(value.Data),
+			start:      start + quote,
+			end:        end - quote,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TOML: %w", err)
+	}
+	return refs, nil
+}
+
+// walkTOML calls fn for every key/value in the document with its full key,
+// descending into inline tables. Entries of arrays of tables are skipped since
+// they cannot be addressed by key.
+func walkTOML(data []byte, fn func(key []string, value *unstable.Node)) error {
+	var p unstable.Parser
+	p.Reset(data)
+
+	var visit func(prefix []string, kv *unstable.Node)
+	visit = func(prefix []string, kv *unstable.Node) {
+		key := append(slices.Clone(prefix), tomlKey(kv.Key())...)
+		value := kv.Value()
+		if value.Kind != unstable.InlineTable {
+			fn(key, value)
+			return
+		}
+		it := value.Children()
+		for it.Next() {
+			if child := it.Node(); child.Kind == unstable.KeyValue {
+				visit(key, child)
+			}
+		}
+	}
+
+	var table []string
+	inArray := false
+	for p.NextExpression() {
+		expr := p.Expression()
+		switch expr.Kind {
+		case unstable.Table:
+			table, inArray = tomlKey(expr.Key()), false
+		case unstable.ArrayTable:
+			inArray = true
+		case unstable.KeyValue:
+			if !inArray {
+				visit(table, expr)
+			}
+		}
+	}
+	return p.Error()
+}
+
+// findGoModReferences matches `require path version` lines and entries of
+// require blocks. Quoted module paths and replace directives are not matched.
+func findGoModReferences(data []byte, name string) []Reference {
+	var refs []Reference
+	inRequire := false
+	offset := 0
+	for line := range bytes.Lines(data) {
+		lineStart := offset
+		offset += len(line)
+
+		text := line
+		if i := bytes.Index(text, []byte("//")); i >= 0 {
+			text = text[:i]
+		}
+		fields := bytes.Fields(text)
+
+		switch {
+		case len(fields) == 0:
+			continue
+		case inRequire && string(fields[0]) == ")":
+			inRequire = false
+			continue
+		case string(fields[0]) == "require" && len(fields) == 2 && string(fields[1]) == "(":
+			inRequire = true
+			continue
+		case string(fields[0]) == "require" && len(fields) == 3:
+			fields = fields[1:]
+		case !inRequire || len(fields) != 2:
+			continue
+		}
+
+		if string(fields[0]) != name {
+			continue
+		}
+		// The version is the last field, so its last occurrence is the field.
+		start := lineStart + bytes.LastIndex(text, fields[1])
+		refs = append(refs, Reference{
+			Section:    "require",
+			Constraint: string(fields[1]),
+			start:      start,
+			end:        start + len(fields[1]),
+		})
+	}
+	return refs
+}
@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+func TestFindReferences(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		format  Format
+		content string
+		dep     string
+		want    []string // "section=constraint"
+		wantErr bool
+	}{
+		{
+			name:   "json sections in file order",
+			format: FormatJSON,
+			content: `{
+  "devDependencies": {"@acme/core": "workspace:^1.4.0"},
+  "dependencies": {"@acme/core": "^1.4.0", "@acme/core-utils": "1.0.0"}
+}`,
+			dep:  "@acme/core",
+			want: []string{"devDependencies=workspace:^1.4.0", "dependencies=^1.4.0"},
+		},
+		{
+			name:    "json name with dots",
+			format:  FormatJSON,
+			content: `{"require": {"acme/core.php": "~1.4"}}`,
+			dep:     "acme/core.php",
+			want:    []string{"require=~1.4"},
+		},
+		{
+			name:    "json not a string",
+			format:  FormatJSON,
+			content: `{"dependencies": {"core": {"version": "1.0.0"}}}`,
+			dep:     "core",
+			wantErr: true,
+		},
+		{
+			name:   "toml inline table and plain string",
+			format: FormatTOML,
+			content: `[dependencies]
+core = { version = "1.4", path = "../core" }
+
+[dev-dependencies]
+core = '=1.4.0'
+`,
+			dep:  "core",
+			want: []string{"dependencies=1.4", "dev-dependencies==1.4.0"},
+		},
+		{
+			name:   "toml dependency table and workspace",
+			format: FormatTOML,
+			content: `[workspace.dependencies]
+core = "1.4"
+
+[target.'cfg(unix)'.dependencies.core]
+version = "^1.4.0"
+path = "../core"
+
+[package]
+core = "ignored"
+`,
+			dep:  "core",
+			want: []string{"workspace.dependencies=1.4", "target.cfg(unix).dependencies=^1.4.0"},
+		},
+		{
+			name:   "gomod single line and block",
+			format: FormatGoMod,
+			content: `module example.com/app
+
+require example.com/core v1.4.0
+
+require (
+	example.com/core/v2 v2.0.0
+	example.com/core v1.4.0 // indirect
+)
+
+replace example.com/core v1.4.0 => ../core
+`,
+			dep:  "example.com/core",
+			want: []string{"require=v1.4.0", "require=v1.4.0"},
+		},
+		{
+			name:    "unsupported format",
+			format:  FormatYAML,
+			content: "dependencies: {}\n",
+			dep:     "core",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			refs, err := FindReferences(tt.format, []byte(tt.content), tt.dep)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %v", refs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, len(refs))
+			for i, ref := range refs {
+				got[i] = ref.Section + "=" + ref.Constraint
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_WriteReferences(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		format  Format
+		content string
+		want    string
+	}{
+		{
+			name:    "json keeps layout",
+			format:  FormatJSON,
+			content: "{\n  \"dependencies\": {\n    \"core\":   \"^1.4.0\"\n  }\n}\n",
+			want:    "{\n  \"dependencies\": {\n    \"core\":   \"<^1.4.0>\"\n  }\n}\n",
+		},
+		{
+			name:    "toml keeps literal strings",
+			format:  FormatTOML,
+			content: "[dependencies]\ncore = { version = '1.4', path = \"../core\" } # local\n",
+			want:    "[dependencies]\ncore = { version = '<1.4>', path = \"../core\" } # local\n",
+		},
+		{
+			name:    "gomod keeps comments",
+			format:  FormatGoMod,
+			content: "require (\n\tcore v1.4.0 // pinned\n)\n",
+			want:    "require (\n\tcore <v1.4.0> // pinned\n)\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/manifest", []byte(tt.content))
+
+			n, err := NewWriter(fs).WriteReferences(context.Background(), "/manifest", tt.format, "core", func(ref Reference) (string, error) {
+				return "<" + ref.Constraint + ">", nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if n != 1 {
+				t.Errorf("got %d references, want 1", n)
+			}
+
+			got, _ := fs.ReadFile(context.Background(), "/manifest")
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
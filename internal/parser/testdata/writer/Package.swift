@@ -0,0 +1,9 @@
+// swift-tools-version:5.9
+import PackageDescription
+
+let version = "1.4.2"
+
+let package = Package(
+    name: "Example",
+    targets: [.target(name: "Example")]
+)
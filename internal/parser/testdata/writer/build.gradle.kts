@@ -0,0 +1,6 @@
+plugins {
+    kotlin("jvm") version "2.0.0"
+}
+
+group = "com.example"
+version = "1.4.2"
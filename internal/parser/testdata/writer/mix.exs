@@ -0,0 +1,16 @@
+defmodule Example.MixProject do
+  use Mix.Project
+
+  @version "1.4.2"
+
+  def project do
+    [
+      app: :example,
+      version: @version,
+      elixir: "~> 1.15",
+      deps: deps()
+    ]
+  end
+
+  defp deps, do: []
+end
@@ -0,0 +1,10 @@
+// Package version holds build information.
+package version
+
+const (
+	// Name is the application name.
+	Name = "example"
+
+	// Version is the current release.
+	Version = "1.4.2" // bumped by sley
+)
@@ -0,0 +1,5 @@
+# frozen_string_literal: true
+
+module Example
+  VERSION = "1.4.2".freeze
+end
@@ -18,6 +18,32 @@ const (
 
 	// FormatRegex is for files requiring regex extraction.
 	FormatRegex Format = "regex"
+
+	// FormatXML is for XML files (pom.xml, *.csproj, etc.).
+	// The field is a slash-separated element path such as "project/version".
+	FormatXML Format = "xml"
+
+	// FormatProperties is for Java properties files (gradle.properties).
+	FormatProperties Format = "properties"
+
+	// FormatGradle is for Gradle build scripts (build.gradle, build.gradle.kts).
+	FormatGradle Format = "gradle"
+
+	// FormatRuby is for Ruby sources (*.gemspec, version.rb).
+	FormatRuby Format = "ruby"
+
+	// FormatElixir is for Elixir project files (mix.exs).
+	FormatElixir Format = "elixir"
+
+	// FormatSwift is for Swift sources (Package.swift).
+	FormatSwift Format = "swift"
+
+	// FormatGo is for Go sources declaring a version constant or variable (version.go).
+	FormatGo Format = "go"
+
+	// FormatGoMod is for go.mod files. It only supports dependency references
+	// since a go.mod file has no version of its own.
+	FormatGoMod Format = "gomod"
 )
 
 // String returns the string representation of the format.
@@ -28,13 +54,27 @@ func (f Format) String() string {
 // IsValid returns true if the format is a known valid format.
 func (f Format) IsValid() bool {
 	switch f {
-	case FormatJSON, FormatYAML, FormatTOML, FormatRaw, FormatRegex:
+	case FormatJSON, FormatYAML, FormatTOML, FormatRaw, FormatRegex,
+		FormatXML, FormatProperties, FormatGradle, FormatRuby, FormatElixir, FormatSwift, FormatGo, FormatGoMod:
 		return true
 	default:
 		return false
 	}
 }
 
+// DefaultField returns the field used when none is configured, or an empty
+// string for formats that require an explicit field or do not use one.
+func (f Format) DefaultField() string {
+	switch f {
+	case FormatProperties, FormatGradle, FormatRuby, FormatElixir, FormatSwift:
+		return "version"
+	case FormatGo:
+		return "Version"
+	default:
+		return ""
+	}
+}
+
 // ParseFormat converts a string to a Format, returning FormatRaw as fallback.
 func ParseFormat(s string) Format {
 	f := Format(s)
@@ -54,6 +94,8 @@ type FileConfig struct {
 
 	// Field is the dot-notation path to the version field (for JSON/YAML/TOML).
 	// Example: "version", "package.version", "tool.poetry.version"
+	// For XML it is an element path ("project/version", "//Version") and for
+	// the source formats the name of the property, constant or variable.
 	Field string
 
 	// Pattern is the regex pattern for regex format.
@@ -43,12 +43,17 @@ func (w *Writer) Write(ctx context.Context, cfg FileConfig, version string) erro
 		return w.writeRaw(ctx, cfg.Path, version)
 	case FormatRegex:
 		return w.writeRegex(ctx, cfg.Path, cfg.Pattern, version)
+	case FormatXML, FormatProperties, FormatGradle, FormatRuby, FormatElixir, FormatSwift, FormatGo:
+		return w.writeManifest(ctx, cfg.Path, cfg.Format, cfg.Field, version)
+	case FormatGoMod:
+		return fmt.Errorf("format %s only supports dependency references", cfg.Format)
 	default:
 		return fmt.Errorf("unsupported format: %s", cfg.Format)
 	}
 }
 
-// writeJSON writes a version to a JSON file using sjson to preserve formatting.
+// writeJSON writes a version to a JSON file. sjson replaces only the bytes of
+// the value, so formatting and key order are preserved.
 func (w *Writer) writeJSON(ctx context.Context, path, field, version string) error {
 	if field == "" {
 		return fmt.Errorf("field is required for JSON format")
@@ -77,7 +82,8 @@ func (w *Writer) writeJSON(ctx context.Context, path, field, version string) err
 	return nil
 }
 
-// writeYAML writes a version to a YAML file.
+// writeYAML writes a version to a YAML file. An existing field is updated
+// in place, preserving comments, key order and quoting.
 func (w *Writer) writeYAML(ctx context.Context, path, field, version string) error {
 	if field == "" {
 		return fmt.Errorf("field is required for YAML format")
@@ -88,18 +94,20 @@ func (w *Writer) writeYAML(ctx context.Context, path, field, version string) err
 		return fmt.Errorf("failed to read file %q: %w", path, err)
 	}
 
-	var obj map[string]any
-	if err := yaml.Unmarshal(data, &obj); err != nil {
-		return fmt.Errorf("failed to parse YAML in %q: %w", path, err)
-	}
-
-	if err := setNestedValue(obj, field, version); err != nil {
+	updated, found, err := editYAMLValue(data, field, version)
+	if err != nil {
+		if !found {
+			return fmt.Errorf("failed to parse YAML in %q: %w", path, err)
+		}
 		return fmt.Errorf("in file %q: %w", path, err)
 	}
-
-	updated, err := yaml.Marshal(obj)
-	if err != nil {
-		return fmt.Errorf("failed to marshal YAML for %q: %w", path, err)
+	if !found {
+		// A missing field, or one reached through an alias or merge key,
+		// requires re-serializing the whole document.
+		updated, err = rewriteYAML(data, field, version)
+		if err != nil {
+			return fmt.Errorf("in file %q: %w", path, err)
+		}
 	}
 
 	if err := w.fs.WriteFile(ctx, path, updated, core.PermOwnerRW); err != nil {
@@ -109,7 +117,8 @@ func (w *Writer) writeYAML(ctx context.Context, path, field, version string) err
 	return nil
 }
 
-// writeTOML writes a version to a TOML file.
+// writeTOML writes a version to a TOML file. An existing field is updated
+// in place, preserving comments, key order and quoting.
 func (w *Writer) writeTOML(ctx context.Context, path, field, version string) error {
 	if field == "" {
 		return fmt.Errorf("field is required for TOML format")
@@ -120,18 +129,19 @@ func (w *Writer) writeTOML(ctx context.Context, path, field, version string) err
 		return fmt.Errorf("failed to read file %q: %w", path, err)
 	}
 
-	var obj map[string]any
-	if err := toml.Unmarshal(data, &obj); err != nil {
-		return fmt.Errorf("failed to parse TOML in %q: %w", path, err)
-	}
-
-	if err := setNestedValue(obj, field, version); err != nil {
+	updated, found, err := editTOMLValue(data, field, version)
+	if err != nil {
+		if !found {
+			return fmt.Errorf("failed to parse TOML in %q: %w", path, err)
+		}
 		return fmt.Errorf("in file %q: %w", path, err)
 	}
-
-	updated, err := toml.Marshal(obj)
-	if err != nil {
-		return fmt.Errorf("failed to marshal TOML for %q: %w", path, err)
+	if !found {
+		// Adding a missing field requires re-serializing the whole document.
+		updated, err = rewriteTOML(data, field, version)
+		if err != nil {
+			return fmt.Errorf("in file %q: %w", path, err)
+		}
 	}
 
 	if err := w.fs.WriteFile(ctx, path, updated, core.PermOwnerRW); err != nil {
@@ -195,6 +205,64 @@ func (w *Writer) writeRegex(ctx context.Context, path, pattern, version string)
 	return nil
 }
 
+// writeManifest replaces the version in an XML, properties or source file,
+// leaving the rest of the file untouched.
+func (w *Writer) writeManifest(ctx context.Context, path string, format Format, field, version string) error {
+	data, err := w.fs.ReadFile(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	span, err := locateValue(format, data, field)
+	if err != nil {
+		return fmt.Errorf("in file %q: %w", path, err)
+	}
+
+	encoded, err := span.encode(version)
+	if err != nil {
+		return fmt.Errorf("in file %q: %w", path, err)
+	}
+
+	updated := splice(data, span.start, span.end, encoded)
+	if err := w.fs.WriteFile(ctx, path, updated, core.PermOwnerRW); err != nil {
+		return fmt.Errorf("failed to write file %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// rewriteYAML sets a field that is not present in the document by decoding
+// and re-encoding it.
+func rewriteYAML(data []byte, field, version string) ([]byte, error) {
+	var obj map[string]any
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if obj == nil {
+		obj = make(map[string]any)
+	}
+	if err := setNestedValue(obj, field, version); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(obj)
+}
+
+// rewriteTOML sets a field that is not present in the document by decoding
+// and re-encoding it.
+func rewriteTOML(data []byte, field, version string) ([]byte, error) {
+	var obj map[string]any
+	if err := toml.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to parse TOML: %w", err)
+	}
+	if obj == nil {
+		obj = make(map[string]any)
+	}
+	if err := setNestedValue(obj, field, version); err != nil {
+		return nil, err
+	}
+	return toml.Marshal(obj)
+}
+
 // setNestedValue sets a value in a nested map using dot notation.
 // Example: "tool.poetry.version" sets obj["tool"]["poetry"]["version"] = value
 func setNestedValue(obj map[string]any, field string, value any) error {
@@ -261,6 +329,9 @@ func FieldForFormat(filename string) string {
 		"pyproject.toml": "project.version",
 		"Chart.yaml":     "version",
 		"pubspec.yaml":   "version",
+		"pom.xml":        "project/version",
+		"version.rb":     "VERSION",
+		"version.go":     "Version",
 	}
 
 	// Check both the full path and just the filename
@@ -275,6 +346,13 @@ func FieldForFormat(filename string) string {
 		return field
 	}
 
+	switch {
+	case strings.HasSuffix(basename, ".csproj"), basename == "Directory.Build.props":
+		return "Project/PropertyGroup/Version"
+	case strings.HasSuffix(basename, ".go"):
+		return "Version"
+	}
+
 	return "version"
 }
 
@@ -291,6 +369,22 @@ func FormatForFile(filename string) Format {
 		return FormatTOML
 	case strings.HasSuffix(lower, ".txt"), lower == "version", lower == ".version":
 		return FormatRaw
+	case strings.HasSuffix(lower, ".xml"), strings.HasSuffix(lower, ".csproj"), strings.HasSuffix(lower, ".props"):
+		return FormatXML
+	case strings.HasSuffix(lower, ".properties"):
+		return FormatProperties
+	case strings.HasSuffix(lower, ".gradle"), strings.HasSuffix(lower, ".gradle.kts"):
+		return FormatGradle
+	case strings.HasSuffix(lower, ".gemspec"), strings.HasSuffix(lower, ".rb"):
+		return FormatRuby
+	case strings.HasSuffix(lower, ".exs"), strings.HasSuffix(lower, ".ex"):
+		return FormatElixir
+	case strings.HasSuffix(lower, ".swift"):
+		return FormatSwift
+	case strings.HasSuffix(lower, ".go"):
+		return FormatGo
+	case lower == "go.mod", strings.HasSuffix(lower, "/go.mod"):
+		return FormatGoMod
 	default:
 		// Check specific file names
 		switch {
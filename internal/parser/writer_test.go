@@ -2,6 +2,8 @@ package parser
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -90,6 +92,71 @@ func TestWriter_WriteJSON(t *testing.T) {
 	}
 }
 
+// TestWriter_PreservesFormatting checks that writing a version changes only
+// the bytes of the version value: each testdata/writer/<file>.golden is the
+// input file with nothing but the version replaced.
+func TestWriter_PreservesFormatting(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		file   string
+		format Format
+		field  string
+	}{
+		{"Chart.yaml", FormatYAML, "version"},
+		{"values.yaml", FormatYAML, "image.tag"},
+		{"anchors.yaml", FormatYAML, "defaults.version"},
+		{"pyproject.toml", FormatTOML, "project.version"},
+		{"Cargo.toml", FormatTOML, "package.version"},
+		{"dotted.toml", FormatTOML, "tool.example.version"},
+		{"package.json", FormatJSON, "version"},
+		{"pom.xml", FormatXML, "project/version"},
+		{"App.csproj", FormatXML, "Project/PropertyGroup/VersionPrefix"},
+		{"build.gradle", FormatGradle, ""},
+		{"build.gradle.kts", FormatGradle, "version"},
+		{"gradle.properties", FormatProperties, ""},
+		{"example.gemspec", FormatRuby, ""},
+		{"version.rb", FormatRuby, "VERSION"},
+		{"mix.exs", FormatElixir, ""},
+		{"Package.swift", FormatSwift, ""},
+		{"version.go", FormatGo, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			t.Parallel()
+			input, err := os.ReadFile(filepath.Join("testdata", "writer", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(filepath.Join("testdata", "writer", tt.file+".golden"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/"+tt.file, input)
+
+			writer := NewWriter(fs)
+			err = writer.Write(context.Background(), FileConfig{
+				Path:   "/" + tt.file,
+				Format: tt.format,
+				Field:  tt.field,
+			}, "2.0.0")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := fs.ReadFile(context.Background(), "/"+tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("output does not match %s.golden\ngot:\n%s\nwant:\n%s", tt.file, got, want)
+			}
+		})
+	}
+}
+
 func TestWriter_WriteYAML(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -114,6 +181,48 @@ func TestWriter_WriteYAML(t *testing.T) {
 			newVersion:  "2.0.0",
 			wantVersion: "2.0.0",
 		},
+		{
+			name:        "missing field is added",
+			content:     "name: app\n",
+			field:       "app.version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:        "flow mapping",
+			content:     "app: {name: x, version: '1.0.0'}\n",
+			field:       "app.version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:        "anchored scalar",
+			content:     "version: &v 1.0.0\nother: *v\n",
+			field:       "version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:        "through alias",
+			content:     "base: &base\n  version: 1.0.0\napp: *base\n",
+			field:       "app.version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:        "through merge key",
+			content:     "base: &base\n  version: 1.0.0\napp:\n  <<: *base\n",
+			field:       "app.version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:       "not a scalar",
+			content:    "version:\n  major: 1\n",
+			field:      "version",
+			newVersion: "2.0.0",
+			wantErr:    true,
+		},
 		{
 			name:       "empty field",
 			content:    "version: 1.0.0\n",
@@ -189,6 +298,34 @@ func TestWriter_WriteTOML(t *testing.T) {
 			newVersion:  "2.0.0",
 			wantVersion: "2.0.0",
 		},
+		{
+			name:        "missing field is added",
+			content:     "[package]\nname = \"test\"\n",
+			field:       "package.version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:        "ignores array of tables",
+			content:     "[[bin]]\nversion = \"0.1.0\"\n\n[package]\nversion = \"1.0.0\"\n",
+			field:       "package.version",
+			newVersion:  "2.0.0",
+			wantVersion: "2.0.0",
+		},
+		{
+			name:       "workspace inherited version",
+			content:    "[package]\nversion.workspace = true\n",
+			field:      "package.version",
+			newVersion: "2.0.0",
+			wantErr:    true,
+		},
+		{
+			name:       "not a string",
+			content:    "version = 1\n",
+			field:      "version",
+			newVersion: "2.0.0",
+			wantErr:    true,
+		},
 		{
 			name:       "empty field",
 			content:    "[package]\nversion = \"1.0.0\"\n",
@@ -0,0 +1,218 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// xmlPath is a parsed XPath-like field selector. Supported forms:
+//
+//	project/version                 element path from the document root
+//	/project/version                same, with an explicit leading slash
+//	//Version                       element at any depth
+//	Project/PropertyGroup/Version   first matching element wins
+//	project/@version                attribute of the selected element
+//
+// Namespace prefixes are ignored; elements match on their local name.
+type xmlPath struct {
+	steps      []string
+	attr       string
+	descendant bool
+}
+
+// parseXMLPath parses a field selector for FormatXML.
+func parseXMLPath(field string) (xmlPath, error) {
+	var p xmlPath
+	switch {
+	case strings.HasPrefix(field, "//"):
+		p.descendant = true
+		field = field[2:]
+	case strings.HasPrefix(field, "/"):
+		field = field[1:]
+	}
+
+	for step := range strings.SplitSeq(field, "/") {
+		if step == "" {
+			return xmlPath{}, fmt.Errorf("invalid XML path %q: empty step", field)
+		}
+		if p.attr != "" {
+			return xmlPath{}, fmt.Errorf("invalid XML path %q: attribute must be the last step", field)
+		}
+		if attr, ok := strings.CutPrefix(step, "@"); ok {
+			p.attr = localName(attr)
+			continue
+		}
+		p.steps = append(p.steps, localName(step))
+	}
+	if len(p.steps) == 0 {
+		return xmlPath{}, fmt.Errorf("invalid XML path %q: no element selected", field)
+	}
+	return p, nil
+}
+
+// matches reports whether the stack of open elements is selected by the path.
+func (p xmlPath) matches(stack []string) bool {
+	if p.descendant {
+		return len(stack) >= len(p.steps) && hasPrefix(stack[len(stack)-len(p.steps):], p.steps)
+	}
+	return len(stack) == len(p.steps) && hasPrefix(stack, p.steps)
+}
+
+// localName strips a namespace prefix ("x:version" -> "version").
+func localName(name string) string {
+	if i := strings.LastIndexByte(name, ':'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// locateXML finds the text content or attribute selected by field.
+func locateXML(data []byte, field string) (valueSpan, error) {
+	path, err := parseXMLPath(field)
+	if err != nil {
+		return valueSpan{}, err
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var stack []string
+	for {
+		offset := int(dec.InputOffset())
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return valueSpan{}, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			if !path.matches(stack) {
+				continue
+			}
+			if path.attr != "" {
+				return locateXMLAttr(data[offset:dec.InputOffset()], offset, path.attr, field)
+			}
+			return locateXMLText(dec, data, field)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return valueSpan{}, fmt.Errorf("element %q not found", field)
+}
+
+// locateXMLText returns the text content of the element just opened in dec.
+func locateXMLText(dec *xml.Decoder, data []byte, field string) (valueSpan, error) {
+	start := int(dec.InputOffset())
+	var text strings.Builder
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return valueSpan{}, fmt.Errorf("failed to parse XML: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			text.Write(t)
+		case xml.Comment, xml.ProcInst:
+			return valueSpan{}, fmt.Errorf("element %q has mixed content", field)
+		case xml.StartElement:
+			return valueSpan{}, fmt.Errorf("element %q is not a text element", field)
+		case xml.EndElement:
+			// InputOffset is now past "</name>"; the closing tag starts at the
+			// last '<' before it.
+			end := bytes.LastIndexByte(data[:dec.InputOffset()], '<')
+			if end < start {
+				return valueSpan{}, fmt.Errorf("element %q is self-closing", field)
+			}
+			raw := data[start:end]
+
+			// Keep surrounding whitespace and a CDATA wrapper in place.
+			trimmed := bytes.TrimSpace(raw)
+			lead := bytes.Index(raw, trimmed)
+			if len(trimmed) == 0 {
+				lead = 0
+			}
+			valueStart, valueEnd := start+lead, start+lead+len(trimmed)
+			cdata := bytes.HasPrefix(trimmed, []byte("<![CDATA[")) && bytes.HasSuffix(trimmed, []byte("]]>"))
+			if cdata {
+				valueStart += len("<![CDATA[")
+				valueEnd -= len("]]>")
+			}
+
+			return valueSpan{
+				start: valueStart,
+				end:   valueEnd,
+				value: strings.TrimSpace(text.String()),
+				encode: func(version string) (string, error) {
+					if cdata {
+						if strings.Contains(version, "]]>") {
+							return "", fmt.Errorf("version %q cannot be written to a CDATA section", version)
+						}
+						return version, nil
+					}
+					return escapeXML(version), nil
+				},
+			}, nil
+		}
+	}
+}
+
+// locateXMLAttr returns the value of attr in the raw start tag found at offset.
+func locateXMLAttr(tag []byte, offset int, attr, field string) (valueSpan, error) {
+	re := regexp.MustCompile(`(?:^|[\s:])` + regexp.QuoteMeta(attr) + `\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	loc := re.FindSubmatchIndex(tag)
+	if loc == nil {
+		return valueSpan{}, fmt.Errorf("attribute %q not found", field)
+	}
+
+	start, end := loc[2], loc[3]
+	if start < 0 {
+		start, end = loc[4], loc[5]
+	}
+
+	decoded, err := unescapeXML(tag[start:end])
+	if err != nil {
+		return valueSpan{}, fmt.Errorf("invalid attribute %q: %w", field, err)
+	}
+
+	return valueSpan{
+		start: offset + start,
+		end:   offset + end,
+		value: decoded,
+		encode: func(version string) (string, error) {
+			return escapeXML(version), nil
+		},
+	}, nil
+}
+
+// escapeXML escapes text for use in element content or a quoted attribute.
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// unescapeXML decodes entity and character references in raw text.
+func unescapeXML(raw []byte) (string, error) {
+	dec := xml.NewDecoder(bytes.NewReader(append(append([]byte("<v>"), raw...), "</v>"...)))
+	var text strings.Builder
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return text.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if cd, ok := tok.(xml.CharData); ok {
+			text.Write(cd)
+		}
+	}
+}
@@ -1,14 +1,11 @@
 package auditlog
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"sort"
+	"sync"
 	"time"
-
-	"github.com/goccy/go-yaml"
-	"github.com/indaco/sley/internal/core"
 )
 
 // AuditLog defines the interface for audit logging.
@@ -20,6 +17,10 @@ type AuditLog interface {
 	// RecordEntry logs a version bump with metadata.
 	RecordEntry(entry *Entry) error
 
+	// Flush writes the recorded entries to the sinks that attach them to the
+	// release commit. It is called once the release commit exists.
+	Flush() error
+
 	// IsEnabled returns whether the plugin is enabled.
 	IsEnabled() bool
 
@@ -29,23 +30,35 @@ type AuditLog interface {
 
 // AuditLogPlugin implements the AuditLog interface.
 type AuditLogPlugin struct {
-	config      *Config
-	gitOps      GitOperations
-	fileOps     FileOperations
-	timeFunc    func() time.Time
-	marshalFn   func(any) ([]byte, error)
-	unmarshalFn func([]byte, any) error
+	config   *Config
+	gitOps   GitOperations
+	fileOps  FileOperations
+	timeFunc func() time.Time
+
+	// sinks receive entries as they are recorded; commitSinks receive them
+	// on Flush, once the release commit exists.
+	sinks       []Sink
+	commitSinks []Sink
+
+	mu      sync.Mutex
+	pending []Entry
 }
 
 // Entry represents a single audit log entry.
 type Entry struct {
-	Timestamp       string `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
-	PreviousVersion string `json:"previous_version" yaml:"previous_version"`
-	NewVersion      string `json:"new_version" yaml:"new_version"`
-	BumpType        string `json:"bump_type" yaml:"bump_type"`
-	Author          string `json:"author,omitempty" yaml:"author,omitempty"`
-	CommitSHA       string `json:"commit_sha,omitempty" yaml:"commit_sha,omitempty"`
-	Branch          string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Timestamp       string   `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	PreviousVersion string   `json:"previous_version" yaml:"previous_version"`
+	NewVersion      string   `json:"new_version" yaml:"new_version"`
+	BumpType        string   `json:"bump_type" yaml:"bump_type"`
+	Author          string   `json:"author,omitempty" yaml:"author,omitempty"`
+	CommitSHA       string   `json:"commit_sha,omitempty" yaml:"commit_sha,omitempty"`
+	Branch          string   `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Module          string   `json:"module,omitempty" yaml:"module,omitempty"`
+	Tag             string   `json:"tag,omitempty" yaml:"tag,omitempty"`
+	Hooks           []string `json:"hooks,omitempty" yaml:"hooks,omitempty"`
+	Extensions      []string `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+	PrevHash        string   `json:"prev_hash,omitempty" yaml:"prev_hash,omitempty"`
+	Hash            string   `json:"hash,omitempty" yaml:"hash,omitempty"`
 }
 
 // AuditLogFile represents the structure of the audit log file.
@@ -88,15 +101,17 @@ func NewAuditLogWithOps(cfg *Config, gitOps GitOperations, fileOps FileOperation
 		timeFunc: time.Now,
 	}
 
-	// Set marshal/unmarshal functions based on format
-	if cfg.GetFormat() == "yaml" {
-		plugin.marshalFn = yaml.Marshal
-		plugin.unmarshalFn = yaml.Unmarshal
-	} else {
-		plugin.marshalFn = func(v any) ([]byte, error) {
-			return json.MarshalIndent(v, "", "  ")
+	for _, sinkCfg := range cfg.GetSinks() {
+		sink, err := newSink(sinkCfg, cfg, fileOps)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: audit log: %v\n", err)
+			continue
+		}
+		if sinkCfg.Type == SinkGitNotes {
+			plugin.commitSinks = append(plugin.commitSinks, sink)
+		} else {
+			plugin.sinks = append(plugin.sinks, sink)
 		}
-		plugin.unmarshalFn = json.Unmarshal
 	}
 
 	return plugin
@@ -135,25 +150,37 @@ func (p *AuditLogPlugin) RecordEntry(entry *Entry) error {
 		fmt.Fprintf(os.Stderr, "Warning: failed to enrich audit log entry: %v\n", err)
 	}
 
-	// Read existing log
-	logFile, err := p.readLogFile()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: failed to read audit log: %v\n", err)
-		return nil // Don't fail the version bump
+	for _, sink := range p.sinks {
+		if err := sink.Write(*entry); err != nil {
+			// Log warning but don't fail the version bump
+			fmt.Fprintf(os.Stderr, "Warning: failed to write audit log to %s: %v\n", sink.Name(), err)
+		}
+	}
+
+	if len(p.commitSinks) > 0 {
+		p.mu.Lock()
+		p.pending = append(p.pending, *entry)
+		p.mu.Unlock()
 	}
 
-	// Add new entry
-	logFile.Entries = append(logFile.Entries, *entry)
+	return nil
+}
 
-	// Sort entries by timestamp (newest first)
-	p.sortEntries(logFile.Entries)
+// Flush writes the pending entries to the sinks that attach them to the
+// release commit. Failures are reported as warnings.
+func (p *AuditLogPlugin) Flush() error {
+	p.mu.Lock()
+	pending := p.pending
+	p.pending = nil
+	p.mu.Unlock()
 
-	// Write updated log
-	if err := p.writeLogFile(logFile); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: failed to write audit log: %v\n", err)
-		return nil // Don't fail the version bump
+	for _, entry := range pending {
+		for _, sink := range p.commitSinks {
+			if err := sink.Write(entry); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to write audit log to %s: %v\n", sink.Name(), err)
+			}
+		}
 	}
-
 	return nil
 }
 
@@ -187,45 +214,8 @@ func (p *AuditLogPlugin) enrichEntry(entry *Entry) error {
 	return nil
 }
 
-// readLogFile reads and parses the audit log file.
-func (p *AuditLogPlugin) readLogFile() (*AuditLogFile, error) {
-	path := p.config.GetPath()
-
-	// If file doesn't exist, return empty log
-	if !p.fileOps.FileExists(path) {
-		return &AuditLogFile{Entries: []Entry{}}, nil
-	}
-
-	data, err := p.fileOps.ReadFile(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read audit log %q: %w", path, err)
-	}
-
-	var logFile AuditLogFile
-	if err := p.unmarshalFn(data, &logFile); err != nil {
-		return nil, fmt.Errorf("failed to parse audit log %q: %w", path, err)
-	}
-
-	return &logFile, nil
-}
-
-// writeLogFile writes the audit log to disk.
-func (p *AuditLogPlugin) writeLogFile(logFile *AuditLogFile) error {
-	data, err := p.marshalFn(logFile)
-	if err != nil {
-		return fmt.Errorf("failed to marshal audit log: %w", err)
-	}
-
-	path := p.config.GetPath()
-	if err := p.fileOps.WriteFile(path, data, core.PermPublicRead); err != nil {
-		return fmt.Errorf("failed to write audit log %q: %w", path, err)
-	}
-
-	return nil
-}
-
 // sortEntries sorts entries by timestamp, newest first.
-func (p *AuditLogPlugin) sortEntries(entries []Entry) {
+func sortEntries(entries []Entry) {
 	sort.Slice(entries, func(i, j int) bool {
 		// Parse timestamps
 		ti, errI := time.Parse(time.RFC3339, entries[i].Timestamp)
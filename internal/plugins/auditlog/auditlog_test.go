@@ -754,9 +754,8 @@ func TestAuditLogPlugin_RecordEntry_ExistingEntries(t *testing.T) {
 	}
 }
 
-func TestAuditLogPlugin_SortEntries_InvalidTimestamps(t *testing.T) {
+func TestSortEntries_InvalidTimestamps(t *testing.T) {
 	t.Parallel()
-	plugin := NewAuditLog(nil)
 
 	entries := []Entry{
 		{Timestamp: "invalid-timestamp", NewVersion: "1.0.0"},
@@ -764,7 +763,7 @@ func TestAuditLogPlugin_SortEntries_InvalidTimestamps(t *testing.T) {
 		{Timestamp: "2026-01-01T10:00:00Z", NewVersion: "3.0.0"},
 	}
 
-	plugin.sortEntries(entries)
+	sortEntries(entries)
 
 	// With invalid timestamps, entries should maintain relative order (only valid one gets sorted)
 	// The valid timestamp entry should be at the end since it's the only one that parses
@@ -773,9 +772,8 @@ func TestAuditLogPlugin_SortEntries_InvalidTimestamps(t *testing.T) {
 	}
 }
 
-func TestAuditLogPlugin_SortEntries_AllValid(t *testing.T) {
+func TestSortEntries_AllValid(t *testing.T) {
 	t.Parallel()
-	plugin := NewAuditLog(nil)
 
 	entries := []Entry{
 		{Timestamp: "2026-01-01T10:00:00Z", NewVersion: "1.0.0"},
@@ -783,7 +781,7 @@ func TestAuditLogPlugin_SortEntries_AllValid(t *testing.T) {
 		{Timestamp: "2026-01-02T10:00:00Z", NewVersion: "2.0.0"},
 	}
 
-	plugin.sortEntries(entries)
+	sortEntries(entries)
 
 	// Should be sorted newest first
 	if entries[0].NewVersion != "3.0.0" {
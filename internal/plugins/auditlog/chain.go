@@ -0,0 +1,135 @@
+package auditlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-yaml"
+)
+
+// ChainIssue describes an entry that breaks the hash chain.
+type ChainIssue struct {
+	// Index is the position of the entry in the log file (0 is the newest).
+	Index  int
+	Entry  Entry
+	Reason string
+}
+
+// ReadLogFile reads the audit log at path in the given format (json, yaml
+// or jsonl), with entries newest first. A missing file yields an empty log.
+func ReadLogFile(path, format string) (*AuditLogFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &AuditLogFile{Entries: []Entry{}}, nil
+		}
+		return nil, fmt.Errorf("failed to read audit log %q: %w", path, err)
+	}
+
+	if format == SinkJSONL {
+		entries, err := readJSONL(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse audit log %q: %w", path, err)
+		}
+		return &AuditLogFile{Entries: entries}, nil
+	}
+
+	unmarshal := json.Unmarshal
+	if format == "yaml" {
+		unmarshal = yaml.Unmarshal
+	}
+
+	var logFile AuditLogFile
+	if err := unmarshal(data, &logFile); err != nil {
+		return nil, fmt.Errorf("failed to parse audit log %q: %w", path, err)
+	}
+	return &logFile, nil
+}
+
+// ComputeHash returns the SHA-256 hash of the entry content, including the
+// previous hash but excluding the entry's own hash.
+func ComputeHash(entry Entry) (string, error) {
+	entry.Hash = ""
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode entry: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// chainEntry links entry to the newest of the existing entries and sets its hash.
+func chainEntry(entry *Entry, existing []Entry) error {
+	entry.PrevHash = ""
+	if len(existing) > 0 {
+		prev, err := entryHash(existing[0])
+		if err != nil {
+			return err
+		}
+		entry.PrevHash = prev
+	}
+
+	hash, err := ComputeHash(*entry)
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+	return nil
+}
+
+// entryHash returns the stored hash of the entry, or computes it for entries
+// recorded before the hash chain was enabled.
+func entryHash(entry Entry) (string, error) {
+	if entry.Hash != "" {
+		return entry.Hash, nil
+	}
+	return ComputeHash(entry)
+}
+
+// VerifyChain checks the hash chain of entries ordered newest first.
+// Entries older than the first hashed entry are not part of the chain and
+// are not reported. It returns nil when the chain is intact.
+func VerifyChain(entries []Entry) ([]ChainIssue, error) {
+	var issues []ChainIssue
+	chained := false
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+
+		if entry.Hash == "" {
+			if chained {
+				issues = append(issues, ChainIssue{Index: i, Entry: entry, Reason: "missing hash"})
+			}
+			continue
+		}
+		chained = true
+
+		hash, err := ComputeHash(entry)
+		if err != nil {
+			return nil, err
+		}
+		if hash != entry.Hash {
+			issues = append(issues, ChainIssue{Index: i, Entry: entry, Reason: "content does not match its hash (entry was modified)"})
+		}
+
+		if i == len(entries)-1 {
+			if entry.PrevHash != "" {
+				issues = append(issues, ChainIssue{Index: i, Entry: entry, Reason: "previous entry is missing (older entries were removed)"})
+			}
+			continue
+		}
+
+		prev, err := entryHash(entries[i+1])
+		if err != nil {
+			return nil, err
+		}
+		if entry.PrevHash != prev {
+			issues = append(issues, ChainIssue{Index: i, Entry: entry, Reason: "previous hash does not match (entries were removed, inserted or reordered)"})
+		}
+	}
+
+	return issues, nil
+}
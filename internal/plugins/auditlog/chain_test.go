@@ -0,0 +1,155 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func recordChained(t *testing.T, count int) (*MockFileOps, *Config) {
+	t.Helper()
+	cfg := &Config{
+		Enabled:          true,
+		Path:             ".version-history.json",
+		Format:           "json",
+		IncludeTimestamp: true,
+		HashChain:        true,
+	}
+	mockFile := NewMockFileOps()
+	plugin := NewAuditLogWithOps(cfg, &MockGitOps{}, mockFile)
+
+	for i := range count {
+		plugin.timeFunc = func() time.Time { return time.Date(2026, 1, 1+i, 0, 0, 0, 0, time.UTC) }
+		entry := &Entry{PreviousVersion: fmt.Sprintf("1.0.%d", i), NewVersion: fmt.Sprintf("1.0.%d", i+1), BumpType: "patch"}
+		if err := plugin.RecordEntry(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return mockFile, cfg
+}
+
+func readEntries(t *testing.T, data []byte) []Entry {
+	t.Helper()
+	var logFile AuditLogFile
+	if err := json.Unmarshal(data, &logFile); err != nil {
+		t.Fatal(err)
+	}
+	return logFile.Entries
+}
+
+func TestRecordEntry_HashChain(t *testing.T) {
+	t.Parallel()
+	mockFile, cfg := recordChained(t, 3)
+	entries := readEntries(t, mockFile.data[cfg.Path])
+
+	if len(entries) != 3 || entries[0].NewVersion != "1.0.3" {
+		t.Fatalf("expected newest entry first, got %+v", entries)
+	}
+	if entries[2].PrevHash != "" {
+		t.Errorf("expected the first entry to have no previous hash, got %q", entries[2].PrevHash)
+	}
+	for i := range 2 {
+		if entries[i].PrevHash != entries[i+1].Hash {
+			t.Errorf("entry %d is not linked to entry %d", i, i+1)
+		}
+	}
+
+	issues, err := VerifyChain(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected an intact chain, got %+v", issues)
+	}
+}
+
+func TestVerifyChain_DetectsEdits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		tamper func([]Entry) []Entry
+		reason string
+	}{
+		{"modified entry", func(e []Entry) []Entry { e[1].NewVersion = "9.9.9"; return e }, "modified"},
+		{"removed entry", func(e []Entry) []Entry { return append(e[:1], e[2:]...) }, "previous hash"},
+		{"removed oldest entry", func(e []Entry) []Entry { return e[:2] }, "older entries were removed"},
+		{"reordered entries", func(e []Entry) []Entry { e[0], e[1] = e[1], e[0]; return e }, "previous hash"},
+		{"unhashed entry added", func(e []Entry) []Entry { return append([]Entry{{NewVersion: "2.0.0"}}, e...) }, "missing hash"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockFile, cfg := recordChained(t, 3)
+			entries := tt.tamper(readEntries(t, mockFile.data[cfg.Path]))
+
+			issues, err := VerifyChain(entries)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := false
+			for _, issue := range issues {
+				if strings.Contains(issue.Reason, tt.reason) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("expected an issue containing %q, got %+v", tt.reason, issues)
+			}
+		})
+	}
+}
+
+func TestVerifyChain_UnhashedHistoryBeforeChain(t *testing.T) {
+	t.Parallel()
+	legacy := Entry{Timestamp: "2025-12-01T00:00:00Z", PreviousVersion: "0.9.0", NewVersion: "1.0.0", BumpType: "major"}
+
+	entry := Entry{Timestamp: "2026-01-01T00:00:00Z", PreviousVersion: "1.0.0", NewVersion: "1.0.1", BumpType: "patch"}
+	if err := chainEntry(&entry, []Entry{legacy}); err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := VerifyChain([]Entry{entry, legacy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected legacy entries to be accepted, got %+v", issues)
+	}
+}
+
+func TestReadLogFile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	logFile, err := ReadLogFile(filepath.Join(dir, "missing.json"), "json")
+	if err != nil || len(logFile.Entries) != 0 {
+		t.Fatalf("expected empty log for missing file, got %v, %v", logFile, err)
+	}
+
+	yamlPath := filepath.Join(dir, "history.yaml")
+	content := "entries:\n  - previous_version: 1.0.0\n    new_version: 1.1.0\n    bump_type: minor\n    module: api\n    tag: api/v1.1.0\n"
+	if err := os.WriteFile(yamlPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	logFile, err = ReadLogFile(yamlPath, "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logFile.Entries) != 1 || logFile.Entries[0].Module != "api" || logFile.Entries[0].Tag != "api/v1.1.0" {
+		t.Errorf("unexpected entries: %+v", logFile.Entries)
+	}
+
+	badPath := filepath.Join(dir, "bad.json")
+	if err := os.WriteFile(badPath, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadLogFile(badPath, "json"); err == nil {
+		t.Error("expected parse error")
+	}
+}
@@ -1,6 +1,10 @@
 package auditlog
 
-import "github.com/indaco/sley/internal/config"
+import (
+	"time"
+
+	"github.com/indaco/sley/internal/config"
+)
 
 // Config holds configuration for the audit log plugin.
 type Config struct {
@@ -24,6 +28,37 @@ type Config struct {
 
 	// IncludeBranch includes current branch name in log entries.
 	IncludeBranch bool
+
+	// HashChain links each entry to the previous one by hash,
+	// so that manual edits can be detected with "sley history verify".
+	HashChain bool
+
+	// Sinks lists the destinations entries are written to.
+	// When empty, a single file sink using Path and Format is used.
+	Sinks []SinkConfig
+}
+
+// SinkConfig configures a single audit log destination.
+type SinkConfig struct {
+	// Type is one of SinkFile, SinkJSONL, SinkGitNotes, SinkSyslog or SinkWebhook.
+	Type string
+
+	// Path and Format configure the file and jsonl sinks.
+	Path   string
+	Format string
+
+	// Ref is the git notes ref.
+	Ref string
+
+	// Tag, Network and Address configure the syslog sink.
+	Tag     string
+	Network string
+	Address string
+
+	// URL, Headers and Timeout configure the webhook sink.
+	URL     string
+	Headers map[string]string
+	Timeout time.Duration
 }
 
 // DefaultConfig returns the default audit log configuration.
@@ -55,6 +90,28 @@ func (c *Config) GetFormat() string {
 	return c.Format
 }
 
+// GetSinks returns the configured sinks, defaulting to a single file sink.
+func (c *Config) GetSinks() []SinkConfig {
+	if len(c.Sinks) == 0 {
+		return []SinkConfig{{Type: SinkFile, Path: c.GetPath(), Format: c.GetFormat()}}
+	}
+	return c.Sinks
+}
+
+// HistorySource returns the path and format of the file "sley history"
+// reads: the first file or jsonl sink, or the default file.
+func (c *Config) HistorySource() (path, format string) {
+	for _, sink := range c.GetSinks() {
+		switch sink.Type {
+		case SinkFile:
+			return sinkPath(sink, c), sinkFormat(sink, c)
+		case SinkJSONL:
+			return sinkPath(sink, c), SinkJSONL
+		}
+	}
+	return c.GetPath(), c.GetFormat()
+}
+
 // FromConfigStruct converts the config package struct to internal config.
 func FromConfigStruct(cfg *config.AuditLogConfig) *Config {
 	if cfg == nil {
@@ -69,5 +126,32 @@ func FromConfigStruct(cfg *config.AuditLogConfig) *Config {
 		IncludeTimestamp: cfg.IncludeTimestamp,
 		IncludeCommitSHA: cfg.IncludeCommitSHA,
 		IncludeBranch:    cfg.IncludeBranch,
+		HashChain:        cfg.HashChain,
+		Sinks:            convertSinks(cfg.Sinks),
+	}
+}
+
+func convertSinks(sinks []config.AuditLogSinkConfig) []SinkConfig {
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	result := make([]SinkConfig, len(sinks))
+	for i, s := range sinks {
+		// An invalid timeout is reported by "sley doctor"; the default applies.
+		timeout, _ := time.ParseDuration(s.Timeout)
+		result[i] = SinkConfig{
+			Type:    s.Type,
+			Path:    s.Path,
+			Format:  s.Format,
+			Ref:     s.Ref,
+			Tag:     s.Tag,
+			Network: s.Network,
+			Address: s.Address,
+			URL:     s.URL,
+			Headers: s.Headers,
+			Timeout: timeout,
+		}
 	}
+	return result
 }
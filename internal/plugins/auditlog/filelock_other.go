@@ -0,0 +1,11 @@
+//go:build !unix && !windows
+
+package auditlog
+
+import "os"
+
+// lockFile is a no-op on platforms without file locking.
+func lockFile(_ *os.File) error { return nil }
+
+// unlockFile is a no-op on platforms without file locking.
+func unlockFile(_ *os.File) error { return nil }
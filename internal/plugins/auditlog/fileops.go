@@ -2,6 +2,8 @@ package auditlog
 
 import (
 	"os"
+
+	"github.com/indaco/sley/internal/core"
 )
 
 // DefaultFileOps implements FileOperations using standard library.
@@ -22,3 +24,20 @@ func (f *DefaultFileOps) FileExists(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil
 }
+
+// Lock takes an exclusive lock on the file at path, creating it if needed,
+// and returns a function releasing the lock.
+func (f *DefaultFileOps) Lock(path string) (func(), error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, core.PermPublicRead)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(file); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return func() {
+		_ = unlockFile(file)
+		_ = file.Close()
+	}, nil
+}
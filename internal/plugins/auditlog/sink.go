@@ -0,0 +1,85 @@
+package auditlog
+
+import (
+	"fmt"
+)
+
+// Sink types supported in the audit-log configuration.
+const (
+	SinkFile     = "file"
+	SinkJSONL    = "jsonl"
+	SinkGitNotes = "git-notes"
+	SinkSyslog   = "syslog"
+	SinkWebhook  = "webhook"
+)
+
+// Default settings of the individual sinks.
+const (
+	defaultJSONLPath   = ".version-history.jsonl"
+	defaultNotesRef    = "sley-audit"
+	defaultSyslogTag   = "sley"
+	defaultWebhookWait = 10
+)
+
+// Sink is a destination for audit log entries.
+type Sink interface {
+	// Name identifies the sink in warnings.
+	Name() string
+
+	// Write records a single entry.
+	Write(entry Entry) error
+}
+
+// FileLocker is implemented by FileOperations that can lock a file across
+// processes. File sinks hold the lock while they read and rewrite the log.
+type FileLocker interface {
+	Lock(path string) (unlock func(), err error)
+}
+
+// newSink creates the sink described by sinkCfg.
+func newSink(sinkCfg SinkConfig, cfg *Config, fileOps FileOperations) (Sink, error) {
+	switch sinkCfg.Type {
+	case SinkFile:
+		return newFileSink(sinkPath(sinkCfg, cfg), sinkFormat(sinkCfg, cfg), cfg.HashChain, fileOps), nil
+	case SinkJSONL:
+		return &jsonlSink{path: sinkPath(sinkCfg, cfg), hashChain: cfg.HashChain}, nil
+	case SinkGitNotes:
+		return newGitNotesSink(sinkCfg.Ref), nil
+	case SinkSyslog:
+		return newSyslogSink(sinkCfg), nil
+	case SinkWebhook:
+		if sinkCfg.URL == "" {
+			return nil, fmt.Errorf("webhook sink requires a url")
+		}
+		return newWebhookSink(sinkCfg), nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sinkCfg.Type)
+	}
+}
+
+// sinkPath returns the output path of a file or jsonl sink.
+func sinkPath(sinkCfg SinkConfig, cfg *Config) string {
+	if sinkCfg.Path != "" {
+		return sinkCfg.Path
+	}
+	if sinkCfg.Type == SinkJSONL {
+		return defaultJSONLPath
+	}
+	return cfg.GetPath()
+}
+
+// sinkFormat returns the format of a file sink.
+func sinkFormat(sinkCfg SinkConfig, cfg *Config) string {
+	if sinkCfg.Format != "" {
+		return sinkCfg.Format
+	}
+	return cfg.GetFormat()
+}
+
+// lockPath locks path when fileOps supports locking.
+func lockPath(fileOps FileOperations, path string) (func(), error) {
+	if locker, ok := fileOps.(FileLocker); ok {
+		return locker.Lock(path)
+	}
+	return func() {}, nil
+}
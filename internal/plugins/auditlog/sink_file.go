@@ -0,0 +1,219 @@
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/goccy/go-yaml"
+	"github.com/indaco/sley/internal/core"
+)
+
+// fileSink keeps every entry in a single JSON or YAML document, newest first.
+// The whole file is rewritten on every entry.
+type fileSink struct {
+	path        string
+	hashChain   bool
+	fileOps     FileOperations
+	marshalFn   func(any) ([]byte, error)
+	unmarshalFn func([]byte, any) error
+}
+
+func newFileSink(path, format string, hashChain bool, fileOps FileOperations) *fileSink {
+	s := &fileSink{path: path, hashChain: hashChain, fileOps: fileOps}
+
+	// Set marshal/unmarshal functions based on format
+	if format == "yaml" {
+		s.marshalFn = yaml.Marshal
+		s.unmarshalFn = yaml.Unmarshal
+	} else {
+		s.marshalFn = func(v any) ([]byte, error) {
+			return json.MarshalIndent(v, "", "  ")
+		}
+		s.unmarshalFn = json.Unmarshal
+	}
+	return s
+}
+
+// Name returns the sink name.
+func (s *fileSink) Name() string { return s.path }
+
+// Write adds the entry to the log file while holding the file lock.
+func (s *fileSink) Write(entry Entry) error {
+	unlock, err := lockPath(s.fileOps, s.path)
+	if err != nil {
+		return fmt.Errorf("failed to lock audit log %q: %w", s.path, err)
+	}
+	defer unlock()
+
+	logFile, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if s.hashChain {
+		// The file order is the chain order, so the entry is prepended
+		// instead of re-sorting by timestamp.
+		if err := chainEntry(&entry, logFile.Entries); err != nil {
+			return err
+		}
+		logFile.Entries = append([]Entry{entry}, logFile.Entries...)
+	} else {
+		// Add new entry
+		logFile.Entries = append(logFile.Entries, entry)
+
+		// Sort entries by timestamp (newest first)
+		sortEntries(logFile.Entries)
+	}
+
+	return s.write(logFile)
+}
+
+// read reads and parses the audit log file.
+func (s *fileSink) read() (*AuditLogFile, error) {
+	// If file doesn't exist, return empty log
+	if !s.fileOps.FileExists(s.path) {
+		return &AuditLogFile{Entries: []Entry{}}, nil
+	}
+
+	data, err := s.fileOps.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log %q: %w", s.path, err)
+	}
+
+	// Locking may have just created the file.
+	if len(bytes.TrimSpace(data)) == 0 {
+		return &AuditLogFile{Entries: []Entry{}}, nil
+	}
+
+	var logFile AuditLogFile
+	if err := s.unmarshalFn(data, &logFile); err != nil {
+		return nil, fmt.Errorf("failed to parse audit log %q: %w", s.path, err)
+	}
+
+	return &logFile, nil
+}
+
+// write writes the audit log to disk.
+func (s *fileSink) write(logFile *AuditLogFile) error {
+	data, err := s.marshalFn(logFile)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log: %w", err)
+	}
+
+	if err := s.fileOps.WriteFile(s.path, data, core.PermPublicRead); err != nil {
+		return fmt.Errorf("failed to write audit log %q: %w", s.path, err)
+	}
+
+	return nil
+}
+
+// jsonlSink appends one JSON object per line, oldest first, without
+// rewriting existing entries.
+type jsonlSink struct {
+	path      string
+	hashChain bool
+}
+
+// Name returns the sink name.
+func (s *jsonlSink) Name() string { return s.path }
+
+// Write appends the entry while holding the file lock.
+func (s *jsonlSink) Write(entry Entry) error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, core.PermPublicRead)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %q: %w", s.path, err)
+	}
+	defer f.Close()
+
+	if err := lockFile(f); err != nil {
+		return fmt.Errorf("failed to lock audit log %q: %w", s.path, err)
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	if s.hashChain {
+		var previous []Entry
+		last, err := lastLine(f)
+		if err != nil {
+			return fmt.Errorf("failed to read audit log %q: %w", s.path, err)
+		}
+		if len(last) > 0 {
+			var prev Entry
+			if err := json.Unmarshal(last, &prev); err != nil {
+				return fmt.Errorf("failed to parse last entry of %q: %w", s.path, err)
+			}
+			previous = []Entry{prev}
+		}
+		if err := chainEntry(&entry, previous); err != nil {
+			return err
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log entry: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log %q: %w", s.path, err)
+	}
+	return nil
+}
+
+// lastLineChunk is the size of the blocks read backwards by lastLine.
+const lastLineChunk = 4096
+
+// lastLine returns the last non-empty line of f by reading it backwards,
+// so that appending stays cheap for long histories.
+func lastLine(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	end := info.Size()
+	var tail []byte
+	for end > 0 {
+		start := max(end-lastLineChunk, 0)
+		buf := make([]byte, end-start)
+		if _, err := f.ReadAt(buf, start); err != nil && err != io.EOF {
+			return nil, err
+		}
+		tail = append(buf, tail...)
+		end = start
+
+		trimmed := bytes.TrimRight(tail, "\r\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+	}
+	return bytes.TrimRight(tail, "\r\n"), nil
+}
+
+// readJSONL reads a JSON Lines history, returning the entries newest first.
+func readJSONL(data []byte) ([]Entry, error) {
+	entries := []Entry{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(text, &entry); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(entries)
+	return entries, nil
+}
@@ -0,0 +1,54 @@
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+// gitNotesSink attaches entries to HEAD as git notes. It is written on
+// Flush, once the release commit has been created.
+type gitNotesSink struct {
+	ref string
+	run func(ctx context.Context, args ...string) error
+}
+
+func newGitNotesSink(ref string) *gitNotesSink {
+	if ref == "" {
+		ref = defaultNotesRef
+	}
+	return &gitNotesSink{ref: ref, run: runGit}
+}
+
+// Name returns the sink name.
+func (s *gitNotesSink) Name() string { return "git notes (" + s.ref + ")" }
+
+// Write appends the entry to the note of the HEAD commit.
+func (s *gitNotesSink) Write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log entry: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), core.TimeoutGit)
+	defer cancel()
+
+	return s.run(ctx, "notes", "--ref", s.ref, "append", "-m", string(data), "HEAD")
+}
+
+// runGit runs a git command, including its stderr in the error.
+func runGit(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if msg := strings.TrimSpace(string(output)); msg != "" {
+			return fmt.Errorf("git %s: %s: %w", args[0], msg, err)
+		}
+		return fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return nil
+}
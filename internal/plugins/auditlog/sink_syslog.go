@@ -0,0 +1,44 @@
+//go:build !windows && !plan9
+
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+)
+
+// syslogSink sends each entry as a JSON message to syslog. On systemd
+// hosts the local socket is served by journald.
+type syslogSink struct {
+	tag     string
+	network string
+	address string
+}
+
+func newSyslogSink(cfg SinkConfig) Sink {
+	tag := cfg.Tag
+	if tag == "" {
+		tag = defaultSyslogTag
+	}
+	return &syslogSink{tag: tag, network: cfg.Network, address: cfg.Address}
+}
+
+// Name returns the sink name.
+func (s *syslogSink) Name() string { return "syslog" }
+
+// Write logs the entry at info level.
+func (s *syslogSink) Write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log entry: %w", err)
+	}
+
+	w, err := syslog.Dial(s.network, s.address, syslog.LOG_INFO|syslog.LOG_USER, s.tag)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	defer w.Close()
+
+	return w.Info(string(data))
+}
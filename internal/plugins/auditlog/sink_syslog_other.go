@@ -0,0 +1,18 @@
+//go:build windows || plan9
+
+package auditlog
+
+import "errors"
+
+// syslogSink reports that syslog is not available on this platform.
+type syslogSink struct{}
+
+func newSyslogSink(_ SinkConfig) Sink { return syslogSink{} }
+
+// Name returns the sink name.
+func (syslogSink) Name() string { return "syslog" }
+
+// Write always fails: syslog is not available on this platform.
+func (syslogSink) Write(_ Entry) error {
+	return errors.New("syslog is not supported on this platform")
+}
@@ -0,0 +1,294 @@
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/indaco/sley/internal/config"
+)
+
+func TestNewSink(t *testing.T) {
+	t.Parallel()
+	cfg := &Config{Path: "history.json", Format: "yaml"}
+
+	tests := []struct {
+		sink    SinkConfig
+		wantErr bool
+		name    string
+	}{
+		{SinkConfig{Type: SinkFile}, false, "history.json"},
+		{SinkConfig{Type: SinkJSONL}, false, defaultJSONLPath},
+		{SinkConfig{Type: SinkJSONL, Path: "audit.jsonl"}, false, "audit.jsonl"},
+		{SinkConfig{Type: SinkGitNotes}, false, "git notes (sley-audit)"},
+		{SinkConfig{Type: SinkSyslog}, false, "syslog"},
+		{SinkConfig{Type: SinkWebhook, URL: "https://example.com/hook"}, false, "webhook https://example.com/hook"},
+		{SinkConfig{Type: SinkWebhook}, true, ""},
+		{SinkConfig{Type: "kafka"}, true, ""},
+	}
+
+	for _, tt := range tests {
+		sink, err := newSink(tt.sink, cfg, &DefaultFileOps{})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("newSink(%+v) error = %v, wantErr %v", tt.sink, err, tt.wantErr)
+			continue
+		}
+		if err == nil && sink.Name() != tt.name {
+			t.Errorf("newSink(%+v) name = %q, want %q", tt.sink, sink.Name(), tt.name)
+		}
+	}
+}
+
+func TestConfig_HistorySource(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{Path: "history.yaml", Format: "yaml"}
+	if path, format := cfg.HistorySource(); path != "history.yaml" || format != "yaml" {
+		t.Errorf("default source = %q %q", path, format)
+	}
+
+	cfg.Sinks = []SinkConfig{{Type: SinkWebhook, URL: "https://example.com"}, {Type: SinkJSONL}}
+	if path, format := cfg.HistorySource(); path != defaultJSONLPath || format != SinkJSONL {
+		t.Errorf("jsonl source = %q %q", path, format)
+	}
+}
+
+func TestJSONLSink_AppendsWithHashChain(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	sink := &jsonlSink{path: path, hashChain: true}
+
+	for i := range 3 {
+		entry := Entry{PreviousVersion: fmt.Sprintf("1.%d.0", i), NewVersion: fmt.Sprintf("1.%d.0", i+1), BumpType: "minor"}
+		if err := sink.Write(entry); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 3 {
+		t.Fatalf("expected 3 lines, got %d:\n%s", lines, data)
+	}
+
+	logFile, err := ReadLogFile(path, SinkJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logFile.Entries[0].NewVersion != "1.3.0" {
+		t.Errorf("expected entries newest first, got %+v", logFile.Entries)
+	}
+	issues, err := VerifyChain(logFile.Entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected an intact chain, got %+v", issues)
+	}
+}
+
+func TestFileSinks_ConcurrentWrites(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	sinks := []Sink{
+		newFileSink(filepath.Join(dir, "history.json"), "json", true, &DefaultFileOps{}),
+		&jsonlSink{path: filepath.Join(dir, "history.jsonl"), hashChain: true},
+	}
+	const writers = 20
+
+	for _, sink := range sinks {
+		var wg sync.WaitGroup
+		for i := range writers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				entry := Entry{NewVersion: fmt.Sprintf("1.0.%d", i), BumpType: "patch", Module: fmt.Sprintf("mod-%d", i)}
+				if err := sink.Write(entry); err != nil {
+					t.Errorf("%s: Write() error = %v", sink.Name(), err)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	for path, format := range map[string]string{"history.json": "json", "history.jsonl": SinkJSONL} {
+		logFile, err := ReadLogFile(filepath.Join(dir, path), format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(logFile.Entries) != writers {
+			t.Errorf("%s: expected %d entries, got %d", path, writers, len(logFile.Entries))
+		}
+		if issues, _ := VerifyChain(logFile.Entries); len(issues) != 0 {
+			t.Errorf("%s: expected an intact chain, got %+v", path, issues)
+		}
+	}
+}
+
+func TestLastLine(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "lines")
+
+	long := strings.Repeat("x", lastLineChunk+10)
+	tests := map[string]string{
+		"":                       "",
+		"only\n":                 "only",
+		"first\nsecond\n":        "second",
+		"first\nsecond\n\n":      "second",
+		"first\n" + long + "\n":  long,
+		long + "\nlast-line\r\n": "last-line",
+	}
+
+	for content, want := range tests {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := lastLine(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("lastLine(%.20q) = %.20q, want %.20q", content, got, want)
+		}
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	t.Setenv("SLEY_TEST_AUDIT_TOKEN", "s3cret")
+
+	var (
+		gotEntry Entry
+		gotAuth  string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &gotEntry)
+		if gotEntry.NewVersion == "9.9.9" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	sink := newWebhookSink(SinkConfig{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer ${SLEY_TEST_AUDIT_TOKEN}"},
+	})
+
+	if err := sink.Write(Entry{NewVersion: "1.2.3", BumpType: "patch"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if gotEntry.NewVersion != "1.2.3" {
+		t.Errorf("unexpected entry posted: %+v", gotEntry)
+	}
+	if gotAuth != "Bearer s3cret" {
+		t.Errorf("expected expanded header, got %q", gotAuth)
+	}
+
+	if err := sink.Write(Entry{NewVersion: "9.9.9"}); err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("expected status error, got %v", err)
+	}
+}
+
+func TestGitNotesSink(t *testing.T) {
+	t.Parallel()
+
+	var gotArgs []string
+	sink := newGitNotesSink("")
+	sink.run = func(_ context.Context, args ...string) error {
+		gotArgs = args
+		return nil
+	}
+
+	if err := sink.Write(Entry{NewVersion: "1.2.3", Tag: "v1.2.3"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	joined := strings.Join(gotArgs, " ")
+	if !strings.HasPrefix(joined, "notes --ref sley-audit append -m ") || !strings.HasSuffix(joined, " HEAD") {
+		t.Errorf("unexpected git arguments: %v", gotArgs)
+	}
+	if !strings.Contains(joined, `"tag":"v1.2.3"`) {
+		t.Errorf("expected the entry JSON in the note, got %v", gotArgs)
+	}
+}
+
+type recordingSink struct {
+	entries []Entry
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Write(entry Entry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestAuditLogPlugin_Flush(t *testing.T) {
+	t.Parallel()
+	plugin := NewAuditLogWithOps(&Config{Enabled: true, Sinks: []SinkConfig{{Type: SinkGitNotes}}}, &MockGitOps{}, NewMockFileOps())
+
+	if len(plugin.sinks) != 0 || len(plugin.commitSinks) != 1 {
+		t.Fatalf("expected git notes to be a commit sink, got %d/%d", len(plugin.sinks), len(plugin.commitSinks))
+	}
+	notes := &recordingSink{}
+	plugin.commitSinks = []Sink{notes}
+
+	if err := plugin.RecordEntry(&Entry{NewVersion: "1.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(notes.entries) != 0 {
+		t.Fatal("expected commit sinks to wait for Flush")
+	}
+
+	if err := plugin.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if len(notes.entries) != 1 || notes.entries[0].NewVersion != "1.0.1" {
+		t.Errorf("unexpected flushed entries: %+v", notes.entries)
+	}
+
+	if err := plugin.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if len(notes.entries) != 1 {
+		t.Error("expected pending entries to be flushed once")
+	}
+}
+
+func TestFromConfigStruct_Sinks(t *testing.T) {
+	t.Parallel()
+	cfg := FromConfigStruct(&config.AuditLogConfig{
+		Enabled: true,
+		Sinks: []config.AuditLogSinkConfig{
+			{Type: "jsonl", Path: "audit.jsonl"},
+			{Type: "webhook", URL: "https://example.com", Timeout: "3s", Headers: map[string]string{"X-Key": "v"}},
+		},
+	})
+
+	if len(cfg.Sinks) != 2 {
+		t.Fatalf("expected 2 sinks, got %d", len(cfg.Sinks))
+	}
+	if cfg.Sinks[0].Type != SinkJSONL || cfg.Sinks[0].Path != "audit.jsonl" {
+		t.Errorf("unexpected jsonl sink: %+v", cfg.Sinks[0])
+	}
+	if cfg.Sinks[1].Timeout.Seconds() != 3 || cfg.Sinks[1].Headers["X-Key"] != "v" {
+		t.Errorf("unexpected webhook sink: %+v", cfg.Sinks[1])
+	}
+}
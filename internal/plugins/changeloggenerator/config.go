@@ -1,6 +1,10 @@
 package changeloggenerator
 
-import "github.com/indaco/sley/internal/config"
+import (
+	"path/filepath"
+
+	"github.com/indaco/sley/internal/config"
+)
 
 // DefaultGroupIcons maps default group labels to their icons.
 // These are used when UseDefaultIcons is enabled.
@@ -44,8 +48,12 @@ type Config struct {
 	// Format determines the changelog format: "grouped" or "keepachangelog".
 	// "grouped" (default): Current behavior with custom group labels
 	// "keepachangelog": Keep a Changelog specification format
+	// "template": Renders the Go text/template set in Template
 	Format string
 
+	// Template is the template path, or "builtin:<name>", for the "template" format.
+	Template string
+
 	// ChangesDir is the directory for version-specific changelog files.
 	ChangesDir string
 
@@ -83,6 +91,18 @@ type Config struct {
 	// Contributors configures the contributors section.
 	Contributors *ContributorsConfig
 
+	// PullRequests configures pull/merge request lookup through the forge API.
+	// Nil when disabled.
+	PullRequests *PullRequestsConfig
+
+	// Outputs lists additional unified changelogs rendered from the
+	// per-version release data.
+	Outputs []OutputConfig
+
+	// Routing splits the changelog per module in coordinated workspaces.
+	// Nil when disabled.
+	Routing *RoutingConfig
+
 	// MergeAfter controls when versioned changelog files are merged into the unified changelog.
 	// Values: "immediate" (merge right after generation), "manual" (no auto-merge, default),
 	// "prompt" (interactive confirmation, auto-skips in CI/non-interactive environments).
@@ -110,6 +130,45 @@ type GroupConfig struct {
 	Label   string
 	Icon    string
 	Order   int
+	Labels  []string // Pull request labels matching this group
+}
+
+// PullRequestsConfig configures pull/merge request lookup.
+type PullRequestsConfig struct {
+	// BaseURL overrides the API base URL derived from the remote.
+	BaseURL string
+	// TokenEnv is the environment variable holding the API token.
+	TokenEnv string
+	// CachePath is the on-disk cache of lookups.
+	CachePath string
+	// Offline only uses cached lookups.
+	Offline bool
+}
+
+// OutputConfig is an additional changelog output.
+type OutputConfig struct {
+	// Path is the output file path.
+	Path string
+	// Format is one of OutputFormats.
+	Format string
+}
+
+// RoutingConfig configures per-module changelog routing.
+type RoutingConfig struct {
+	// Modules lists the modules commits are routed to, in order.
+	Modules []RouteConfig
+	// Aggregate also writes the root changelog with a subsection per module.
+	Aggregate bool
+	// RootLabel is the root subsection for commits that match no module.
+	RootLabel string
+}
+
+// RouteConfig routes commits to a module changelog.
+type RouteConfig struct {
+	Name          string
+	Path          string   // Module directory, slash-separated
+	Scopes        []string // Conventional commit scopes
+	ChangelogPath string
 }
 
 // ContributorsConfig configures the contributors section.
@@ -183,6 +242,7 @@ func FromConfigStruct(cfg *config.ChangelogGeneratorConfig) *Config {
 		Enabled:                cfg.Enabled,
 		Mode:                   cfg.GetMode(),
 		Format:                 cfg.GetFormat(),
+		Template:               cfg.Template,
 		ChangesDir:             cfg.GetChangesDir(),
 		ChangelogPath:          cfg.GetChangelogPath(),
 		MergeAfter:             cfg.GetMergeAfter(),
@@ -201,6 +261,11 @@ func FromConfigStruct(cfg *config.ChangelogGeneratorConfig) *Config {
 
 	result.BreakingChangesIcon = convertBreakingChangesIcon(cfg)
 	result.Contributors = convertContributorsConfig(cfg)
+	result.PullRequests = convertPullRequestsConfig(cfg.PullRequests)
+	for _, out := range cfg.Outputs {
+		result.Outputs = append(result.Outputs, OutputConfig{Path: out.Path, Format: out.Format})
+	}
+	result.Routing = convertRoutingConfig(cfg.Routing)
 
 	return result
 }
@@ -231,6 +296,48 @@ func convertRepositoryConfig(repo *config.RepositoryConfig) *RepositoryConfig {
 	}
 }
 
+// convertPullRequestsConfig converts pull request lookup configuration,
+// returning nil when it is disabled.
+func convertPullRequestsConfig(prs *config.PullRequestsConfig) *PullRequestsConfig {
+	if prs == nil || !prs.Enabled {
+		return nil
+	}
+	return &PullRequestsConfig{
+		BaseURL:   prs.BaseURL,
+		TokenEnv:  prs.TokenEnv,
+		CachePath: prs.GetCachePath(),
+		Offline:   prs.Offline,
+	}
+}
+
+// convertRoutingConfig converts routing configuration, returning nil when it
+// is disabled. Module changelogs default to "<path>/CHANGELOG.md".
+func convertRoutingConfig(routing *config.ChangelogRoutingConfig) *RoutingConfig {
+	if routing == nil || !routing.Enabled {
+		return nil
+	}
+
+	result := &RoutingConfig{
+		Aggregate: routing.GetAggregate(),
+		RootLabel: routing.GetRootLabel(),
+	}
+	for _, route := range routing.Modules {
+		rc := RouteConfig{
+			Name:          route.Name,
+			Scopes:        route.Scopes,
+			ChangelogPath: route.ChangelogPath,
+		}
+		if route.Path != "" {
+			rc.Path = filepath.ToSlash(filepath.Clean(route.Path))
+		}
+		if rc.ChangelogPath == "" {
+			rc.ChangelogPath = filepath.Join(route.Path, "CHANGELOG.md")
+		}
+		result.Modules = append(result.Modules, rc)
+	}
+	return result
+}
+
 // convertGroupsConfig converts groups configuration with icon handling.
 func convertGroupsConfig(cfg *config.ChangelogGeneratorConfig) ([]GroupConfig, map[string]string) {
 	if len(cfg.Groups) > 0 {
@@ -241,6 +348,7 @@ func convertGroupsConfig(cfg *config.ChangelogGeneratorConfig) ([]GroupConfig, m
 				Label:   g.Label,
 				Icon:    g.Icon,
 				Order:   g.Order,
+				Labels:  g.Labels,
 			}
 		}
 		return groups, nil
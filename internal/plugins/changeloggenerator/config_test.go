@@ -1,6 +1,7 @@
 package changeloggenerator
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/indaco/sley/internal/config"
@@ -637,3 +638,74 @@ func TestFromConfigStruct_MergeAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestFromConfigStruct_PullRequests(t *testing.T) {
+
+	cfg := FromConfigStruct(&config.ChangelogGeneratorConfig{Enabled: true})
+	if cfg.PullRequests != nil {
+		t.Errorf("PullRequests = %+v, want nil when not configured", cfg.PullRequests)
+	}
+
+	cfg = FromConfigStruct(&config.ChangelogGeneratorConfig{
+		Enabled:      true,
+		Groups:       []config.CommitGroupConfig{{Label: "Security", Labels: []string{"security"}}},
+		PullRequests: &config.PullRequestsConfig{Enabled: true, BaseURL: "https://git.corp/api/v3", Offline: true},
+	})
+	if cfg.PullRequests == nil {
+		t.Fatal("PullRequests = nil, want config")
+	}
+	if cfg.PullRequests.BaseURL != "https://git.corp/api/v3" || !cfg.PullRequests.Offline {
+		t.Errorf("PullRequests = %+v", cfg.PullRequests)
+	}
+	if cfg.PullRequests.CachePath != ".sley-cache/pull-requests.json" {
+		t.Errorf("CachePath = %q, want default", cfg.PullRequests.CachePath)
+	}
+	if cfg.Template != "" {
+		t.Errorf("Template = %q, want empty", cfg.Template)
+	}
+	if len(cfg.Groups[0].Labels) != 1 || cfg.Groups[0].Labels[0] != "security" {
+		t.Errorf("Groups[0].Labels = %v, want [security]", cfg.Groups[0].Labels)
+	}
+}
+
+func TestFromConfigStruct_Outputs(t *testing.T) {
+
+	cfg := FromConfigStruct(&config.ChangelogGeneratorConfig{
+		Enabled: true,
+		Outputs: []config.ChangelogOutputConfig{{Path: "docs/changelog.html", Format: "html"}},
+	})
+	if len(cfg.Outputs) != 1 || cfg.Outputs[0] != (OutputConfig{Path: "docs/changelog.html", Format: "html"}) {
+		t.Errorf("Outputs = %+v", cfg.Outputs)
+	}
+}
+
+func TestFromConfigStruct_Routing(t *testing.T) {
+
+	cfg := FromConfigStruct(&config.ChangelogGeneratorConfig{
+		Enabled: true,
+		Routing: &config.ChangelogRoutingConfig{
+			Enabled: true,
+			Modules: []config.ChangelogRouteConfig{
+				{Name: "api", Path: "./services/api/", Scopes: []string{"api"}},
+				{Name: "docs", Scopes: []string{"docs"}, ChangelogPath: "docs/CHANGES.md"},
+			},
+		},
+	})
+	if cfg.Routing == nil {
+		t.Fatal("Routing = nil, want config")
+	}
+	if !cfg.Routing.Aggregate || cfg.Routing.RootLabel != "General" {
+		t.Errorf("Routing defaults = %+v", cfg.Routing)
+	}
+	if api := cfg.Routing.Modules[0]; api.Path != "services/api" || api.ChangelogPath != filepath.Join("services", "api", "CHANGELOG.md") {
+		t.Errorf("Modules[0] = %+v", api)
+	}
+	if docs := cfg.Routing.Modules[1]; docs.Path != "" || docs.ChangelogPath != "docs/CHANGES.md" {
+		t.Errorf("Modules[1] = %+v", docs)
+	}
+
+	cfg = FromConfigStruct(&config.ChangelogGeneratorConfig{Enabled: true, Routing: &config.ChangelogRoutingConfig{}})
+	if cfg.Routing != nil {
+		t.Errorf("Routing = %+v, want nil when disabled", cfg.Routing)
+	}
+}
@@ -0,0 +1,217 @@
+package changeloggenerator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// forgeRequestTimeout bounds each forge API request.
+const forgeRequestTimeout = 10 * time.Second
+
+// PullRequest is the pull/merge request that introduced a commit.
+type PullRequest struct {
+	Number int      `json:"number"`
+	Title  string   `json:"title"`
+	Author string   `json:"author,omitempty"` // Forge username of the PR author
+	Labels []string `json:"labels,omitempty"`
+	URL    string   `json:"url,omitempty"`
+}
+
+// HasLabel reports whether the pull request carries the label (case-insensitive).
+func (pr *PullRequest) HasLabel(label string) bool {
+	for _, l := range pr.Labels {
+		if strings.EqualFold(l, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// numberString returns the pull request number as a string, or "" for nil.
+func (pr *PullRequest) numberString() string {
+	if pr == nil || pr.Number == 0 {
+		return ""
+	}
+	return strconv.Itoa(pr.Number)
+}
+
+// forgeClient looks up the pull request of a commit through a forge API.
+type forgeClient struct {
+	provider string
+	baseURL  string
+	project  string // owner/repo
+	token    string
+	http     *http.Client
+}
+
+// newForgeClient creates a client for the remote. GitHub, GitLab, Gitea and
+// Codeberg are supported; cfg.BaseURL overrides the API URL for self-hosted
+// instances.
+func newForgeClient(remote *RemoteInfo, cfg *PullRequestsConfig) (*forgeClient, error) {
+	provider := remote.Provider
+	if provider == "codeberg" {
+		provider = "gitea"
+	}
+
+	var baseURL, tokenEnv string
+	switch provider {
+	case "github":
+		baseURL, tokenEnv = "https://api.github.com", "GITHUB_TOKEN"
+		if remote.Host != "" && remote.Host != "github.com" {
+			baseURL = "https://" + remote.Host + "/api/v3"
+		}
+	case "gitlab":
+		baseURL, tokenEnv = "https://"+remote.Host+"/api/v4", "GITLAB_TOKEN"
+	case "gitea":
+		baseURL, tokenEnv = "https://"+remote.Host+"/api/v1", "GITEA_TOKEN"
+	default:
+		return nil, fmt.Errorf("pull request lookup is not supported for provider %q", remote.Provider)
+	}
+
+	if cfg.BaseURL != "" {
+		baseURL = cfg.BaseURL
+	}
+	if cfg.TokenEnv != "" {
+		tokenEnv = cfg.TokenEnv
+	}
+
+	return &forgeClient{
+		provider: provider,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		project:  remote.Owner + "/" + remote.Repo,
+		token:    os.Getenv(tokenEnv),
+		http:     &http.Client{Timeout: forgeRequestTimeout},
+	}, nil
+}
+
+// pullRequestForCommit returns the pull request that introduced the commit,
+// or nil if it was pushed directly.
+func (c *forgeClient) pullRequestForCommit(sha string) (*PullRequest, error) {
+	switch c.provider {
+	case "github":
+		var prs []struct {
+			Number   int     `json:"number"`
+			Title    string  `json:"title"`
+			HTMLURL  string  `json:"html_url"`
+			MergedAt *string `json:"merged_at"`
+			User     struct {
+				Login string `json:"login"`
+			} `json:"user"`
+			Labels []struct {
+				Name string `json:"name"`
+			} `json:"labels"`
+		}
+		found, err := c.get("/repos/"+c.project+"/commits/"+sha+"/pulls", &prs)
+		if err != nil || !found || len(prs) == 0 {
+			return nil, err
+		}
+		// Prefer the merged pull request when a commit belongs to several
+		best := prs[0]
+		for _, pr := range prs {
+			if pr.MergedAt != nil {
+				best = pr
+				break
+			}
+		}
+		labels := make([]string, len(best.Labels))
+		for i, l := range best.Labels {
+			labels[i] = l.Name
+		}
+		return &PullRequest{Number: best.Number, Title: best.Title, Author: best.User.Login, Labels: labels, URL: best.HTMLURL}, nil
+
+	case "gitlab":
+		var mrs []struct {
+			IID    int      `json:"iid"`
+			Title  string   `json:"title"`
+			WebURL string   `json:"web_url"`
+			State  string   `json:"state"`
+			Labels []string `json:"labels"`
+			Author struct {
+				Username string `json:"username"`
+			} `json:"author"`
+		}
+		found, err := c.get("/projects/"+url.PathEscape(c.project)+"/repository/commits/"+sha+"/merge_requests", &mrs)
+		if err != nil || !found || len(mrs) == 0 {
+			return nil, err
+		}
+		best := mrs[0]
+		for _, mr := range mrs {
+			if mr.State == "merged" {
+				best = mr
+				break
+			}
+		}
+		return &PullRequest{Number: best.IID, Title: best.Title, Author: best.Author.Username, Labels: best.Labels, URL: best.WebURL}, nil
+
+	default: // gitea
+		var pr struct {
+			Number  int    `json:"number"`
+			Title   string `json:"title"`
+			HTMLURL string `json:"html_url"`
+			User    struct {
+				Login string `json:"login"`
+			} `json:"user"`
+			Labels []struct {
+				Name string `json:"name"`
+			} `json:"labels"`
+		}
+		found, err := c.get("/repos/"+c.project+"/commits/"+sha+"/pull", &pr)
+		if err != nil || !found || pr.Number == 0 {
+			return nil, err
+		}
+		labels := make([]string, len(pr.Labels))
+		for i, l := range pr.Labels {
+			labels[i] = l.Name
+		}
+		return &PullRequest{Number: pr.Number, Title: pr.Title, Author: pr.User.Login, Labels: labels, URL: pr.HTMLURL}, nil
+	}
+}
+
+// get performs an authenticated GET and decodes the JSON response into v.
+// It returns false without error when the API answers 404.
+func (c *forgeClient) get(path string, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return false, fmt.Errorf("invalid forge API request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "sley-changelog")
+	if c.token != "" {
+		switch c.provider {
+		case "gitlab":
+			req.Header.Set("PRIVATE-TOKEN", c.token)
+		case "gitea":
+			req.Header.Set("Authorization", "token "+c.token)
+		default:
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("forge API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		return false, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		return false, fmt.Errorf("forge API returned %s for %s", resp.Status, path)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("invalid forge API response for %s: %w", path, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,155 @@
+package changeloggenerator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestNewForgeClient(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		remote      *RemoteInfo
+		cfg         *PullRequestsConfig
+		wantBaseURL string
+		wantErr     bool
+	}{
+		{"github.com", &RemoteInfo{Provider: "github", Host: "github.com"}, &PullRequestsConfig{}, "https://api.github.com", false},
+		{"github enterprise", &RemoteInfo{Provider: "github", Host: "git.corp"}, &PullRequestsConfig{}, "https://git.corp/api/v3", false},
+		{"gitlab", &RemoteInfo{Provider: "gitlab", Host: "gitlab.com"}, &PullRequestsConfig{}, "https://gitlab.com/api/v4", false},
+		{"codeberg", &RemoteInfo{Provider: "codeberg", Host: "codeberg.org"}, &PullRequestsConfig{}, "https://codeberg.org/api/v1", false},
+		{"base url override", &RemoteInfo{Provider: "gitea", Host: "gitea.io"}, &PullRequestsConfig{BaseURL: "http://localhost:3000/api/v1/"}, "http://localhost:3000/api/v1", false},
+		{"unsupported", &RemoteInfo{Provider: "bitbucket", Host: "bitbucket.org"}, &PullRequestsConfig{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := newForgeClient(tt.remote, tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if client.baseURL != tt.wantBaseURL {
+				t.Errorf("baseURL = %q, want %q", client.baseURL, tt.wantBaseURL)
+			}
+		})
+	}
+}
+
+func TestForgeClient_PullRequestForCommit(t *testing.T) {
+	t.Setenv("SLEY_TEST_FORGE_TOKEN", "secret")
+
+	tests := []struct {
+		name       string
+		provider   string
+		path       string
+		authHeader string
+		authValue  string
+		response   string
+		want       *PullRequest
+	}{
+		{
+			name:       "github prefers merged pull request",
+			provider:   "github",
+			path:       "/repos/o/r/commits/abc/pulls",
+			authHeader: "Authorization",
+			authValue:  "Bearer secret",
+			response: `[{"number": 1, "title": "draft", "merged_at": null},
+				{"number": 7, "title": "feat: login", "html_url": "https://github.com/o/r/pull/7", "merged_at": "2026-01-01T00:00:00Z",
+				 "user": {"login": "octocat"}, "labels": [{"name": "enhancement"}]}]`,
+			want: &PullRequest{Number: 7, Title: "feat: login", Author: "octocat", Labels: []string{"enhancement"}, URL: "https://github.com/o/r/pull/7"},
+		},
+		{
+			name:       "gitlab merge request",
+			provider:   "gitlab",
+			path:       "/projects/o%2Fr/repository/commits/abc/merge_requests",
+			authHeader: "PRIVATE-TOKEN",
+			authValue:  "secret",
+			response:   `[{"iid": 3, "title": "fix: crash", "state": "merged", "labels": ["bug"], "author": {"username": "tanuki"}}]`,
+			want:       &PullRequest{Number: 3, Title: "fix: crash", Author: "tanuki", Labels: []string{"bug"}},
+		},
+		{
+			name:       "gitea pull request",
+			provider:   "gitea",
+			path:       "/repos/o/r/commits/abc/pull",
+			authHeader: "Authorization",
+			authValue:  "token secret",
+			response:   `{"number": 5, "title": "docs: readme", "user": {"login": "tea"}, "labels": []}`,
+			want:       &PullRequest{Number: 5, Title: "docs: readme", Author: "tea", Labels: []string{}},
+		},
+		{
+			name:     "no pull request",
+			provider: "github",
+			path:     "/repos/o/r/commits/abc/pulls",
+			response: `[]`,
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.EscapedPath() != tt.path {
+					t.Errorf("path = %q, want %q", r.URL.EscapedPath(), tt.path)
+				}
+				if tt.authHeader != "" && r.Header.Get(tt.authHeader) != tt.authValue {
+					t.Errorf("%s = %q, want %q", tt.authHeader, r.Header.Get(tt.authHeader), tt.authValue)
+				}
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			remote := &RemoteInfo{Provider: tt.provider, Host: "example.com", Owner: "o", Repo: "r"}
+			client, err := newForgeClient(remote, &PullRequestsConfig{BaseURL: server.URL, TokenEnv: "SLEY_TEST_FORGE_TOKEN"})
+			if err != nil {
+				t.Fatalf("newForgeClient() error = %v", err)
+			}
+
+			got, err := client.pullRequestForCommit("abc")
+			if err != nil {
+				t.Fatalf("pullRequestForCommit() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pullRequestForCommit() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForgeClient_Errors(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/o/r/commits/missing/pull":
+			http.NotFound(w, r)
+		case "/repos/o/r/commits/limited/pull":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			_, _ = w.Write([]byte("not json"))
+		}
+	}))
+	defer server.Close()
+
+	remote := &RemoteInfo{Provider: "gitea", Host: "example.com", Owner: "o", Repo: "r"}
+	client, err := newForgeClient(remote, &PullRequestsConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("newForgeClient() error = %v", err)
+	}
+
+	if pr, err := client.pullRequestForCommit("missing"); err != nil || pr != nil {
+		t.Errorf("404 = (%v, %v), want (nil, nil)", pr, err)
+	}
+	if _, err := client.pullRequestForCommit("limited"); err == nil {
+		t.Error("expected error for 403 response")
+	}
+	if _, err := client.pullRequestForCommit("garbage"); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
@@ -29,7 +29,9 @@ func NewFormatter(format string, config *Config) (Formatter, error) {
 		return &GitHubFormatter{config: config}, nil
 	case "minimal":
 		return &MinimalFormatter{config: config}, nil
+	case "template":
+		return newTemplateFormatter(config)
 	default:
-		return nil, fmt.Errorf("unknown changelog format: %s (supported: grouped, keepachangelog, github, minimal)", format)
+		return nil, fmt.Errorf("unknown changelog format: %s (supported: grouped, keepachangelog, github, minimal, template)", format)
 	}
 }
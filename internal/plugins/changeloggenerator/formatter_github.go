@@ -51,6 +51,7 @@ func (f *GitHubFormatter) FormatChangelog(
 		for _, c := range breakingChanges {
 			entry := formatGitHubCommitEntry(c, remote)
 			sb.WriteString(entry)
+			sb.WriteString(formatBreakingNote(c))
 		}
 		sb.WriteString("\n")
 	}
@@ -101,6 +102,13 @@ func formatGitHubCommitEntry(c *GroupedCommit, remote *RemoteInfo) string {
 		fmt.Fprintf(&sb, " in #%s", c.PRNumber)
 	}
 
+	// Add issues referenced by Closes/Fixes/Refs footers (GitHub autolinks them)
+	for _, issue := range c.Issues {
+		if issue != c.PRNumber {
+			fmt.Fprintf(&sb, " (#%s)", issue)
+		}
+	}
+
 	sb.WriteString("\n")
 	return sb.String()
 }
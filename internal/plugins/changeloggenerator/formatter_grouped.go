@@ -48,6 +48,7 @@ func (f *GroupedFormatter) FormatChangelog(
 		for _, c := range breakingChanges {
 			entry := formatCommitEntry(c, remote)
 			sb.WriteString(entry)
+			sb.WriteString(formatBreakingNote(c))
 		}
 		sb.WriteString("\n")
 	}
@@ -135,6 +136,18 @@ func formatCommitEntry(c *GroupedCommit, remote *RemoteInfo) string {
 		}
 	}
 
+	// Add issues referenced by Closes/Fixes/Refs footers
+	for _, issue := range c.Issues {
+		if issue == c.PRNumber {
+			continue
+		}
+		if remote != nil {
+			fmt.Fprintf(&sb, " ([#%s](%s))", issue, buildIssueURL(remote, issue))
+		} else {
+			fmt.Fprintf(&sb, " (#%s)", issue)
+		}
+	}
+
 	sb.WriteString("\n")
 	return sb.String()
 }
@@ -177,3 +190,25 @@ func buildPRURL(remote *RemoteInfo, prNumber string) string {
 			remote.Host, remote.Owner, remote.Repo, prNumber)
 	}
 }
+
+// buildIssueURL generates an issue URL for the provider.
+func buildIssueURL(remote *RemoteInfo, issue string) string {
+	switch remote.Provider {
+	case "gitlab":
+		return fmt.Sprintf("https://%s/%s/%s/-/issues/%s",
+			remote.Host, remote.Owner, remote.Repo, issue)
+	case "sourcehut":
+		return fmt.Sprintf("https://todo.%s/%s/%s/%s",
+			remote.Host, remote.Owner, remote.Repo, issue)
+	default:
+		// GitHub, Gitea, Codeberg and Bitbucket share the same layout
+		return fmt.Sprintf("https://%s/%s/%s/issues/%s",
+			remote.Host, remote.Owner, remote.Repo, issue)
+	}
+}
+
+// formatBreakingNote renders the BREAKING CHANGE footer description of a
+// commit as an indented blockquote below its entry, or "" when there is none.
+func formatBreakingNote(c *GroupedCommit) string {
+	return indentLines("  > ", c.BreakingNote)
+}
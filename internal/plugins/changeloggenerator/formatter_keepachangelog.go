@@ -21,6 +21,9 @@ type KeepAChangelogFormatter struct {
 	config *Config
 }
 
+// keepAChangelogSectionOrder is the order sections are written in.
+var keepAChangelogSectionOrder = []string{"Breaking Changes", "Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"}
+
 // FormatChangelog generates the changelog in Keep a Changelog format.
 func (f *KeepAChangelogFormatter) FormatChangelog(
 	version string,
@@ -40,9 +43,7 @@ func (f *KeepAChangelogFormatter) FormatChangelog(
 	sections := f.regroupCommits(grouped, sortedKeys)
 
 	// Write sections in Keep a Changelog order
-	sectionOrder := []string{"Breaking Changes", "Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"}
-
-	for _, sectionName := range sectionOrder {
+	for _, sectionName := range keepAChangelogSectionOrder {
 		commits, exists := sections[sectionName]
 		if !exists || len(commits) == 0 {
 			continue
@@ -53,6 +54,9 @@ func (f *KeepAChangelogFormatter) FormatChangelog(
 		for _, c := range commits {
 			entry := formatCommitEntry(c, remote)
 			sb.WriteString(entry)
+			if sectionName == "Breaking Changes" {
+				sb.WriteString(formatBreakingNote(c))
+			}
 		}
 		sb.WriteString("\n")
 	}
@@ -85,13 +89,19 @@ func (f *KeepAChangelogFormatter) regroupCommits(
 
 // mapTypeToSection maps a commit type to a Keep a Changelog section.
 func (f *KeepAChangelogFormatter) mapTypeToSection(commit *GroupedCommit) string {
+	return keepAChangelogSection(commit.Type, commit.Breaking)
+}
+
+// keepAChangelogSection maps a commit type to a Keep a Changelog section,
+// returning "" for commits that do not belong in the changelog.
+func keepAChangelogSection(commitType string, breaking bool) string {
 	// Breaking changes get their own section at the top
-	if commit.Breaking {
+	if breaking {
 		return "Breaking Changes"
 	}
 
 	// Map conventional commit types to Keep a Changelog sections
-	switch commit.Type {
+	switch commitType {
 	case "feat":
 		return "Added"
 	case "fix":
@@ -106,7 +116,7 @@ func (f *KeepAChangelogFormatter) mapTypeToSection(commit *GroupedCommit) string
 		return "Removed"
 	default:
 		// For unknown types, include in Changed if they have content
-		if commit.Type != "" {
+		if commitType != "" {
 			return "Changed"
 		}
 		// Non-conventional commits are skipped unless explicitly included
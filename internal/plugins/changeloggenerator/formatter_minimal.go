@@ -88,13 +88,18 @@ func formatMinimalCommitEntry(c *GroupedCommit) string {
 // Breaking changes always return "Breaking" regardless of the original type.
 // Unknown or empty types return "Other".
 func getTypeAbbreviation(c *GroupedCommit) string {
+	return typeAbbreviation(c.Type, c.Breaking)
+}
+
+// typeAbbreviation returns the abbreviated prefix for a commit type.
+func typeAbbreviation(commitType string, breaking bool) string {
 	// Breaking changes take precedence
-	if c.Breaking {
+	if breaking {
 		return "Breaking"
 	}
 
 	// Look up the type abbreviation
-	if abbr, ok := typeAbbreviations[c.Type]; ok {
+	if abbr, ok := typeAbbreviations[commitType]; ok {
 		return abbr
 	}
 
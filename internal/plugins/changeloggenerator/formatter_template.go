@@ -0,0 +1,334 @@
+package changeloggenerator
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+)
+
+//go:embed templates
+var builtinTemplatesFS embed.FS
+
+// BuiltinTemplatePrefix selects a built-in template in the Template setting,
+// e.g. "builtin:keepachangelog".
+const BuiltinTemplatePrefix = "builtin:"
+
+// BuiltinTemplateNames lists the built-in templates, one per hardcoded format.
+var BuiltinTemplateNames = []string{"grouped", "keepachangelog", "github", "minimal"}
+
+// BuiltinTemplate returns the source of a built-in template.
+func BuiltinTemplate(name string) (string, error) {
+	data, err := builtinTemplatesFS.ReadFile("templates/" + name + ".md.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("unknown built-in template: %s (available: %s)", name, strings.Join(BuiltinTemplateNames, ", "))
+	}
+	return string(data), nil
+}
+
+// TemplateData is the data passed to changelog templates. It doubles as the
+// release model: the generator stores it as JSON per version so that other
+// output formats can be rendered from it later.
+type TemplateData struct {
+	Version         string      `json:"version"`
+	PreviousVersion string      `json:"previous_version,omitempty"`
+	Date            string      `json:"date"`                  // YYYY-MM-DD
+	CompareURL      string      `json:"compare_url,omitempty"` // Empty without a remote or previous version
+	Module          string      `json:"module,omitempty"`      // Set for module releases in multi-module workspaces
+	Remote          *RemoteInfo `json:"-"`
+
+	// Commits lists all commits in group order, breaking ones included.
+	Commits []TemplateCommit `json:"commits"`
+	// Breaking lists the breaking commits.
+	Breaking []TemplateCommit `json:"-"`
+	// Groups lists the non-empty groups in order, without breaking commits.
+	Groups []TemplateGroup `json:"-"`
+
+	// Contributors and NewContributors are empty when disabled in config.
+	Contributors    []TemplateContributor    `json:"contributors,omitempty"`
+	NewContributors []TemplateNewContributor `json:"new_contributors,omitempty"`
+
+	BreakingChangesIcon string `json:"-"`
+	ContributorsIcon    string `json:"-"`
+	NewContributorsIcon string `json:"-"`
+}
+
+// TemplateGroup is a group of commits with its label and icon.
+type TemplateGroup struct {
+	Label   string
+	Icon    string
+	Commits []TemplateCommit
+}
+
+// TemplateCommit is a commit as seen by changelog templates. URL fields are
+// empty when no repository remote is known.
+type TemplateCommit struct {
+	Type           string          `json:"type,omitempty"`
+	Scope          string          `json:"scope,omitempty"`
+	Description    string          `json:"description"`
+	Subject        string          `json:"subject"`
+	Body           string          `json:"body,omitempty"`
+	Hash           string          `json:"hash"`
+	ShortHash      string          `json:"short_hash"`
+	CommitURL      string          `json:"commit_url,omitempty"`
+	PRNumber       string          `json:"pr_number,omitempty"`
+	PRURL          string          `json:"pr_url,omitempty"`
+	Author         string          `json:"author"`
+	AuthorEmail    string          `json:"author_email,omitempty"`
+	AuthorUsername string          `json:"author_username,omitempty"` // Pull request author, or derived from the email
+	Breaking       bool            `json:"breaking,omitempty"`
+	BreakingNote   string          `json:"breaking_note,omitempty"`
+	Issues         []TemplateIssue `json:"issues,omitempty"`
+	Labels         []string        `json:"labels,omitempty"` // Pull request labels
+	Group          string          `json:"group"`
+}
+
+// TemplateIssue is an issue referenced by a commit footer.
+type TemplateIssue struct {
+	Number string `json:"number"`
+	URL    string `json:"url,omitempty"`
+}
+
+// TemplateContributor is a contributor of the release.
+type TemplateContributor struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+	Host     string `json:"host,omitempty"`
+	URL      string `json:"url,omitempty"` // Profile URL, empty without a host
+}
+
+// TemplateNewContributor is a first-time contributor of the release.
+type TemplateNewContributor struct {
+	TemplateContributor
+	PRNumber   string `json:"pr_number,omitempty"`
+	PRURL      string `json:"pr_url,omitempty"`
+	CommitHash string `json:"commit_hash,omitempty"`
+	CommitURL  string `json:"commit_url,omitempty"`
+}
+
+// templateFuncs are the helper functions available to changelog templates.
+var templateFuncs = template.FuncMap{
+	"indent":         indentLines,
+	"trimPrefix":     strings.TrimPrefix,
+	"lower":          strings.ToLower,
+	"upper":          strings.ToUpper,
+	"join":           strings.Join,
+	"abbrev":         typeAbbreviation,
+	"keepachangelog": keepAChangelogGroups,
+}
+
+// TemplateFormatter renders changelogs with a user-supplied Go text/template.
+type TemplateFormatter struct {
+	config *Config
+	tmpl   *template.Template
+}
+
+// newTemplateFormatter parses the template configured in config.Template.
+func newTemplateFormatter(config *Config) (*TemplateFormatter, error) {
+	if config.Template == "" {
+		return nil, fmt.Errorf("changelog format 'template' requires a template path")
+	}
+
+	var source string
+	if name, ok := strings.CutPrefix(config.Template, BuiltinTemplatePrefix); ok {
+		builtin, err := BuiltinTemplate(name)
+		if err != nil {
+			return nil, err
+		}
+		source = builtin
+	} else {
+		data, err := os.ReadFile(config.Template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read changelog template: %w", err)
+		}
+		source = string(data)
+	}
+
+	tmpl, err := template.New("changelog").Funcs(templateFuncs).Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid changelog template %s: %w", config.Template, err)
+	}
+	return &TemplateFormatter{config: config, tmpl: tmpl}, nil
+}
+
+// FormatChangelog renders the template without contributors. Rendering errors
+// yield empty output; the generator uses Render to report them.
+func (f *TemplateFormatter) FormatChangelog(
+	version string,
+	previousVersion string,
+	grouped map[string][]*GroupedCommit,
+	sortedKeys []string,
+	remote *RemoteInfo,
+) string {
+	content, _ := f.Render(newTemplateData(f.config, version, previousVersion, grouped, sortedKeys, remote))
+	return content
+}
+
+// Render executes the template with the given data.
+func (f *TemplateFormatter) Render(data *TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := f.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render changelog template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// newTemplateData builds the template data for the commits of a version.
+// Contributors are filled in by the generator.
+func newTemplateData(
+	config *Config,
+	version string,
+	previousVersion string,
+	grouped map[string][]*GroupedCommit,
+	sortedKeys []string,
+	remote *RemoteInfo,
+) *TemplateData {
+	data := &TemplateData{
+		Version:             version,
+		PreviousVersion:     previousVersion,
+		Date:                time.Now().Format("2006-01-02"),
+		Remote:              remote,
+		BreakingChangesIcon: config.BreakingChangesIcon,
+	}
+	if config.Contributors != nil {
+		data.ContributorsIcon = config.Contributors.Icon
+		data.NewContributorsIcon = config.Contributors.NewContributorsIcon
+	}
+	if remote != nil && previousVersion != "" {
+		data.CompareURL = buildCompareURL(remote, previousVersion, version)
+	}
+
+	for _, label := range sortedKeys {
+		group := TemplateGroup{Label: label}
+		for _, c := range grouped[label] {
+			tc := newTemplateCommit(c, remote)
+			group.Icon = c.GroupIcon
+			data.Commits = append(data.Commits, tc)
+			if c.Breaking {
+				data.Breaking = append(data.Breaking, tc)
+			} else {
+				group.Commits = append(group.Commits, tc)
+			}
+		}
+		if len(group.Commits) > 0 {
+			data.Groups = append(data.Groups, group)
+		}
+	}
+	return data
+}
+
+// newTemplateCommit converts a grouped commit for templates.
+func newTemplateCommit(c *GroupedCommit, remote *RemoteInfo) TemplateCommit {
+	tc := TemplateCommit{
+		Type:         c.Type,
+		Scope:        c.Scope,
+		Description:  c.Description,
+		Subject:      c.Subject,
+		Body:         c.Body,
+		Hash:         c.Hash,
+		ShortHash:    c.ShortHash,
+		PRNumber:     c.PRNumber,
+		Author:       c.Author,
+		AuthorEmail:  c.AuthorEmail,
+		Breaking:     c.Breaking,
+		BreakingNote: c.BreakingNote,
+		Group:        c.GroupLabel,
+	}
+	tc.AuthorUsername, _ = extractUsername(c.AuthorEmail, c.Author)
+	if pr := c.PullRequest; pr != nil {
+		tc.Labels = pr.Labels
+		if pr.Author != "" {
+			tc.AuthorUsername = pr.Author
+		}
+	}
+	if remote != nil {
+		tc.CommitURL = buildCommitURL(remote, c.ShortHash)
+		if c.PRNumber != "" {
+			tc.PRURL = buildPRURL(remote, c.PRNumber)
+		}
+	}
+	for _, issue := range c.Issues {
+		if issue == c.PRNumber {
+			continue
+		}
+		ti := TemplateIssue{Number: issue}
+		if remote != nil {
+			ti.URL = buildIssueURL(remote, issue)
+		}
+		tc.Issues = append(tc.Issues, ti)
+	}
+	return tc
+}
+
+// newTemplateContributor converts a contributor for templates.
+func newTemplateContributor(contrib Contributor, remote *RemoteInfo) TemplateContributor {
+	tc := TemplateContributor{
+		Name:     contrib.Name,
+		Username: contrib.Username,
+		Email:    contrib.Email,
+		Host:     contrib.Host,
+	}
+	if tc.Host == "" && remote != nil {
+		tc.Host = remote.Host
+	}
+	if tc.Host != "" {
+		tc.URL = "https://" + tc.Host + "/" + tc.Username
+	}
+	return tc
+}
+
+// newTemplateNewContributor converts a new contributor for templates.
+func newTemplateNewContributor(nc *NewContributor, remote *RemoteInfo) TemplateNewContributor {
+	tnc := TemplateNewContributor{
+		TemplateContributor: newTemplateContributor(nc.Contributor, remote),
+		PRNumber:            nc.PRNumber,
+		CommitHash:          nc.FirstCommit.ShortHash,
+	}
+	if remote != nil {
+		if tnc.PRNumber != "" {
+			tnc.PRURL = buildPRURL(remote, tnc.PRNumber)
+		}
+		if tnc.CommitHash != "" {
+			tnc.CommitURL = buildCommitURL(remote, tnc.CommitHash)
+		}
+	}
+	return tnc
+}
+
+// keepAChangelogGroups regroups commits into Keep a Changelog sections, in
+// the order of the keepachangelog format.
+func keepAChangelogGroups(commits []TemplateCommit) []TemplateGroup {
+	sections := make(map[string][]TemplateCommit)
+	for _, c := range commits {
+		if section := keepAChangelogSection(c.Type, c.Breaking); section != "" {
+			sections[section] = append(sections[section], c)
+		}
+	}
+
+	var groups []TemplateGroup
+	for _, name := range keepAChangelogSectionOrder {
+		if len(sections[name]) > 0 {
+			groups = append(groups, TemplateGroup{Label: name, Commits: sections[name]})
+		}
+	}
+	return groups
+}
+
+// indentLines prefixes every line of text and ends each with a newline.
+// Empty text yields an empty string.
+func indentLines(prefix, text string) string {
+	if text == "" {
+		return ""
+	}
+	var sb strings.Builder
+	for line := range strings.SplitSeq(text, "\n") {
+		sb.WriteString(prefix)
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
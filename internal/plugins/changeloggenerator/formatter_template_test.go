@@ -0,0 +1,150 @@
+package changeloggenerator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// templateTestCommits covers scopes, breaking notes, PR numbers, issues and
+// several authors.
+var templateTestCommits = []CommitInfo{
+	{Hash: "a1", ShortHash: "a1", Subject: "feat(cli)!: new flags (#10)", Author: "Alice", AuthorEmail: "alice@users.noreply.github.com",
+		Body: "BREAKING CHANGE: --old is gone\nuse --new"},
+	{Hash: "b2", ShortHash: "b2", Subject: "feat: add export", Author: "Bob", AuthorEmail: "bob@example.com", Body: "Closes #7"},
+	{Hash: "c3", ShortHash: "c3", Subject: "fix(core): handle nil", Author: "Alice", AuthorEmail: "alice@users.noreply.github.com"},
+	{Hash: "d4", ShortHash: "d4", Subject: "docs: update readme", Author: "Carol", AuthorEmail: "carol@users.noreply.github.com"},
+	{Hash: "e5", ShortHash: "e5", Subject: "perf: faster parse", Author: "Bob", AuthorEmail: "bob@example.com"},
+}
+
+// newTemplateTestGenerator returns a generator with a fixed remote and
+// contributor lookups that do not need git.
+func newTemplateTestGenerator(t *testing.T, cfg *Config) *Generator {
+	t.Helper()
+
+	gitOps := NewGitOps()
+	gitOps.GetNewContributorsFn = func(commits []CommitInfo, previousVersion string) ([]NewContributor, error) {
+		return []NewContributor{
+			{Contributor: Contributor{Name: "Carol", Username: "carol", Host: "github.com"}, FirstCommit: commits[3]},
+			{Contributor: Contributor{Name: "Alice", Username: "alice", Host: "github.com"}, FirstCommit: commits[0], PRNumber: "10"},
+		}, nil
+	}
+
+	g, err := NewGenerator(cfg, gitOps)
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	g.remote = &RemoteInfo{Provider: "github", Host: "github.com", Owner: "owner", Repo: "repo"}
+	return g
+}
+
+func TestBuiltinTemplates_MatchFormatters(t *testing.T) {
+
+	for _, name := range BuiltinTemplateNames {
+		for _, useIcons := range []bool{false, true} {
+			t.Run(name, func(t *testing.T) {
+				cfg := DefaultConfig()
+				cfg.Format = name
+				if useIcons {
+					cfg.BreakingChangesIcon = DefaultBreakingChangesIcon
+					cfg.Contributors.Icon = DefaultContributorIcon
+					cfg.Contributors.NewContributorsIcon = DefaultNewContributorsIcon
+					applyGroupIcons(cfg.Groups, nil, true)
+				}
+				want := newTemplateTestGenerator(t, cfg).GenerateVersionChangelogWithResult("v2.0.0", "v1.0.0", templateTestCommits)
+
+				cfg.Format = "template"
+				cfg.Template = BuiltinTemplatePrefix + name
+				got := newTemplateTestGenerator(t, cfg).GenerateVersionChangelogWithResult("v2.0.0", "v1.0.0", templateTestCommits)
+
+				if got.Err != nil {
+					t.Fatalf("render error: %v", got.Err)
+				}
+				if got.Content != want.Content {
+					t.Errorf("builtin:%s output differs from %s format\n--- template ---\n%s\n--- formatter ---\n%s", name, name, got.Content, want.Content)
+				}
+				if got.HasEntries != want.HasEntries {
+					t.Errorf("HasEntries = %v, want %v", got.HasEntries, want.HasEntries)
+				}
+			})
+		}
+	}
+}
+
+func TestTemplateFormatter_CustomFile(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "changelog.tmpl")
+	tmpl := `# {{.Version}} (since {{.PreviousVersion}})
+{{range .Commits}}{{.Type}}|{{.Scope}}|{{.Description}}|{{.PRNumber}}|{{.AuthorUsername}}|{{.Breaking}}{{range .Issues}}|#{{.Number}}{{end}}
+{{end}}{{range .Contributors}}@{{.Username}} {{end}}
+{{.CompareURL}}
+`
+	if err := os.WriteFile(path, []byte(tmpl), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := DefaultConfig()
+	cfg.Format = "template"
+	cfg.Template = path
+	cfg.Contributors.ShowNewContributors = false
+
+	result := newTemplateTestGenerator(t, cfg).GenerateVersionChangelogWithResult("v2.0.0", "v1.0.0", templateTestCommits)
+	if result.Err != nil {
+		t.Fatalf("render error: %v", result.Err)
+	}
+
+	for _, want := range []string{
+		"# v2.0.0 (since v1.0.0)",
+		"feat|cli|new flags|10|alice|true",
+		"feat||add export||bob|false|#7",
+		"@alice @bob @carol",
+		"https://github.com/owner/repo/compare/v1.0.0...v2.0.0",
+	} {
+		if !strings.Contains(result.Content, want) {
+			t.Errorf("expected %q in output:\n%s", want, result.Content)
+		}
+	}
+}
+
+func TestTemplateFormatter_Errors(t *testing.T) {
+
+	dir := t.TempDir()
+	broken := filepath.Join(dir, "broken.tmpl")
+	failing := filepath.Join(dir, "failing.tmpl")
+	_ = os.WriteFile(broken, []byte("{{range .Commits}"), 0o644)
+	_ = os.WriteFile(failing, []byte("{{.Missing}}"), 0o644)
+
+	for _, tmpl := range []string{"", "builtin:unknown", filepath.Join(dir, "missing.tmpl"), broken} {
+		cfg := DefaultConfig()
+		cfg.Format = "template"
+		cfg.Template = tmpl
+		if _, err := NewGenerator(cfg, NewGitOps()); err == nil {
+			t.Errorf("NewGenerator(template=%q) expected error, got nil", tmpl)
+		}
+	}
+
+	cfg := DefaultConfig()
+	cfg.Format = "template"
+	cfg.Template = failing
+	result := newTemplateTestGenerator(t, cfg).GenerateVersionChangelogWithResult("v2.0.0", "v1.0.0", templateTestCommits)
+	if result.Err == nil {
+		t.Error("expected render error for unknown field")
+	}
+}
+
+func TestBuiltinTemplate(t *testing.T) {
+
+	for _, name := range BuiltinTemplateNames {
+		src, err := BuiltinTemplate(name)
+		if err != nil {
+			t.Errorf("BuiltinTemplate(%q) error = %v", name, err)
+		}
+		if !strings.Contains(src, "sley changelog template "+name) {
+			t.Errorf("BuiltinTemplate(%q) is missing its usage comment", name)
+		}
+	}
+	if _, err := BuiltinTemplate("nope"); err == nil {
+		t.Error("expected error for unknown template")
+	}
+}
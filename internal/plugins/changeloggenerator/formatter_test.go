@@ -464,6 +464,51 @@ func TestGroupedFormatter_BreakingChangesOnly(t *testing.T) {
 	}
 }
 
+func TestGroupedFormatter_BreakingNoteAndIssues(t *testing.T) {
+
+	formatter := &GroupedFormatter{config: DefaultConfig()}
+
+	grouped := map[string][]*GroupedCommit{
+		"Enhancements": {
+			{
+				ParsedCommit: &ParsedCommit{
+					CommitInfo:   CommitInfo{Hash: "abc123", ShortHash: "abc123", Subject: "feat!: new config"},
+					Type:         "feat",
+					Description:  "new config",
+					Breaking:     true,
+					BreakingNote: "old keys are rejected\nrun sley doctor",
+					Issues:       []string{"12"},
+				},
+				GroupLabel: "Enhancements",
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		remote *RemoteInfo
+		want   string
+	}{
+		{"github", &RemoteInfo{Provider: "github", Host: "github.com", Owner: "o", Repo: "r"}, "([#12](https://github.com/o/r/issues/12))"},
+		{"gitlab", &RemoteInfo{Provider: "gitlab", Host: "gitlab.com", Owner: "o", Repo: "r"}, "([#12](https://gitlab.com/o/r/-/issues/12))"},
+		{"sourcehut", &RemoteInfo{Provider: "sourcehut", Host: "sr.ht", Owner: "~o", Repo: "r"}, "([#12](https://todo.sr.ht/~o/r/12))"},
+		{"no remote", nil, "new config (#12)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := formatter.FormatChangelog("v2.0.0", "v1.0.0", grouped, []string{"Enhancements"}, tt.remote)
+
+			if !strings.Contains(result, tt.want) {
+				t.Errorf("expected %q in output:\n%s", tt.want, result)
+			}
+			if !strings.Contains(result, "  > old keys are rejected\n  > run sley doctor\n") {
+				t.Errorf("expected breaking note in output:\n%s", result)
+			}
+		})
+	}
+}
+
 func TestGroupedFormatter_MixedBreakingAndRegular(t *testing.T) {
 
 	cfg := DefaultConfig()
@@ -6,6 +6,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -23,6 +24,16 @@ type Generator struct {
 	formatter Formatter
 	gitOps    *GitOps
 
+	// releasesDir is the changes directory at creation time. Outputs are
+	// rendered from all release data below it, even while ChangesDir is
+	// scoped to a module.
+	releasesDir string
+
+	// releaseLine is set on maintenance branches. New sections are then
+	// inserted in version order instead of at the top, so a 1.3.x patch
+	// lands below the newer 2.x entries.
+	releaseLine *semver.ReleaseLine
+
 	// Template caches with thread-safe initialization via sync.Once.
 	cachedContribTmpl    *template.Template
 	contribTmplOnce      sync.Once
@@ -38,11 +49,15 @@ func NewGenerator(config *Config, gitOps *GitOps) (*Generator, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := validateOutputs(config.Outputs); err != nil {
+		return nil, err
+	}
 
 	return &Generator{
-		config:    config,
-		formatter: formatter,
-		gitOps:    gitOps,
+		config:      config,
+		formatter:   formatter,
+		gitOps:      gitOps,
+		releasesDir: config.ChangesDir,
 	}, nil
 }
 
@@ -83,6 +98,18 @@ func (g *Generator) resolveRemote() (*RemoteInfo, error) {
 	return nil, fmt.Errorf("repository configuration not available")
 }
 
+// CompareURLFunc returns a builder for compare URLs of the configured
+// repository, or nil when the repository cannot be resolved.
+func (g *Generator) CompareURLFunc() func(prev, curr string) string {
+	remote, err := g.resolveRemote()
+	if err != nil || remote == nil {
+		return nil
+	}
+	return func(prev, curr string) string {
+		return buildCompareURL(remote, prev, curr)
+	}
+}
+
 // getDefaultHost returns the default host for a provider.
 func getDefaultHost(provider string) string {
 	switch provider {
@@ -115,17 +142,29 @@ func getProviderFromHost(host string) string {
 type GenerateResult struct {
 	Content                string
 	SkippedNonConventional []*ParsedCommit
-	HasEntries             bool // true if at least one commit group was populated
+	HasEntries             bool  // true if at least one commit group was populated
+	Err                    error // set when a changelog template fails to render
+
+	// Release is the release data of the version, set when outputs are configured.
+	Release *TemplateData
 }
 
 // GenerateVersionChangelog generates the changelog content for a version.
 func (g *Generator) GenerateVersionChangelog(version, previousVersion string, commits []CommitInfo) (string, error) {
 	result := g.GenerateVersionChangelogWithResult(version, previousVersion, commits)
-	return result.Content, nil
+	return result.Content, result.Err
 }
 
 // GenerateVersionChangelogWithResult generates the changelog content and returns detailed result.
 func (g *Generator) GenerateVersionChangelogWithResult(version, previousVersion string, commits []CommitInfo) GenerateResult {
+	// Resolve remote for links
+	remote, _ := g.resolveRemote() // Ignore error, just won't have links
+
+	// Link commits to their pull requests; failures only cost links
+	if err := g.enrichWithPullRequests(commits, remote); err != nil {
+		fmt.Fprintln(os.Stderr, printer.Warning(fmt.Sprintf("Warning: %v", err)))
+	}
+
 	// Parse and filter commits
 	parsed := ParseCommits(commits)
 	filtered := FilterCommits(parsed, g.config.ExcludePatterns)
@@ -135,19 +174,50 @@ func (g *Generator) GenerateVersionChangelogWithResult(version, previousVersion
 	grouped := groupResult.Grouped
 	sortedKeys := SortedGroupKeys(grouped)
 
-	// Resolve remote for links
-	remote, _ := g.resolveRemote() // Ignore error, just won't have links
+	// Outputs are rendered later from the release data
+	var release *TemplateData
+	if len(g.config.Outputs) > 0 {
+		release = g.buildTemplateData(version, previousVersion, commits, grouped, sortedKeys, remote)
+	}
+
+	// Templates render the whole section, contributors included
+	if tf, ok := g.formatter.(*TemplateFormatter); ok {
+		data := release
+		if data == nil {
+			data = g.buildTemplateData(version, previousVersion, commits, grouped, sortedKeys, remote)
+		}
+		content, err := tf.Render(data)
+		return GenerateResult{
+			Content:                content,
+			SkippedNonConventional: groupResult.SkippedNonConventional,
+			HasEntries:             len(grouped) > 0,
+			Err:                    err,
+			Release:                release,
+		}
+	}
 
 	// Use formatter to generate the main changelog content
 	var sb strings.Builder
 	content := g.formatter.FormatChangelog(version, previousVersion, grouped, sortedKeys, remote)
 	sb.WriteString(content)
+	g.writeFooter(&sb, version, previousVersion, commits, remote)
 
+	return GenerateResult{
+		Content:                sb.String(),
+		SkippedNonConventional: groupResult.SkippedNonConventional,
+		HasEntries:             len(grouped) > 0,
+		Release:                release,
+	}
+}
+
+// writeFooter writes the sections that follow the commit groups: new
+// contributors, the Full Changelog link and contributors.
+func (g *Generator) writeFooter(sb *strings.Builder, version, previousVersion string, commits []CommitInfo, remote *RemoteInfo) {
 	// New Contributors section (before Full Changelog link)
 	if g.config.Contributors != nil && g.config.Contributors.Enabled && g.config.Contributors.ShowNewContributors {
 		newContributors, err := g.gitOps.GetNewContributorsFn(commits, previousVersion)
 		if err == nil && len(newContributors) > 0 {
-			g.writeNewContributorsSection(&sb, newContributors, remote)
+			g.writeNewContributorsSection(sb, newContributors, remote)
 		}
 	}
 
@@ -155,7 +225,7 @@ func (g *Generator) GenerateVersionChangelogWithResult(version, previousVersion
 	if remote != nil && previousVersion != "" {
 		compareURL := buildCompareURL(remote, previousVersion, version)
 		if compareURL != "" {
-			fmt.Fprintf(&sb, "**Full Changelog:** [%s...%s](%s)\n\n", previousVersion, version, compareURL)
+			fmt.Fprintf(sb, "**Full Changelog:** [%s...%s](%s)\n\n", previousVersion, version, compareURL)
 		}
 	}
 
@@ -164,22 +234,44 @@ func (g *Generator) GenerateVersionChangelogWithResult(version, previousVersion
 		contributors := g.gitOps.GetContributorsFn(commits)
 		if len(contributors) > 0 {
 			if g.config.Contributors.Icon != "" {
-				fmt.Fprintf(&sb, "### %s Contributors\n\n", g.config.Contributors.Icon)
+				fmt.Fprintf(sb, "### %s Contributors\n\n", g.config.Contributors.Icon)
 			} else {
 				sb.WriteString("### Contributors\n\n")
 			}
 			for _, contrib := range contributors {
-				g.writeContributorEntry(&sb, contrib, remote)
+				g.writeContributorEntry(sb, contrib, remote)
 			}
 			sb.WriteString("\n")
 		}
 	}
+}
 
-	return GenerateResult{
-		Content:                sb.String(),
-		SkippedNonConventional: groupResult.SkippedNonConventional,
-		HasEntries:             len(grouped) > 0,
+// buildTemplateData builds the data for the template formatter, including
+// contributors when enabled.
+func (g *Generator) buildTemplateData(
+	version, previousVersion string,
+	commits []CommitInfo,
+	grouped map[string][]*GroupedCommit,
+	sortedKeys []string,
+	remote *RemoteInfo,
+) *TemplateData {
+	data := newTemplateData(g.config, version, previousVersion, grouped, sortedKeys, remote)
+	if g.config.Contributors == nil || !g.config.Contributors.Enabled {
+		return data
+	}
+
+	if g.config.Contributors.ShowNewContributors {
+		newContributors, err := g.gitOps.GetNewContributorsFn(commits, previousVersion)
+		if err == nil {
+			for i := range newContributors {
+				data.NewContributors = append(data.NewContributors, newTemplateNewContributor(&newContributors[i], remote))
+			}
+		}
 	}
+	for _, contrib := range g.gitOps.GetContributorsFn(commits) {
+		data.Contributors = append(data.Contributors, newTemplateContributor(contrib, remote))
+	}
+	return data
 }
 
 // contributorTemplateData holds data for contributor template rendering.
@@ -380,8 +472,15 @@ func (g *Generator) WriteVersionedFile(version, content string) error {
 
 // WriteUnifiedChangelog writes to the unified CHANGELOG.md file.
 func (g *Generator) WriteUnifiedChangelog(newContent string) error {
-	path := g.config.ChangelogPath
+	if err := g.writeChangelogFile(g.config.ChangelogPath, newContent); err != nil {
+		return err
+	}
+	return g.WriteOutputs()
+}
 
+// writeChangelogFile inserts new content into the changelog at path, creating
+// it with the default header when it does not exist.
+func (g *Generator) writeChangelogFile(path, newContent string) error {
 	var existingContent string
 
 	// Read existing content if file exists
@@ -448,6 +547,14 @@ func (g *Generator) insertAfterHeader(existing, newContent string) string {
 		return existing + "\n" + newContent
 	}
 
+	if g.releaseLine != nil {
+		insertIdx = versionOrderedIndex(lines, insertIdx, newContent)
+		if insertIdx == len(lines) {
+			// Every existing version is newer, append at the end
+			return strings.TrimRight(existing, "\n\r\t ") + "\n\n" + newContent
+		}
+	}
+
 	// Insert new content before the first version
 	before := strings.Join(lines[:insertIdx], "\n")
 	after := strings.Join(lines[insertIdx:], "\n")
@@ -458,6 +565,43 @@ func (g *Generator) insertAfterHeader(existing, newContent string) string {
 	return before + newContent + after
 }
 
+// headingVersionRe matches the version in a changelog heading such as
+// "## v1.3.5 - date", "## [1.3.5] - date" or "## api - v1.3.5 - date".
+var headingVersionRe = regexp.MustCompile(`v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?`)
+
+// headingVersion returns the version of a "## " heading line.
+func headingVersion(line string) (semver.SemVersion, bool) {
+	match := headingVersionRe.FindString(line)
+	if match == "" {
+		return semver.SemVersion{}, false
+	}
+	v, err := semver.ParseVersion(match)
+	return v, err == nil
+}
+
+// versionOrderedIndex returns the line index of the first version heading,
+// starting at from, that is older than the version of newContent. Headings
+// without a version (e.g. "## Unreleased") are skipped. Returns from when
+// newContent has no version and len(lines) when every version is newer.
+func versionOrderedIndex(lines []string, from int, newContent string) int {
+	heading, _, _ := strings.Cut(strings.TrimLeft(newContent, "\n"), "\n")
+	newVersion, ok := headingVersion(heading)
+	if !ok {
+		return from
+	}
+
+	for i := from; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, "## ") {
+			continue
+		}
+		if v, ok := headingVersion(trimmed); ok && v.Compare(newVersion) < 0 {
+			return i
+		}
+	}
+	return len(lines)
+}
+
 // collectVersionFiles returns all version files in the directory.
 // collectVersionFiles returns all version files in the directory, recursing
 // into subdirectories to find module-scoped files (e.g. .changes/mymod/v0.1.0.md).
@@ -536,23 +680,23 @@ func prefixVersionHeading(content, moduleName string) string {
 	return before + h2 + moduleName + " - " + after
 }
 
-// MergeVersionedFiles merges all versioned changelog files into a unified CHANGELOG.md.
+// MergeVersionedFiles merges all versioned changelog files into a unified
+// CHANGELOG.md, then renders the configured outputs from the release data.
 func (g *Generator) MergeVersionedFiles() error {
 	files, err := collectVersionFiles(g.config.ChangesDir)
 	if err != nil {
 		return err
 	}
-	if len(files) == 0 {
-		return nil
-	}
 
-	sortVersionFiles(files)
-	content := g.buildMergedContent(files)
+	if len(files) > 0 {
+		sortVersionFiles(files)
+		content := g.buildMergedContent(files)
 
-	if err := os.WriteFile(g.config.ChangelogPath, []byte(content), core.PermPublicRead); err != nil {
-		return fmt.Errorf("failed to write unified changelog %q: %w", g.config.ChangelogPath, err)
+		if err := os.WriteFile(g.config.ChangelogPath, []byte(content), core.PermPublicRead); err != nil {
+			return fmt.Errorf("failed to write unified changelog %q: %w", g.config.ChangelogPath, err)
+		}
 	}
-	return nil
+	return g.WriteOutputs()
 }
 
 // sortVersionFiles sorts version files by semantic version (newest first).
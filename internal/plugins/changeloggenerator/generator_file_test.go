@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/indaco/sley/internal/semver"
 )
 
 // Test helper functions to reduce cyclomatic complexity
@@ -647,6 +649,43 @@ Some description about this project.
 	}
 }
 
+func TestInsertAfterHeader_ReleaseLine(t *testing.T) {
+
+	cfg := DefaultConfig()
+	g, err := NewGenerator(cfg, NewGitOps())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g.releaseLine = &semver.ReleaseLine{Major: 1, Minor: 3, HasMinor: true}
+
+	existing := `# Changelog
+
+## Unreleased
+
+## v2.1.0 - 2026-10-01
+
+Main content
+
+## v1.3.4 - 2026-09-01
+
+Old patch
+`
+
+	result := g.insertAfterHeader(existing, "## v1.3.5 - 2026-10-18\n\nBackport\n\n")
+	v21Index := strings.Index(result, "## v2.1.0")
+	newIndex := strings.Index(result, "## v1.3.5")
+	oldIndex := strings.Index(result, "## v1.3.4")
+	if !(v21Index < newIndex && newIndex < oldIndex) {
+		t.Errorf("expected v1.3.5 between v2.1.0 and v1.3.4, got:\n%s", result)
+	}
+
+	// Older than every existing entry: appended at the end
+	result = g.insertAfterHeader(existing, "## v1.2.9 - 2026-10-18\n\nBackport\n")
+	if !strings.HasSuffix(strings.TrimSpace(result), "Backport") {
+		t.Errorf("expected v1.2.9 at the end, got:\n%s", result)
+	}
+}
+
 func TestSortVersionFiles(t *testing.T) {
 
 	tests := []struct {
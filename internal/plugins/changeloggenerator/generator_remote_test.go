@@ -160,3 +160,32 @@ func TestResolveRemote_FillProviderFromHost(t *testing.T) {
 		t.Errorf("Provider = %q, want 'github'", remote.Provider)
 	}
 }
+
+func TestCompareURLFunc(t *testing.T) {
+
+	cfg := DefaultConfig()
+	cfg.Repository = &RepositoryConfig{Provider: "gitlab", Owner: "mygroup", Repo: "myproject"}
+	g, err := NewGenerator(cfg, NewGitOps())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	compareURL := g.CompareURLFunc()
+	if compareURL == nil {
+		t.Fatal("expected compare URL builder")
+	}
+	want := "https://gitlab.com/mygroup/myproject/-/compare/v1.0.0...v1.1.0"
+	if got := compareURL("v1.0.0", "v1.1.0"); got != want {
+		t.Errorf("compareURL() = %q, want %q", got, want)
+	}
+
+	cfg = DefaultConfig()
+	cfg.Repository = nil
+	g, err = NewGenerator(cfg, NewGitOps())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.CompareURLFunc() != nil {
+		t.Error("expected nil builder without repository config")
+	}
+}
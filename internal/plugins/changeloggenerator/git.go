@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/indaco/sley/internal/git"
+	"github.com/indaco/sley/internal/semver"
 )
 
 // fieldSep is the ASCII unit separator used as a delimiter in git log format strings.
@@ -15,6 +16,10 @@ import (
 // that would occur with common characters like pipe (|).
 const fieldSep = "\x1f"
 
+// recordSep is the ASCII record separator terminating each commit in git log
+// output, since commit bodies span several lines.
+const recordSep = "\x1e"
+
 // Pre-compiled regexes for URL parsing (compiled once at package init).
 var (
 	// Remote URL formats
@@ -33,8 +38,13 @@ type CommitInfo struct {
 	Hash        string
 	ShortHash   string
 	Subject     string
+	Body        string // Message body after the subject, including footers
 	Author      string
 	AuthorEmail string
+
+	// PullRequest is the pull request that introduced the commit, when
+	// pull request lookup is enabled and one was found.
+	PullRequest *PullRequest
 }
 
 // RemoteInfo holds parsed git remote information.
@@ -69,6 +79,7 @@ type GitOps struct {
 	GetContributorsFn           func(commits []CommitInfo) []Contributor
 	GetHistoricalContributorsFn func(beforeRef string) (map[string]struct{}, error)
 	GetNewContributorsFn        func(commits []CommitInfo, previousVersion string) ([]NewContributor, error)
+	GetCommitFilesFn            func(hashes []string) (map[string][]string, error)
 
 	// ModulePath scopes git log to commits touching this directory.
 	// Empty means no path filtering (all commits).
@@ -76,6 +87,9 @@ type GitOps struct {
 	// TagPrefix scopes tag resolution to tags matching this prefix.
 	// Empty means use the latest tag globally.
 	TagPrefix string
+	// Line scopes tag resolution to a maintenance release line.
+	// Nil means tags of every line are considered.
+	Line *semver.ReleaseLine
 }
 
 // NewGitOps creates a new GitOps with default implementations.
@@ -88,6 +102,7 @@ func NewGitOps() *GitOps {
 	g.GetContributorsFn = getContributors // pure function, no exec dependency
 	g.GetHistoricalContributorsFn = g.getHistoricalContributors
 	g.GetNewContributorsFn = g.getNewContributors
+	g.GetCommitFilesFn = g.getCommitFiles
 	return g
 }
 
@@ -97,7 +112,7 @@ func GetLatestTag() (string, error) {
 }
 
 // getCommitsWithMeta retrieves commits between two refs with full metadata.
-// Format: hash|short_hash|subject|author|email
+// Format: hash|short_hash|subject|author|email|body, one record per commit
 func (g *GitOps) getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 	if until == "" {
 		until = "HEAD"
@@ -115,7 +130,7 @@ func (g *GitOps) getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 	}
 
 	revRange := since + ".." + until
-	format := "%H" + fieldSep + "%h" + fieldSep + "%s" + fieldSep + "%an" + fieldSep + "%ae"
+	format := "%H" + fieldSep + "%h" + fieldSep + "%s" + fieldSep + "%an" + fieldSep + "%ae" + fieldSep + "%b" + recordSep
 	args := []string{"log", "--pretty=format:" + format, revRange}
 	// Scope to module path if set (only commits touching this directory)
 	if g.ModulePath != "" {
@@ -135,16 +150,16 @@ func (g *GitOps) getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 		return nil, fmt.Errorf("git log failed: %w", err)
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) == 1 && lines[0] == "" {
-		return []CommitInfo{}, nil
-	}
-
-	commits := make([]CommitInfo, 0, len(lines))
-	for _, line := range lines {
-		parts := strings.SplitN(line, fieldSep, 5)
-		if len(parts) < 5 {
-			continue // Skip malformed lines
+	records := strings.Split(string(output), recordSep)
+	commits := make([]CommitInfo, 0, len(records))
+	for _, record := range records {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		parts := strings.SplitN(record, fieldSep, 6)
+		if len(parts) < 6 {
+			continue // Skip malformed records
 		}
 		commits = append(commits, CommitInfo{
 			Hash:        parts[0],
@@ -152,15 +167,55 @@ func (g *GitOps) getCommitsWithMeta(since, until string) ([]CommitInfo, error) {
 			Subject:     parts[2],
 			Author:      parts[3],
 			AuthorEmail: parts[4],
+			Body:        strings.TrimSpace(parts[5]),
 		})
 	}
 
 	return commits, nil
 }
 
+// getCommitFiles returns the files changed by each commit, keyed by commit hash.
+func (g *GitOps) getCommitFiles(hashes []string) (map[string][]string, error) {
+	files := make(map[string][]string, len(hashes))
+	if len(hashes) == 0 {
+		return files, nil
+	}
+
+	args := append([]string{"log", "--no-walk=unsorted", "--name-only", "--pretty=format:" + recordSep + "%H"}, hashes...)
+	cmd := g.ExecCommandFn("git", args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return nil, fmt.Errorf("git log failed: %s: %w", stderrMsg, err)
+		}
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+
+	for record := range strings.SplitSeq(string(output), recordSep) {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		if lines[0] == "" {
+			continue
+		}
+		for _, file := range lines[1:] {
+			if file = strings.TrimSpace(file); file != "" {
+				files[lines[0]] = append(files[lines[0]], file)
+			}
+		}
+	}
+	return files, nil
+}
+
 // getLatestTag returns the most recent git tag.
 // When TagPrefix is set, only tags matching that prefix are considered.
 func (g *GitOps) getLatestTag() (string, error) {
+	if g.Line != nil {
+		return g.getLatestTagInLine(*g.Line)
+	}
 	if g.TagPrefix != "" {
 		return g.getLatestTagWithPrefix(g.TagPrefix)
 	}
@@ -186,6 +241,33 @@ func (g *GitOps) getLatestTag() (string, error) {
 	return tag, nil
 }
 
+// getLatestTagInLine returns the newest tag of a release line, so a
+// maintenance branch resolves to its own line instead of the global newest tag.
+func (g *GitOps) getLatestTagInLine(line semver.ReleaseLine) (string, error) {
+	args := []string{"tag", "--list"}
+	if g.TagPrefix != "" {
+		args = append(args, line.TagPattern(g.TagPrefix))
+	}
+	cmd := g.ExecCommandFn("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return "", fmt.Errorf("git tag list failed: %s: %w", stderrMsg, err)
+		}
+		return "", fmt.Errorf("git tag list failed: %w", err)
+	}
+
+	tag, ok := semver.LatestInLine(strings.Split(string(out), "\n"), g.TagPrefix, line)
+	if !ok {
+		return "", fmt.Errorf("no tags found in release line %s", line)
+	}
+	return tag, nil
+}
+
 // getLatestTagWithPrefix returns the most recent tag matching the given prefix.
 // Uses git tag --list with version sorting to find the latest match.
 func (g *GitOps) getLatestTagWithPrefix(prefix string) (string, error) {
@@ -283,23 +365,41 @@ func getContributors(commits []CommitInfo) []Contributor {
 	seen := make(map[string]bool, len(commits))
 	contributors := make([]Contributor, 0, len(commits))
 
-	for _, c := range commits {
-		key := c.AuthorEmail
-		if seen[key] {
-			continue
+	add := func(name, email, login string) {
+		if seen[email] {
+			return
 		}
-		seen[key] = true
+		seen[email] = true
 
 		// Extract username from email if it follows known patterns
-		username, host := extractUsername(c.AuthorEmail, c.Author)
+		username, host := extractUsername(email, name)
+		if login != "" {
+			// The forge knows the real username; link it on the remote host
+			username, host = login, ""
+		}
 		contributors = append(contributors, Contributor{
-			Name:     c.Author,
+			Name:     name,
 			Username: username,
-			Email:    c.AuthorEmail,
+			Email:    email,
 			Host:     host,
 		})
 	}
 
+	for _, c := range commits {
+		login := ""
+		if c.PullRequest != nil {
+			login = c.PullRequest.Author
+		}
+		add(c.Author, c.AuthorEmail, login)
+
+		// Credit people listed in Co-authored-by footers
+		if c.Body != "" {
+			for _, co := range coAuthors(ParseTrailers(c.Body)) {
+				add(co.Name, co.Email, "")
+			}
+		}
+	}
+
 	return contributors
 }
 
@@ -411,8 +511,8 @@ func (g *GitOps) getNewContributors(commits []CommitInfo, previousVersion string
 		// Check if this is a new contributor (not in historical set)
 		if _, existed := historicalUsernames[username]; !existed {
 			// Extract PR number from commit subject
-			prNumber := ""
-			if matches := prNumberExtractRe.FindStringSubmatch(commit.Subject); len(matches) == 2 {
+			prNumber := commit.PullRequest.numberString()
+			if matches := prNumberExtractRe.FindStringSubmatch(commit.Subject); prNumber == "" && len(matches) == 2 {
 				prNumber = matches[1]
 			}
 
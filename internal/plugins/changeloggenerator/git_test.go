@@ -6,6 +6,8 @@ import (
 	"os/exec"
 	"strings"
 	"testing"
+
+	"github.com/indaco/sley/internal/semver"
 )
 
 var fakeGitCommands = map[string]string{}
@@ -14,7 +16,10 @@ var fakeGitCommands = map[string]string{}
 var s = fieldSep
 
 // commitLogFormat is the git log --pretty=format string used by getCommitsWithMeta.
-var commitLogFormat = "git log --pretty=format:%H" + s + "%h" + s + "%s" + s + "%an" + s + "%ae"
+var commitLogFormat = "git log --pretty=format:%H" + s + "%h" + s + "%s" + s + "%an" + s + "%ae" + s + "%b" + r
+
+// r is a shorthand alias for recordSep terminating each commit record.
+var r = recordSep
 
 func fakeExecCommand(command string, args ...string) *exec.Cmd {
 	cmdStr := command + " " + strings.Join(args, " ")
@@ -59,7 +64,7 @@ func TestGetCommitsWithMeta(t *testing.T) {
 			since: "v1.0.0",
 			until: "HEAD",
 			mockGitCommands: map[string]string{
-				commitLogFormat + " v1.0.0..HEAD": "abc123" + s + "abc123" + s + "feat: login" + s + "Alice" + s + "alice@example.com\ndef456" + s + "def456" + s + "fix: bug" + s + "Bob" + s + "bob@example.com",
+				commitLogFormat + " v1.0.0..HEAD": "abc123" + s + "abc123" + s + "feat: login" + s + "Alice" + s + "alice@example.com" + s + r + "\ndef456" + s + "def456" + s + "fix: bug" + s + "Bob" + s + "bob@example.com" + s + r,
 			},
 			expectedCount: 2,
 		},
@@ -70,7 +75,7 @@ func TestGetCommitsWithMeta(t *testing.T) {
 			mockGitCommands: map[string]string{
 				"git describe --tags --abbrev=0":   "", // no tags
 				"git rev-list --count HEAD":        "25",
-				commitLogFormat + " HEAD~10..HEAD": "abc123" + s + "abc123" + s + "feat: update" + s + "Alice" + s + "alice@example.com",
+				commitLogFormat + " HEAD~10..HEAD": "abc123" + s + "abc123" + s + "feat: update" + s + "Alice" + s + "alice@example.com" + s + r,
 			},
 			expectedCount: 1,
 		},
@@ -82,7 +87,7 @@ func TestGetCommitsWithMeta(t *testing.T) {
 				"git describe --tags --abbrev=0":    "", // no tags
 				"git rev-list --count HEAD":         "2",
 				"git rev-list --max-parents=0 HEAD": "root123",
-				commitLogFormat + " root123..HEAD":  "abc123" + s + "abc123" + s + "feat: init" + s + "Alice" + s + "alice@example.com",
+				commitLogFormat + " root123..HEAD":  "abc123" + s + "abc123" + s + "feat: init" + s + "Alice" + s + "alice@example.com" + s + r,
 			},
 			expectedCount: 1,
 		},
@@ -92,7 +97,7 @@ func TestGetCommitsWithMeta(t *testing.T) {
 			until: "HEAD",
 			mockGitCommands: map[string]string{
 				"git describe --tags --abbrev=0":  "v2.0.0",
-				commitLogFormat + " v2.0.0..HEAD": "abc123" + s + "abc123" + s + "feat: new" + s + "Alice" + s + "alice@example.com",
+				commitLogFormat + " v2.0.0..HEAD": "abc123" + s + "abc123" + s + "feat: new" + s + "Alice" + s + "alice@example.com" + s + r,
 			},
 			expectedCount: 1,
 		},
@@ -144,21 +149,21 @@ func TestGetCommitsWithMeta_PipeInSubject(t *testing.T) {
 	}{
 		{
 			name:        "pipe in subject is preserved",
-			mockOutput:  "abc123" + s + "abc1" + s + "feat: add A | B support" + s + "Alice" + s + "alice@example.com",
+			mockOutput:  "abc123" + s + "abc1" + s + "feat: add A | B support" + s + "Alice" + s + "alice@example.com" + s + r,
 			wantSubject: "feat: add A | B support",
 			wantAuthor:  "Alice",
 			wantEmail:   "alice@example.com",
 		},
 		{
 			name:        "multiple pipes in subject",
-			mockOutput:  "def456" + s + "def4" + s + "fix: handle X | Y | Z" + s + "Bob" + s + "bob@example.com",
+			mockOutput:  "def456" + s + "def4" + s + "fix: handle X | Y | Z" + s + "Bob" + s + "bob@example.com" + s + r,
 			wantSubject: "fix: handle X | Y | Z",
 			wantAuthor:  "Bob",
 			wantEmail:   "bob@example.com",
 		},
 		{
 			name:        "no pipe in subject",
-			mockOutput:  "ghi789" + s + "ghi7" + s + "feat: normal change" + s + "Charlie" + s + "charlie@example.com",
+			mockOutput:  "ghi789" + s + "ghi7" + s + "feat: normal change" + s + "Charlie" + s + "charlie@example.com" + s + r,
 			wantSubject: "feat: normal change",
 			wantAuthor:  "Charlie",
 			wantEmail:   "charlie@example.com",
@@ -194,6 +199,55 @@ func TestGetCommitsWithMeta_PipeInSubject(t *testing.T) {
 	}
 }
 
+func TestGetCommitsWithMeta_Body(t *testing.T) {
+	fakeGitCommands = map[string]string{
+		commitLogFormat + " v1.0.0..HEAD": "abc123" + s + "abc1" + s + "feat!: drop v1 API" + s + "Alice" + s + "alice@example.com" + s +
+			"Remove the deprecated endpoints.\n\nBREAKING CHANGE: the /v1 routes are gone\nCloses #42\n" + r +
+			"\ndef456" + s + "def4" + s + "fix: typo" + s + "Bob" + s + "bob@example.com" + s + r,
+	}
+
+	g := &GitOps{ExecCommandFn: fakeExecCommand}
+	commits, err := g.getCommitsWithMeta("v1.0.0", "HEAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(commits))
+	}
+
+	wantBody := "Remove the deprecated endpoints.\n\nBREAKING CHANGE: the /v1 routes are gone\nCloses #42"
+	if commits[0].Body != wantBody {
+		t.Errorf("Body = %q, want %q", commits[0].Body, wantBody)
+	}
+	if commits[1].Hash != "def456" || commits[1].Body != "" {
+		t.Errorf("second commit = %+v, want hash def456 with empty body", commits[1])
+	}
+}
+
+func TestGetLatestTag_ReleaseLine(t *testing.T) {
+	fakeGitCommands = map[string]string{
+		"git tag --list v1.3.*": "v1.3.4\nv1.3.10\nv1.3.9\n",
+	}
+
+	g := &GitOps{
+		ExecCommandFn: fakeExecCommand,
+		TagPrefix:     "v",
+		Line:          &semver.ReleaseLine{Major: 1, Minor: 3, HasMinor: true},
+	}
+	tag, err := g.getLatestTag()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tag != "v1.3.10" {
+		t.Errorf("tag = %q, want 'v1.3.10'", tag)
+	}
+
+	g.Line = &semver.ReleaseLine{Major: 0}
+	if _, err := g.getLatestTag(); err == nil {
+		t.Error("expected error for a release line without tags")
+	}
+}
+
 func TestParseRemoteURL(t *testing.T) {
 
 	tests := []struct {
@@ -627,6 +681,23 @@ func TestGetLatestTag_MockSuccess(t *testing.T) {
 	}
 }
 
+func TestGetContributors_CoAuthors(t *testing.T) {
+
+	commits := []CommitInfo{
+		{Author: "Alice", AuthorEmail: "alice@example.com", Body: "Pairing session.\n\nCo-authored-by: Bob <bob@example.com>\nCo-authored-by: Alice <alice@example.com>"},
+		{Author: "Bob", AuthorEmail: "bob@example.com"},
+	}
+
+	contributors := getContributors(commits)
+
+	if len(contributors) != 2 {
+		t.Fatalf("expected 2 unique contributors, got %d: %v", len(contributors), contributors)
+	}
+	if contributors[0].Name != "Alice" || contributors[1].Name != "Bob" {
+		t.Errorf("contributors = %v, want Alice then Bob", contributors)
+	}
+}
+
 func TestGetContributors_MockSuccess(t *testing.T) {
 
 	gitOps := NewGitOps()
@@ -992,3 +1063,27 @@ func TestGetNewContributorsFn_MockSuccess(t *testing.T) {
 		t.Errorf("Username = %q, want 'newdev'", newContribs[0].Username)
 	}
 }
+
+func TestGetCommitFiles(t *testing.T) {
+	key := "git log --no-walk=unsorted --name-only --pretty=format:" + r + "%H abc def"
+	fakeGitCommands = map[string]string{
+		key: r + "abc\nservices/api/main.go\nREADME.md\n\n" + r + "def\n",
+	}
+	g := &GitOps{ExecCommandFn: fakeExecCommand}
+
+	files, err := g.getCommitFiles([]string{"abc", "def"})
+	if err != nil {
+		t.Fatalf("getCommitFiles() error = %v", err)
+	}
+	if got := strings.Join(files["abc"], ","); got != "services/api/main.go,README.md" {
+		t.Errorf("files[abc] = %s", got)
+	}
+	if len(files["def"]) != 0 {
+		t.Errorf("files[def] = %v, want none", files["def"])
+	}
+
+	fakeGitCommands = map[string]string{key: "ERROR"}
+	if _, err := g.getCommitFiles([]string{"abc", "def"}); err == nil {
+		t.Error("expected error when git log fails")
+	}
+}
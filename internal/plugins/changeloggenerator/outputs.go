@@ -0,0 +1,260 @@
+package changeloggenerator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/semver"
+)
+
+// OutputFormats lists the formats available to additional changelog outputs.
+var OutputFormats = []string{"json", "asciidoc", "rst", "html"}
+
+// outputTemplates maps the document formats to their embedded templates.
+var outputTemplates = map[string]string{
+	"asciidoc": "changelog.adoc.tmpl",
+	"rst":      "changelog.rst.tmpl",
+	"html":     "changelog.html.tmpl",
+}
+
+// outputFuncs are the helper functions available to output templates.
+var outputFuncs = map[string]any{
+	"heading":   releaseHeading,
+	"title":     groupTitle,
+	"underline": underline,
+	"rst":       escapeRST,
+	"indent":    indentLines,
+}
+
+// releaseDocument is the data rendered into a unified output: all releases,
+// newest first.
+type releaseDocument struct {
+	Releases []*TemplateData `json:"releases"`
+}
+
+// validateOutputs checks that every output has a path and a known format.
+func validateOutputs(outputs []OutputConfig) error {
+	for _, out := range outputs {
+		if out.Path == "" {
+			return fmt.Errorf("changelog output with format %q requires a path", out.Format)
+		}
+		if !slices.Contains(OutputFormats, out.Format) {
+			return fmt.Errorf("unknown changelog output format: %s (available: %s)", out.Format, strings.Join(OutputFormats, ", "))
+		}
+	}
+	return nil
+}
+
+// WriteRelease stores the release data of a version as JSON next to the
+// versioned changelog files (e.g. .changes/v1.2.0.json). Outputs are rendered
+// from these files.
+func (g *Generator) WriteRelease(release *TemplateData) error {
+	dir := g.config.ChangesDir
+	if err := os.MkdirAll(dir, core.PermDirDefault); err != nil {
+		return fmt.Errorf("failed to create changes directory %q: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(release, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal release data: %w", err)
+	}
+
+	path := filepath.Join(dir, release.Version+".json")
+	if err := os.WriteFile(path, append(data, '\n'), core.PermPublicRead); err != nil {
+		return fmt.Errorf("failed to write release data %q: %w", path, err)
+	}
+	return nil
+}
+
+// WriteOutputs renders every configured output from the stored release data.
+func (g *Generator) WriteOutputs() error {
+	if len(g.config.Outputs) == 0 {
+		return nil
+	}
+
+	releases, err := loadReleases(g.releasesDir)
+	if err != nil {
+		return err
+	}
+	for _, release := range releases {
+		g.restoreRelease(release)
+	}
+	doc := &releaseDocument{Releases: releases}
+
+	for _, out := range g.config.Outputs {
+		content, err := renderOutput(out.Format, doc)
+		if err != nil {
+			return err
+		}
+		if dir := filepath.Dir(out.Path); dir != "." {
+			if err := os.MkdirAll(dir, core.PermDirDefault); err != nil {
+				return fmt.Errorf("failed to create output directory %q: %w", dir, err)
+			}
+		}
+		if err := os.WriteFile(out.Path, []byte(content), core.PermPublicRead); err != nil { //nolint:gosec // G703: path is an output path from plugin config
+			return fmt.Errorf("failed to write changelog output %q: %w", out.Path, err)
+		}
+	}
+	return nil
+}
+
+// loadReleases reads all release data files in dir, recursing into module
+// subdirectories, and returns them newest first. A missing directory yields
+// no releases.
+func loadReleases(dir string) ([]*TemplateData, error) {
+	var releases []*TemplateData
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasPrefix(d.Name(), "v") || !strings.HasSuffix(d.Name(), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		release := &TemplateData{}
+		if err := json.Unmarshal(data, release); err != nil {
+			return fmt.Errorf("invalid release data %s: %w", path, err)
+		}
+		if release.Module == "" {
+			release.Module = moduleNameFromPath(dir, path)
+		}
+		releases = append(releases, release)
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release data in %q: %w", dir, err)
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		vi, _ := semver.ParseVersion(releases[i].Version)
+		vj, _ := semver.ParseVersion(releases[j].Version)
+		return vi.Compare(vj) > 0
+	})
+	return releases, nil
+}
+
+// restoreRelease rebuilds the parts of stored release data that are not
+// serialized: the breaking and grouped commit lists and the configured icons.
+func (g *Generator) restoreRelease(release *TemplateData) {
+	release.BreakingChangesIcon = g.config.BreakingChangesIcon
+	if g.config.Contributors != nil {
+		release.ContributorsIcon = g.config.Contributors.Icon
+		release.NewContributorsIcon = g.config.Contributors.NewContributorsIcon
+	}
+
+	icons := make(map[string]string, len(g.config.Groups))
+	for _, group := range g.config.Groups {
+		icons[group.Label] = group.Icon
+	}
+
+	release.Breaking, release.Groups = nil, nil
+	index := make(map[string]int)
+	for _, c := range release.Commits {
+		if c.Breaking {
+			release.Breaking = append(release.Breaking, c)
+			continue
+		}
+		i, ok := index[c.Group]
+		if !ok {
+			i = len(release.Groups)
+			index[c.Group] = i
+			release.Groups = append(release.Groups, TemplateGroup{Label: c.Group, Icon: icons[c.Group]})
+		}
+		release.Groups[i].Commits = append(release.Groups[i].Commits, c)
+	}
+}
+
+// renderOutput renders the releases in the given output format.
+func renderOutput(format string, doc *releaseDocument) (string, error) {
+	if format == "json" {
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal changelog: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+
+	name, ok := outputTemplates[format]
+	if !ok {
+		return "", fmt.Errorf("unknown changelog output format: %s", format)
+	}
+	source, err := builtinTemplatesFS.ReadFile("templates/" + name)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if format == "html" {
+		tmpl, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap(outputFuncs)).Parse(string(source))
+		if err != nil {
+			return "", err
+		}
+		if err := tmpl.Execute(&buf, doc); err != nil {
+			return "", fmt.Errorf("failed to render %s changelog: %w", format, err)
+		}
+		return buf.String(), nil
+	}
+
+	tmpl, err := template.New(name).Funcs(template.FuncMap(outputFuncs)).Parse(string(source))
+	if err != nil {
+		return "", err
+	}
+	if err := tmpl.Execute(&buf, doc); err != nil {
+		return "", fmt.Errorf("failed to render %s changelog: %w", format, err)
+	}
+	return buf.String(), nil
+}
+
+// releaseHeading returns the heading of a release, e.g.
+// "mymod - v1.2.0 - 2026-01-02".
+func releaseHeading(release *TemplateData) string {
+	parts := make([]string, 0, 3)
+	if release.Module != "" {
+		parts = append(parts, release.Module)
+	}
+	parts = append(parts, release.Version)
+	if release.Date != "" {
+		parts = append(parts, release.Date)
+	}
+	return strings.Join(parts, " - ")
+}
+
+// groupTitle prefixes a section title with its icon, if any.
+func groupTitle(icon, label string) string {
+	if icon == "" {
+		return label
+	}
+	return icon + " " + label
+}
+
+// underline returns a reStructuredText section underline for the title. It
+// counts bytes so that wide characters such as icons are always covered.
+func underline(char, title string) string {
+	return strings.Repeat(char, len(title))
+}
+
+// rstEscaper escapes reStructuredText inline markup characters.
+var rstEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "`", "\\`", "_", `\_`, "|", `\|`)
+
+// escapeRST escapes inline markup in reStructuredText text.
+func escapeRST(text string) string {
+	return rstEscaper.Replace(text)
+}
@@ -0,0 +1,195 @@
+package changeloggenerator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestReleases stores two root releases and one module release from
+// the template test commits and returns the generator.
+func writeTestReleases(t *testing.T, dir string, outputs []OutputConfig) *Generator {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.ChangesDir = filepath.Join(dir, ".changes")
+	cfg.Outputs = outputs
+	g := newTemplateTestGenerator(t, cfg)
+
+	for _, rel := range []struct{ version, previous string }{{"v1.0.0", ""}, {"v2.0.0", "v1.0.0"}} {
+		result := g.GenerateVersionChangelogWithResult(rel.version, rel.previous, templateTestCommits)
+		if result.Release == nil {
+			t.Fatal("expected release data with outputs configured")
+		}
+		result.Release.Date = "2026-01-02"
+		if err := g.WriteRelease(result.Release); err != nil {
+			t.Fatalf("WriteRelease() error = %v", err)
+		}
+	}
+
+	// A module release, scoped the way multi-module workspaces do it
+	g.config.ChangesDir = filepath.Join(dir, ".changes", "api")
+	g.config.Contributors.ShowNewContributors = false
+	result := g.GenerateVersionChangelogWithResult("v0.3.0", "", templateTestCommits[3:4])
+	result.Release.Date = "2026-01-02"
+	if err := g.WriteRelease(result.Release); err != nil {
+		t.Fatalf("WriteRelease() error = %v", err)
+	}
+	return g
+}
+
+func TestGenerator_WriteOutputs(t *testing.T) {
+
+	dir := t.TempDir()
+	outputs := []OutputConfig{
+		{Path: filepath.Join(dir, "changelog.json"), Format: "json"},
+		{Path: filepath.Join(dir, "CHANGELOG.adoc"), Format: "asciidoc"},
+		{Path: filepath.Join(dir, "CHANGELOG.rst"), Format: "rst"},
+		{Path: filepath.Join(dir, "site", "changelog.html"), Format: "html"},
+	}
+	g := writeTestReleases(t, dir, outputs)
+
+	if err := g.WriteOutputs(); err != nil {
+		t.Fatalf("WriteOutputs() error = %v", err)
+	}
+
+	data, err := os.ReadFile(outputs[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc releaseDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	var versions []string
+	for _, r := range doc.Releases {
+		versions = append(versions, r.Module+"@"+r.Version)
+	}
+	if got := strings.Join(versions, ","); got != "@v2.0.0,@v1.0.0,api@v0.3.0" {
+		t.Errorf("JSON releases = %s, want newest first with module", got)
+	}
+	if c := doc.Releases[0].Commits[0]; c.BreakingNote != "--old is gone\nuse --new" || c.PRURL != "https://github.com/owner/repo/pull/10" {
+		t.Errorf("JSON commit = %+v", c)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{outputs[1].Path, []string{
+			"= Changelog",
+			"== v2.0.0 - 2026-01-02\n\n=== Breaking Changes\n\n* *cli:* new flags (link:https://github.com/owner/repo/commit/a1[a1]) (link:https://github.com/owner/repo/pull/10[#10])\n+\n____\n--old is gone\nuse --new\n____",
+			"=== Enhancements\n\n* add export (link:https://github.com/owner/repo/commit/b2[b2]) (link:https://github.com/owner/repo/issues/7[#7])",
+			"*Full Changelog:* link:https://github.com/owner/repo/compare/v1.0.0...v2.0.0[v1.0.0...v2.0.0]",
+			"== api - v0.3.0 - 2026-01-02",
+		}},
+		{outputs[2].Path, []string{
+			"Changelog\n=========",
+			"v2.0.0 - 2026-01-02\n-------------------\n\nBreaking Changes\n~~~~~~~~~~~~~~~~",
+			"- **cli:** new flags (`a1 <https://github.com/owner/repo/commit/a1>`__) (`#10 <https://github.com/owner/repo/pull/10>`__)\n\n  --old is gone\n  use --new",
+			"api - v0.3.0 - 2026-01-02\n-------------------------",
+		}},
+		{outputs[3].Path, []string{
+			"<!DOCTYPE html>",
+			`<section class="release" id="v2.0.0">`,
+			`<li><strong>cli:</strong> new flags (<a href="https://github.com/owner/repo/commit/a1">a1</a>) (<a href="https://github.com/owner/repo/pull/10">#10</a>)`,
+			`<section class="release" id="api-v0.3.0">`,
+		}},
+	}
+	for _, tt := range tests {
+		data, err := os.ReadFile(tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: expected %q in output:\n%s", filepath.Base(tt.path), want, data)
+			}
+		}
+	}
+}
+
+func TestRenderOutput_Escaping(t *testing.T) {
+
+	doc := &releaseDocument{Releases: []*TemplateData{{
+		Version: "v1.0.0",
+		Commits: []TemplateCommit{{Description: "handle <script> & *stars*_", Group: "Fixes"}},
+	}}}
+	(&Generator{config: DefaultConfig()}).restoreRelease(doc.Releases[0])
+
+	html, err := renderOutput("html", doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(html, "handle &lt;script&gt; &amp; *stars*_") {
+		t.Errorf("HTML output is not escaped:\n%s", html)
+	}
+
+	rst, err := renderOutput("rst", doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(rst, `- handle <script> & \*stars\*\_`) {
+		t.Errorf("RST output is not escaped:\n%s", rst)
+	}
+
+	if _, err := renderOutput("pdf", doc); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+func TestGenerator_MergeVersionedFiles_Outputs(t *testing.T) {
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "changelog.json")
+	g := writeTestReleases(t, dir, []OutputConfig{{Path: out, Format: "json"}})
+	g.config.ChangesDir = filepath.Join(dir, ".changes")
+	g.config.ChangelogPath = filepath.Join(dir, "CHANGELOG.md")
+
+	// No Markdown files: only the outputs are written
+	if err := g.MergeVersionedFiles(); err != nil {
+		t.Fatalf("MergeVersionedFiles() error = %v", err)
+	}
+	if _, err := os.Stat(g.config.ChangelogPath); !os.IsNotExist(err) {
+		t.Error("unified Markdown changelog should not be written without versioned files")
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("expected JSON output: %v", err)
+	}
+	if !strings.Contains(string(data), `"version": "v2.0.0"`) {
+		t.Errorf("unexpected JSON output:\n%s", data)
+	}
+}
+
+func TestNewGenerator_InvalidOutputs(t *testing.T) {
+
+	for _, outputs := range [][]OutputConfig{
+		{{Path: "CHANGELOG.txt", Format: "text"}},
+		{{Format: "json"}},
+	} {
+		cfg := DefaultConfig()
+		cfg.Outputs = outputs
+		if _, err := NewGenerator(cfg, NewGitOps()); err == nil {
+			t.Errorf("NewGenerator(outputs=%+v) expected error, got nil", outputs)
+		}
+	}
+}
+
+func TestLoadReleases(t *testing.T) {
+
+	releases, err := loadReleases(filepath.Join(t.TempDir(), "missing"))
+	if err != nil || len(releases) != 0 {
+		t.Errorf("loadReleases(missing) = (%v, %v), want empty", releases, err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "v1.0.0.json"), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadReleases(dir); err == nil {
+		t.Error("expected error for corrupt release data")
+	}
+}
@@ -14,6 +14,11 @@ type ParsedCommit struct {
 	Description string // The commit description after the colon
 	Breaking    bool   // Has breaking change indicator (! or BREAKING CHANGE footer)
 	PRNumber    string // Extracted PR/MR number if present
+
+	BreakingNote string         // Description from the BREAKING CHANGE footer
+	Issues       []string       // Issue numbers referenced by Closes/Fixes/Refs footers
+	CoAuthors    []CommitAuthor // People credited in Co-authored-by footers
+	Trailers     []Trailer      // All footers of the commit body
 }
 
 // Regex patterns for conventional commit parsing.
@@ -57,15 +62,26 @@ func getCompiledRegex(pattern string) (*regexp.Regexp, error) {
 
 // ParseConventionalCommit parses a commit message into its components.
 // Returns nil if the commit doesn't follow conventional commit format.
+//
+// When the commit has a pull request whose title is a conventional commit,
+// the title is used instead of the subject, since squash merges often keep
+// an unhelpful subject while the title is what reviewers agreed on.
 func ParseConventionalCommit(commit *CommitInfo) *ParsedCommit {
 	matches := conventionalCommitRe.FindStringSubmatch(commit.Subject)
+	if pr := commit.PullRequest; pr != nil {
+		if prMatches := conventionalCommitRe.FindStringSubmatch(pr.Title); prMatches != nil {
+			matches = prMatches
+		}
+	}
 	if matches == nil {
 		// Not a conventional commit, return with just the subject as description
-		return &ParsedCommit{
+		parsed := &ParsedCommit{
 			CommitInfo:  *commit,
 			Type:        "",
 			Description: commit.Subject,
 		}
+		parsed.applyTrailers()
+		return parsed
 	}
 
 	parsed := &ParsedCommit{
@@ -75,6 +91,7 @@ func ParseConventionalCommit(commit *CommitInfo) *ParsedCommit {
 		Breaking:    matches[3] == "!",
 		Description: matches[4],
 	}
+	parsed.applyTrailers()
 
 	// Extract PR number from description and remove it from the description text
 	if prMatches := prNumberRe.FindStringSubmatch(parsed.Description); len(prMatches) == 2 {
@@ -82,10 +99,32 @@ func ParseConventionalCommit(commit *CommitInfo) *ParsedCommit {
 		// Remove the PR reference from description to avoid duplication in output
 		parsed.Description = strings.TrimSpace(prNumberRe.ReplaceAllString(parsed.Description, ""))
 	}
+	if n := commit.PullRequest.numberString(); n != "" {
+		parsed.PRNumber = n
+	}
 
 	return parsed
 }
 
+// applyTrailers fills in the fields derived from the commit body footers.
+// A BREAKING CHANGE footer marks the commit as breaking.
+func (p *ParsedCommit) applyTrailers() {
+	if p.Body == "" {
+		return
+	}
+	p.Trailers = ParseTrailers(p.Body)
+	for _, t := range p.Trailers {
+		if isBreakingTrailer(t.Key) {
+			p.Breaking = true
+			if p.BreakingNote == "" {
+				p.BreakingNote = t.Value
+			}
+		}
+	}
+	p.Issues = issueNumbers(p.Trailers)
+	p.CoAuthors = coAuthors(p.Trailers)
+}
+
 // ParseCommits parses a slice of CommitInfo into ParsedCommits.
 func ParseCommits(commits []CommitInfo) []*ParsedCommit {
 	parsed := make([]*ParsedCommit, 0, len(commits))
@@ -182,7 +221,25 @@ func matchCommitToGroup(commit *ParsedCommit, groups []compiledGroup) *GroupedCo
 	if matchTarget == "" {
 		matchTarget = commit.Subject
 	}
+	// Pull request labels take precedence over the commit type
+	if pr := commit.PullRequest; pr != nil {
+		for _, group := range groups {
+			for _, label := range group.Labels {
+				if pr.HasLabel(label) {
+					return &GroupedCommit{
+						ParsedCommit: commit,
+						GroupLabel:   group.Label,
+						GroupIcon:    group.Icon,
+						GroupOrder:   group.order,
+					}
+				}
+			}
+		}
+	}
 	for _, group := range groups {
+		if group.Pattern == "" {
+			continue // label-only group
+		}
 		if group.re.MatchString(matchTarget) {
 			return &GroupedCommit{
 				ParsedCommit: commit,
@@ -359,6 +359,38 @@ func TestGroupCommits_NonConventionalCommit(t *testing.T) {
 	}
 }
 
+func TestParseConventionalCommit_Body(t *testing.T) {
+
+	commit := &CommitInfo{
+		Subject: "feat(config): rename output key (#40)",
+		Body: "The key was confusing.\n\n" +
+			"BREAKING CHANGE: `output` is now `changelog-path`\n" +
+			"Closes #12\n" +
+			"Co-authored-by: Jane Doe <jane@example.com>",
+	}
+
+	parsed := ParseConventionalCommit(commit)
+
+	if !parsed.Breaking {
+		t.Error("expected BREAKING CHANGE footer to mark the commit as breaking")
+	}
+	if parsed.BreakingNote != "`output` is now `changelog-path`" {
+		t.Errorf("BreakingNote = %q", parsed.BreakingNote)
+	}
+	if len(parsed.Issues) != 1 || parsed.Issues[0] != "12" {
+		t.Errorf("Issues = %v, want [12]", parsed.Issues)
+	}
+	if len(parsed.CoAuthors) != 1 || parsed.CoAuthors[0].Email != "jane@example.com" {
+		t.Errorf("CoAuthors = %v, want Jane Doe", parsed.CoAuthors)
+	}
+	if parsed.PRNumber != "40" {
+		t.Errorf("PRNumber = %q, want '40'", parsed.PRNumber)
+	}
+	if len(parsed.Trailers) != 3 {
+		t.Errorf("Trailers = %v, want 3 entries", parsed.Trailers)
+	}
+}
+
 func TestParsedCommit_Fields(t *testing.T) {
 
 	pc := ParsedCommit{
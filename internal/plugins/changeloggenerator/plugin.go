@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/tui"
 )
 
@@ -118,6 +119,13 @@ func (p *ChangelogGeneratorPlugin) SetTagPrefix(prefix string) {
 	p.gitOps.TagPrefix = prefix
 }
 
+// SetReleaseLine restricts tag lookups to a maintenance release line and
+// places new sections in version order. Pass nil to clear it.
+func (p *ChangelogGeneratorPlugin) SetReleaseLine(line *semver.ReleaseLine) {
+	p.gitOps.Line = line
+	p.generator.releaseLine = line
+}
+
 // GenerateForVersion generates changelog for a version bump.
 func (p *ChangelogGeneratorPlugin) GenerateForVersion(version, previousVersion, bumpType string) error {
 	if !p.config.Enabled {
@@ -136,6 +144,9 @@ func (p *ChangelogGeneratorPlugin) GenerateForVersion(version, previousVersion,
 
 	// Generate changelog content with result
 	result := p.generator.GenerateVersionChangelogWithResult(version, previousVersion, commits)
+	if result.Err != nil {
+		return result.Err
+	}
 
 	// Print warning about skipped non-conventional commits
 	if len(result.SkippedNonConventional) > 0 {
@@ -152,10 +163,44 @@ func (p *ChangelogGeneratorPlugin) GenerateForVersion(version, previousVersion,
 		return nil
 	}
 
+	// Store the release data that outputs are rendered from
+	if result.Release != nil {
+		result.Release.Module = p.moduleName
+		if err := p.generator.WriteRelease(result.Release); err != nil {
+			return err
+		}
+	}
+
+	// Split the changelog per module in coordinated workspaces
+	if p.config.Routing != nil && p.moduleName == "" && p.gitOps.ModulePath == "" {
+		return p.writeRoutedChangelog(version, previousVersion, commits)
+	}
+
 	// Write based on mode
 	return p.writeChangelog(version, result.Content)
 }
 
+// writeRoutedChangelog writes each module's changelog, then the aggregated
+// root changelog based on the configured mode when aggregation is enabled.
+func (p *ChangelogGeneratorPlugin) writeRoutedChangelog(version, previousVersion string, commits []CommitInfo) error {
+	routed, err := p.generator.GenerateRoutedChangelog(version, previousVersion, commits)
+	if err != nil {
+		return err
+	}
+
+	for _, module := range routed.Modules {
+		if err := p.generator.writeChangelogFile(module.ChangelogPath, module.Content); err != nil {
+			return fmt.Errorf("module %s: %w", module.Name, err)
+		}
+		printer.PrintFaint(fmt.Sprintf("Updated changelog: %s", printer.Info(module.ChangelogPath)))
+	}
+
+	if !p.config.Routing.Aggregate || routed.Aggregate == "" {
+		return nil
+	}
+	return p.writeChangelog(version, routed.Aggregate)
+}
+
 // writeChangelog writes the changelog based on configured mode.
 func (p *ChangelogGeneratorPlugin) writeChangelog(version, content string) error {
 	mode := p.config.Mode
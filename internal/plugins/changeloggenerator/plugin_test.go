@@ -249,6 +249,45 @@ func TestGenerateForVersion_Enabled_UnifiedMode(t *testing.T) {
 	}
 }
 
+func TestGenerateForVersion_Outputs(t *testing.T) {
+
+	tmpDir := t.TempDir()
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.Mode = "unified"
+	cfg.ChangesDir = filepath.Join(tmpDir, ".changes")
+	cfg.ChangelogPath = filepath.Join(tmpDir, "CHANGELOG.md")
+	cfg.Contributors.Enabled = false
+	cfg.Outputs = []OutputConfig{{Path: filepath.Join(tmpDir, "CHANGELOG.adoc"), Format: "asciidoc"}}
+	plugin, err := NewChangelogGenerator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plugin.SetModuleName("api")
+
+	plugin.gitOps.GetCommitsWithMetaFn = func(since, until string) ([]CommitInfo, error) {
+		return []CommitInfo{
+			{Hash: "def456", ShortHash: "def456", Subject: "fix: test fix", Author: "Test", AuthorEmail: "test@example.com"},
+		}, nil
+	}
+
+	if err := plugin.GenerateForVersion("v1.0.0", "v0.9.0", "patch"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(cfg.ChangesDir, "v1.0.0.json")); err != nil {
+		t.Errorf("expected release data file: %v", err)
+	}
+	data, err := os.ReadFile(cfg.Outputs[0].Path)
+	if err != nil {
+		t.Fatalf("expected AsciiDoc output: %v", err)
+	}
+	if !strings.Contains(string(data), "== api - v1.0.0 - ") || !strings.Contains(string(data), "* test fix") {
+		t.Errorf("unexpected AsciiDoc output:\n%s", data)
+	}
+}
+
 func TestGenerateForVersion_Enabled_BothMode(t *testing.T) {
 
 	tmpDir := t.TempDir()
@@ -0,0 +1,110 @@
+package changeloggenerator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// pullRequestCache maps commit hashes to their pull request. A nil entry
+// records that the commit has no pull request, so it is not looked up again.
+type pullRequestCache map[string]*PullRequest
+
+// loadPullRequestCache reads the cache file. A missing file yields an empty cache.
+func loadPullRequestCache(path string) (pullRequestCache, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return pullRequestCache{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pull request cache: %w", err)
+	}
+
+	cache := pullRequestCache{}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("invalid pull request cache %s: %w", path, err)
+	}
+	return cache, nil
+}
+
+// save writes the cache file, creating its directory if needed.
+func (c pullRequestCache) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal pull request cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write pull request cache: %w", err)
+	}
+	return nil
+}
+
+// attachPullRequests sets the PullRequest of each commit, using the cache
+// first and the lookup function for the rest. After the first failed lookup
+// (e.g. no network) only cached data is used. Lookups are skipped entirely
+// when offline is set. It returns the first lookup error, if any, so callers
+// can warn that some entries were not linked.
+func attachPullRequests(commits []CommitInfo, cache pullRequestCache, offline bool, lookup func(sha string) (*PullRequest, error)) (changed bool, lookupErr error) {
+	for i := range commits {
+		if pr, ok := cache[commits[i].Hash]; ok {
+			commits[i].PullRequest = pr
+			continue
+		}
+		if offline || lookupErr != nil {
+			continue
+		}
+
+		pr, err := lookup(commits[i].Hash)
+		if err != nil {
+			lookupErr = err
+			continue
+		}
+		cache[commits[i].Hash] = pr
+		commits[i].PullRequest = pr
+		changed = true
+	}
+	return changed, lookupErr
+}
+
+// enrichWithPullRequests links commits to their pull requests according to
+// the PullRequests config. Errors are not fatal: commits that cannot be
+// resolved are left as they are and the error is returned for reporting.
+func (g *Generator) enrichWithPullRequests(commits []CommitInfo, remote *RemoteInfo) error {
+	cfg := g.config.PullRequests
+	if cfg == nil || len(commits) == 0 {
+		return nil
+	}
+
+	cache, err := loadPullRequestCache(cfg.CachePath)
+	if err != nil {
+		return err
+	}
+
+	lookup := func(string) (*PullRequest, error) {
+		return nil, fmt.Errorf("repository remote not available")
+	}
+	if remote != nil {
+		client, err := newForgeClient(remote, cfg)
+		if err != nil {
+			return err
+		}
+		lookup = client.pullRequestForCommit
+	}
+
+	changed, lookupErr := attachPullRequests(commits, cache, cfg.Offline, lookup)
+	if changed {
+		if err := cache.save(cfg.CachePath); err != nil {
+			return err
+		}
+	}
+	if lookupErr != nil {
+		return fmt.Errorf("pull request lookup failed, using cached data only: %w", lookupErr)
+	}
+	return nil
+}
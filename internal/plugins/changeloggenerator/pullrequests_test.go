@@ -0,0 +1,140 @@
+package changeloggenerator
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAttachPullRequests(t *testing.T) {
+
+	cache := pullRequestCache{
+		"cached": {Number: 1, Title: "feat: cached"},
+		"direct": nil,
+	}
+	commits := []CommitInfo{{Hash: "cached"}, {Hash: "direct"}, {Hash: "new"}, {Hash: "later"}}
+
+	calls := 0
+	lookup := func(sha string) (*PullRequest, error) {
+		calls++
+		if sha == "new" {
+			return &PullRequest{Number: 2}, nil
+		}
+		return nil, errors.New("network down")
+	}
+
+	changed, err := attachPullRequests(commits, cache, false, lookup)
+	if !changed {
+		t.Error("expected cache to change")
+	}
+	if err == nil || !strings.Contains(err.Error(), "network down") {
+		t.Errorf("err = %v, want network error", err)
+	}
+	if calls != 2 {
+		t.Errorf("lookup called %d times, want 2 (cache hits skipped)", calls)
+	}
+	if commits[0].PullRequest.Number != 1 || commits[1].PullRequest != nil || commits[2].PullRequest.Number != 2 {
+		t.Errorf("unexpected pull requests: %+v", commits)
+	}
+	if _, ok := cache["later"]; ok {
+		t.Error("failed lookups must not be cached")
+	}
+}
+
+func TestAttachPullRequests_Offline(t *testing.T) {
+
+	commits := []CommitInfo{{Hash: "abc"}}
+	changed, err := attachPullRequests(commits, pullRequestCache{}, true, func(string) (*PullRequest, error) {
+		t.Fatal("lookup must not be called offline")
+		return nil, nil
+	})
+	if changed || err != nil {
+		t.Errorf("attachPullRequests() = (%v, %v), want (false, nil)", changed, err)
+	}
+}
+
+func TestPullRequestCache_RoundTrip(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "cache", "pull-requests.json")
+
+	cache, err := loadPullRequestCache(path)
+	if err != nil || len(cache) != 0 {
+		t.Fatalf("loadPullRequestCache(missing) = (%v, %v), want empty", cache, err)
+	}
+
+	cache["abc"] = &PullRequest{Number: 4, Title: "fix: x", Labels: []string{"bug"}}
+	cache["def"] = nil
+	if err := cache.save(path); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+
+	loaded, err := loadPullRequestCache(path)
+	if err != nil {
+		t.Fatalf("loadPullRequestCache() error = %v", err)
+	}
+	if loaded["abc"].Number != 4 || loaded["abc"].Labels[0] != "bug" {
+		t.Errorf("loaded[abc] = %+v", loaded["abc"])
+	}
+	if pr, ok := loaded["def"]; !ok || pr != nil {
+		t.Errorf("loaded[def] = (%v, %v), want cached nil entry", pr, ok)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadPullRequestCache(path); err == nil {
+		t.Error("expected error for corrupt cache")
+	}
+}
+
+func TestGenerator_PullRequestEnrichment(t *testing.T) {
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`[{"number": 12, "title": "feat(auth): add login", "merged_at": "2026-01-01T00:00:00Z",
+			"user": {"login": "octocat"}, "labels": [{"name": "security"}]}]`))
+	}))
+
+	cachePath := filepath.Join(t.TempDir(), "pull-requests.json")
+	cfg := DefaultConfig()
+	cfg.Repository = &RepositoryConfig{Provider: "github", Owner: "o", Repo: "r"}
+	cfg.Groups = append([]GroupConfig{{Label: "Security", Labels: []string{"security"}}}, cfg.Groups...)
+	cfg.Contributors.ShowNewContributors = false
+	cfg.PullRequests = &PullRequestsConfig{BaseURL: server.URL, CachePath: cachePath}
+
+	commits := []CommitInfo{{Hash: "abc123", ShortHash: "abc123", Subject: "Squashed changes", Author: "Octo Cat", AuthorEmail: "octo@example.com"}}
+
+	gen, err := NewGenerator(cfg, NewGitOps())
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := gen.GenerateVersionChangelogWithResult("v1.1.0", "v1.0.0", commits)
+
+	for _, want := range []string{
+		"### Security",
+		"**auth:** add login",
+		"([#12](https://github.com/o/r/pull/12))",
+		"(https://github.com/octocat)",
+	} {
+		if !strings.Contains(result.Content, want) {
+			t.Errorf("expected %q in output:\n%s", want, result.Content)
+		}
+	}
+
+	// A second run works from the cache with the forge unreachable
+	server.Close()
+	commits[0].PullRequest = nil
+	gen, _ = NewGenerator(cfg, NewGitOps())
+	result = gen.GenerateVersionChangelogWithResult("v1.1.0", "v1.0.0", commits)
+	if !strings.Contains(result.Content, "([#12](https://github.com/o/r/pull/12))") {
+		t.Errorf("expected cached PR link in offline output:\n%s", result.Content)
+	}
+	if requests != 1 {
+		t.Errorf("forge requests = %d, want 1", requests)
+	}
+}
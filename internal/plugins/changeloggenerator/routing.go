@@ -0,0 +1,217 @@
+package changeloggenerator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// RoutedChangelog is the changelog of a version split per module.
+type RoutedChangelog struct {
+	// Modules lists the module changelogs with entries, in config order.
+	Modules []ModuleChangelog
+	// Aggregate is the root changelog section, with a subsection per module.
+	Aggregate string
+}
+
+// ModuleChangelog is the changelog section of a module.
+type ModuleChangelog struct {
+	Name          string
+	ChangelogPath string
+	Content       string
+}
+
+// routedCommits holds the commits routed to a module.
+type routedCommits struct {
+	route   RouteConfig
+	commits []CommitInfo
+}
+
+// routeCommits assigns each commit to every module whose directory it touched
+// or whose scopes include the commit scope. Commits matching no module are
+// returned separately.
+func routeCommits(commits []CommitInfo, routes []RouteConfig, files map[string][]string) ([]routedCommits, []CommitInfo) {
+	routed := make([]routedCommits, len(routes))
+	for i, route := range routes {
+		routed[i].route = route
+	}
+
+	var unrouted []CommitInfo
+	for _, c := range commits {
+		scopes := commitScopes(&c)
+		matched := false
+		for i, route := range routes {
+			if routeMatches(route, files[c.Hash], scopes) {
+				routed[i].commits = append(routed[i].commits, c)
+				matched = true
+			}
+		}
+		if !matched {
+			unrouted = append(unrouted, c)
+		}
+	}
+	return routed, unrouted
+}
+
+// commitScopes returns the conventional commit scopes of a commit, splitting
+// multi-scope commits such as "feat(api,web): ...".
+func commitScopes(c *CommitInfo) []string {
+	parsed := ParseConventionalCommit(c)
+	if parsed.Scope == "" {
+		return nil
+	}
+	var scopes []string
+	for scope := range strings.SplitSeq(parsed.Scope, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// routeMatches reports whether a commit touching files with the given scopes
+// belongs to the route.
+func routeMatches(route RouteConfig, files, scopes []string) bool {
+	for _, scope := range scopes {
+		if slices.Contains(route.Scopes, scope) {
+			return true
+		}
+	}
+	if route.Path == "" || route.Path == "." {
+		return false
+	}
+	for _, file := range files {
+		if file == route.Path || strings.HasPrefix(file, route.Path+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRoutedChangelog routes the commits of a version to the configured
+// modules and generates each module's changelog section plus the aggregated
+// root section.
+func (g *Generator) GenerateRoutedChangelog(version, previousVersion string, commits []CommitInfo) (*RoutedChangelog, error) {
+	routing := g.config.Routing
+	if routing == nil {
+		return nil, fmt.Errorf("changelog routing is not configured")
+	}
+
+	var files map[string][]string
+	if slices.ContainsFunc(routing.Modules, func(r RouteConfig) bool { return r.Path != "" }) {
+		hashes := make([]string, len(commits))
+		for i, c := range commits {
+			hashes[i] = c.Hash
+		}
+		var err error
+		if files, err = g.gitOps.GetCommitFilesFn(hashes); err != nil {
+			return nil, fmt.Errorf("failed to list changed files: %w", err)
+		}
+	}
+
+	routed, unrouted := routeCommits(commits, routing.Modules, files)
+	result := &RoutedChangelog{}
+
+	type section struct {
+		label string
+		body  string
+	}
+	var sections []section
+	heading := ""
+
+	for _, rc := range routed {
+		if len(rc.commits) == 0 {
+			continue
+		}
+		moduleResult := g.GenerateVersionChangelogWithResult(version, previousVersion, rc.commits)
+		if moduleResult.Err != nil {
+			return nil, moduleResult.Err
+		}
+		if !moduleResult.HasEntries {
+			continue
+		}
+		result.Modules = append(result.Modules, ModuleChangelog{
+			Name:          rc.route.Name,
+			ChangelogPath: rc.route.ChangelogPath,
+			Content:       moduleResult.Content,
+		})
+
+		h, body, err := g.formatSection(version, previousVersion, rc.commits)
+		if err != nil {
+			return nil, err
+		}
+		if body != "" {
+			heading = h
+			sections = append(sections, section{label: rc.route.Name, body: body})
+		}
+	}
+
+	if len(unrouted) > 0 {
+		h, body, err := g.formatSection(version, previousVersion, unrouted)
+		if err != nil {
+			return nil, err
+		}
+		if body != "" {
+			heading = h
+			sections = append(sections, section{label: routing.RootLabel, body: body})
+		}
+	}
+
+	if len(sections) == 0 {
+		return result, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(heading)
+	sb.WriteString("\n\n")
+	for _, sec := range sections {
+		fmt.Fprintf(&sb, "### %s\n\n%s\n\n", sec.label, demoteHeadings(sec.body))
+	}
+	remote, _ := g.resolveRemote()
+	g.writeFooter(&sb, version, previousVersion, commits, remote)
+	result.Aggregate = sb.String()
+
+	return result, nil
+}
+
+// formatSection renders the commit groups of commits without the footer,
+// split into the version heading line and the body. The body is empty when
+// no commit made it into a group.
+func (g *Generator) formatSection(version, previousVersion string, commits []CommitInfo) (heading, body string, err error) {
+	remote, _ := g.resolveRemote()
+	parsed := FilterCommits(ParseCommits(commits), g.config.ExcludePatterns)
+	grouped := GroupCommitsWithOptions(parsed, g.config.Groups, g.config.IncludeNonConventional).Grouped
+	if len(grouped) == 0 {
+		return "", "", nil
+	}
+	sortedKeys := SortedGroupKeys(grouped)
+
+	var content string
+	if tf, ok := g.formatter.(*TemplateFormatter); ok {
+		// The aggregated section carries a single compare link in its footer
+		data := newTemplateData(g.config, version, previousVersion, grouped, sortedKeys, remote)
+		data.CompareURL = ""
+		if content, err = tf.Render(data); err != nil {
+			return "", "", err
+		}
+	} else {
+		content = g.formatter.FormatChangelog(version, previousVersion, grouped, sortedKeys, remote)
+	}
+
+	content = strings.TrimSpace(content)
+	if first, rest, _ := strings.Cut(content, "\n"); strings.HasPrefix(first, "## ") {
+		return first, strings.TrimSpace(rest), nil
+	}
+	return "## " + version, content, nil
+}
+
+// demoteHeadings moves every Markdown heading in content one level down.
+func demoteHeadings(content string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			lines[i] = "#" + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,191 @@
+package changeloggenerator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var routingTestRoutes = []RouteConfig{
+	{Name: "api", Path: "services/api", Scopes: []string{"api"}, ChangelogPath: "services/api/CHANGELOG.md"},
+	{Name: "web", Path: "web", Scopes: []string{"ui"}, ChangelogPath: "web/CHANGELOG.md"},
+}
+
+var routingTestCommits = []CommitInfo{
+	{Hash: "a1", ShortHash: "a1", Subject: "feat: add endpoint", Author: "Alice", AuthorEmail: "alice@example.com"},
+	{Hash: "b2", ShortHash: "b2", Subject: "fix(ui): align button", Author: "Bob", AuthorEmail: "bob@example.com"},
+	{Hash: "c3", ShortHash: "c3", Subject: "feat(api,ui): shared login", Author: "Alice", AuthorEmail: "alice@example.com"},
+	{Hash: "d4", ShortHash: "d4", Subject: "chore: bump tooling", Author: "Carol", AuthorEmail: "carol@example.com"},
+	{Hash: "e5", ShortHash: "e5", Subject: "docs: web readme", Author: "Bob", AuthorEmail: "bob@example.com"},
+}
+
+var routingTestFiles = map[string][]string{
+	"a1": {"services/api/handler.go"},
+	"b2": {"web/button.css"},
+	"c3": {"go.mod"},
+	"d4": {"Makefile", "services/apix/main.go"},
+	"e5": {"web"},
+}
+
+func hashes(commits []CommitInfo) string {
+	var out []string
+	for _, c := range commits {
+		out = append(out, c.Hash)
+	}
+	return strings.Join(out, ",")
+}
+
+func TestRouteCommits(t *testing.T) {
+
+	routed, unrouted := routeCommits(routingTestCommits, routingTestRoutes, routingTestFiles)
+
+	if got := hashes(routed[0].commits); got != "a1,c3" {
+		t.Errorf("api commits = %s, want a1,c3", got)
+	}
+	if got := hashes(routed[1].commits); got != "b2,c3,e5" {
+		t.Errorf("web commits = %s, want b2,c3,e5", got)
+	}
+	if got := hashes(unrouted); got != "d4" {
+		t.Errorf("unrouted commits = %s, want d4 (sibling directory prefix must not match)", got)
+	}
+}
+
+func newRoutingTestGenerator(t *testing.T) *Generator {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.Contributors.ShowNewContributors = false
+	cfg.Routing = &RoutingConfig{Modules: routingTestRoutes, Aggregate: true, RootLabel: "General"}
+	g := newTemplateTestGenerator(t, cfg)
+	g.gitOps.GetCommitFilesFn = func(hashes []string) (map[string][]string, error) {
+		return routingTestFiles, nil
+	}
+	return g
+}
+
+func TestGenerator_GenerateRoutedChangelog(t *testing.T) {
+
+	g := newRoutingTestGenerator(t)
+	routed, err := g.GenerateRoutedChangelog("v2.0.0", "v1.0.0", routingTestCommits)
+	if err != nil {
+		t.Fatalf("GenerateRoutedChangelog() error = %v", err)
+	}
+
+	if len(routed.Modules) != 2 || routed.Modules[0].Name != "api" || routed.Modules[1].Name != "web" {
+		t.Fatalf("Modules = %+v, want api and web", routed.Modules)
+	}
+	api := routed.Modules[0].Content
+	if !strings.Contains(api, "add endpoint") || !strings.Contains(api, "shared login") || strings.Contains(api, "align button") {
+		t.Errorf("unexpected api changelog:\n%s", api)
+	}
+	if !strings.Contains(api, "**Full Changelog:**") || !strings.Contains(api, "@alice") || strings.Contains(api, "@bob") {
+		t.Errorf("api changelog should have its own footer and contributors:\n%s", api)
+	}
+
+	agg := routed.Aggregate
+	if !strings.HasPrefix(agg, "## v2.0.0 - ") {
+		t.Errorf("aggregate should start with the version heading:\n%s", agg)
+	}
+	for _, want := range []string{
+		"### api\n\n#### Enhancements\n\n- add endpoint",
+		"### web\n\n#### Enhancements\n\n- **api,ui:** shared login",
+		"### General\n\n#### Chores\n\n- bump tooling",
+	} {
+		if !strings.Contains(agg, want) {
+			t.Errorf("expected %q in aggregate:\n%s", want, agg)
+		}
+	}
+	if n := strings.Count(agg, "**Full Changelog:**"); n != 1 {
+		t.Errorf("aggregate has %d Full Changelog links, want 1:\n%s", n, agg)
+	}
+	if strings.Index(agg, "### General") > strings.Index(agg, "**Full Changelog:**") {
+		t.Errorf("footer should follow the module sections:\n%s", agg)
+	}
+}
+
+func TestGenerator_GenerateRoutedChangelog_Template(t *testing.T) {
+
+	g := newRoutingTestGenerator(t)
+	g.config.Format = "template"
+	g.config.Template = BuiltinTemplatePrefix + "keepachangelog"
+	formatter, err := NewFormatter("template", g.config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.formatter = formatter
+
+	routed, err := g.GenerateRoutedChangelog("v2.0.0", "v1.0.0", routingTestCommits)
+	if err != nil {
+		t.Fatalf("GenerateRoutedChangelog() error = %v", err)
+	}
+	if !strings.HasPrefix(routed.Aggregate, "## [2.0.0] - ") || !strings.Contains(routed.Aggregate, "### api\n\n#### Added") {
+		t.Errorf("unexpected aggregate:\n%s", routed.Aggregate)
+	}
+	if n := strings.Count(routed.Aggregate, "**Full Changelog:**"); n != 1 {
+		t.Errorf("aggregate has %d Full Changelog links, want 1:\n%s", n, routed.Aggregate)
+	}
+}
+
+func TestGenerateForVersion_Routing(t *testing.T) {
+
+	tmpDir := t.TempDir()
+	routes := []RouteConfig{
+		{Name: "api", Path: "services/api", ChangelogPath: filepath.Join(tmpDir, "api.md")},
+		{Name: "web", Path: "web", ChangelogPath: filepath.Join(tmpDir, "web.md")},
+		{Name: "docs", Scopes: []string{"docs"}, ChangelogPath: filepath.Join(tmpDir, "docs.md")},
+	}
+
+	newPlugin := func(aggregate bool) *ChangelogGeneratorPlugin {
+		cfg := DefaultConfig()
+		cfg.Enabled = true
+		cfg.Mode = "unified"
+		cfg.ChangelogPath = filepath.Join(tmpDir, "CHANGELOG.md")
+		cfg.Contributors.Enabled = false
+		cfg.Routing = &RoutingConfig{Modules: routes, Aggregate: aggregate, RootLabel: "General"}
+		plugin, err := NewChangelogGenerator(cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		plugin.gitOps.GetCommitsWithMetaFn = func(since, until string) ([]CommitInfo, error) {
+			return routingTestCommits[:2], nil
+		}
+		plugin.gitOps.GetCommitFilesFn = func(hashes []string) (map[string][]string, error) {
+			return routingTestFiles, nil
+		}
+		return plugin
+	}
+
+	if err := newPlugin(false).GenerateForVersion("v1.0.0", "", "minor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "CHANGELOG.md")); !os.IsNotExist(err) {
+		t.Error("root changelog should not be written without aggregation")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "docs.md")); !os.IsNotExist(err) {
+		t.Error("modules without commits should not get a changelog")
+	}
+
+	if err := newPlugin(true).GenerateForVersion("v1.1.0", "", "minor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	api, _ := os.ReadFile(filepath.Join(tmpDir, "api.md"))
+	if !strings.Contains(string(api), "## v1.1.0") || !strings.Contains(string(api), "## v1.0.0") || strings.Contains(string(api), "align button") {
+		t.Errorf("unexpected api changelog:\n%s", api)
+	}
+	root, _ := os.ReadFile(filepath.Join(tmpDir, "CHANGELOG.md"))
+	if !strings.Contains(string(root), "### api") || !strings.Contains(string(root), "### web") {
+		t.Errorf("unexpected root changelog:\n%s", root)
+	}
+
+	// Module-scoped generation (independent versioning) is not routed again
+	plugin := newPlugin(true)
+	plugin.SetModulePath("web")
+	plugin.gitOps.GetCommitFilesFn = func(hashes []string) (map[string][]string, error) {
+		t.Fatal("module-scoped generation must not route commits")
+		return nil, nil
+	}
+	if err := plugin.GenerateForVersion("v1.2.0", "", "minor"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
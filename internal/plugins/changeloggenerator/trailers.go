@@ -0,0 +1,106 @@
+package changeloggenerator
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Trailer is a "Key: value" (or "Key #value") footer line in a commit body,
+// such as "BREAKING CHANGE: ...", "Closes #123" or "Co-authored-by: ...".
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+// CommitAuthor is a person credited in a commit trailer.
+type CommitAuthor struct {
+	Name  string
+	Email string
+}
+
+var (
+	// Matches a footer token: "Key: value", "Key #value" or "BREAKING CHANGE: value".
+	trailerRe = regexp.MustCompile(`^(BREAKING[ -]CHANGE|[A-Za-z][A-Za-z0-9-]*)(?::\s+(.*)|\s+(#.*))$`)
+
+	// Matches issue numbers in a footer value: "#123" or "123".
+	issueNumberRe = regexp.MustCompile(`(?:^|[\s,(])#?(\d+)\b`)
+
+	// Matches "Name <email>" in co-author trailers.
+	personRe = regexp.MustCompile(`^(.*?)\s*<([^>]+)>$`)
+)
+
+// issueTrailerKeys lists the (lowercase) footer keys that reference issues.
+var issueTrailerKeys = map[string]bool{
+	"close": true, "closes": true, "closed": true,
+	"fix": true, "fixes": true, "fixed": true,
+	"resolve": true, "resolves": true, "resolved": true,
+	"ref": true, "refs": true, "references": true,
+}
+
+// ParseTrailers returns the footers of a commit body. Footers are the trailing
+// paragraphs whose first line is a footer token; lines that are not tokens
+// continue the previous footer (e.g. a multi-line BREAKING CHANGE description).
+func ParseTrailers(body string) []Trailer {
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n")), "\n\n")
+
+	start := len(paragraphs)
+	for start > 0 {
+		first, _, _ := strings.Cut(strings.TrimSpace(paragraphs[start-1]), "\n")
+		if !trailerRe.MatchString(first) {
+			break
+		}
+		start--
+	}
+
+	var trailers []Trailer
+	for _, paragraph := range paragraphs[start:] {
+		for line := range strings.SplitSeq(paragraph, "\n") {
+			if m := trailerRe.FindStringSubmatch(line); m != nil {
+				trailers = append(trailers, Trailer{Key: m[1], Value: strings.TrimSpace(m[2] + m[3])})
+				continue
+			}
+			if n := len(trailers); n > 0 && strings.TrimSpace(line) != "" {
+				trailers[n-1].Value += "\n" + strings.TrimSpace(line)
+			}
+		}
+	}
+	return trailers
+}
+
+// isBreakingTrailer reports whether the key is "BREAKING CHANGE" or "BREAKING-CHANGE".
+func isBreakingTrailer(key string) bool {
+	return key == "BREAKING CHANGE" || key == "BREAKING-CHANGE"
+}
+
+// issueNumbers returns the issue numbers referenced by issue footers, in
+// order and without duplicates.
+func issueNumbers(trailers []Trailer) []string {
+	var numbers []string
+	seen := make(map[string]bool)
+	for _, t := range trailers {
+		if !issueTrailerKeys[strings.ToLower(t.Key)] {
+			continue
+		}
+		for _, m := range issueNumberRe.FindAllStringSubmatch(t.Value, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				numbers = append(numbers, m[1])
+			}
+		}
+	}
+	return numbers
+}
+
+// coAuthors returns the people credited in Co-authored-by footers.
+func coAuthors(trailers []Trailer) []CommitAuthor {
+	var authors []CommitAuthor
+	for _, t := range trailers {
+		if !strings.EqualFold(t.Key, "Co-authored-by") {
+			continue
+		}
+		if m := personRe.FindStringSubmatch(t.Value); m != nil {
+			authors = append(authors, CommitAuthor{Name: m[1], Email: m[2]})
+		}
+	}
+	return authors
+}
@@ -0,0 +1,93 @@
+package changeloggenerator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTrailers(t *testing.T) {
+
+	tests := []struct {
+		name string
+		body string
+		want []Trailer
+	}{
+		{
+			name: "empty body",
+			body: "",
+			want: nil,
+		},
+		{
+			name: "body without footers",
+			body: "Explain the change.\n\nMore details here.",
+			want: nil,
+		},
+		{
+			name: "footers after body",
+			body: "Explain the change.\n\nBREAKING CHANGE: config key renamed\nCloses #12, #13\nCo-authored-by: Jane Doe <jane@example.com>",
+			want: []Trailer{
+				{Key: "BREAKING CHANGE", Value: "config key renamed"},
+				{Key: "Closes", Value: "#12, #13"},
+				{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>"},
+			},
+		},
+		{
+			name: "multi-line breaking change",
+			body: "BREAKING-CHANGE: the old flag is gone\nuse --new instead\n\nRefs #7",
+			want: []Trailer{
+				{Key: "BREAKING-CHANGE", Value: "the old flag is gone\nuse --new instead"},
+				{Key: "Refs", Value: "#7"},
+			},
+		},
+		{
+			name: "hash separator",
+			body: "Fixes #99",
+			want: []Trailer{{Key: "Fixes", Value: "#99"}},
+		},
+		{
+			name: "prose paragraph is not a footer",
+			body: "This is prose: with a colon inside.\n\nSigned-off-by: Bob <bob@example.com>",
+			want: []Trailer{{Key: "Signed-off-by", Value: "Bob <bob@example.com>"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseTrailers(tt.body)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTrailers() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueNumbers(t *testing.T) {
+
+	trailers := []Trailer{
+		{Key: "Closes", Value: "#12, #13"},
+		{Key: "fixes", Value: "12"},
+		{Key: "Refs", Value: "owner/other#5"},
+		{Key: "Reviewed-by", Value: "#99"},
+	}
+
+	got := issueNumbers(trailers)
+	want := []string{"12", "13"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("issueNumbers() = %v, want %v", got, want)
+	}
+}
+
+func TestCoAuthors(t *testing.T) {
+
+	trailers := []Trailer{
+		{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>"},
+		{Key: "co-authored-by", Value: "no email"},
+		{Key: "Signed-off-by", Value: "Bob <bob@example.com>"},
+	}
+
+	got := coAuthors(trailers)
+	want := []CommitAuthor{{Name: "Jane Doe", Email: "jane@example.com"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("coAuthors() = %v, want %v", got, want)
+	}
+}
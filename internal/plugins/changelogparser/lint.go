@@ -0,0 +1,649 @@
+package changelogparser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/indaco/sley/internal/semver"
+)
+
+// Lint rules reported by LintChangelog.
+const (
+	RuleDuplicateVersion = "duplicate-version"
+	RuleUnsortedVersions = "unsorted-versions"
+	RuleMissingTag       = "missing-tag"
+	RuleMissingLink      = "missing-link"
+	RuleIncorrectLink    = "incorrect-link"
+	RuleMalformedDate    = "malformed-date"
+	RuleEmptySection     = "empty-section"
+	RuleStaleUnreleased  = "stale-unreleased"
+)
+
+// Changelog structure patterns for linting.
+var (
+	lintVersionHeadingRe    = regexp.MustCompile(`^##\s+(?:(\S+)\s+-\s+)?(\[)?(v?\d+\.\d+\.\d+[^\]\s()]*)\]?(?:\([^)]*\))?(?:\s+-\s+(.+?))?\s*$`)
+	lintUnreleasedHeadingRe = regexp.MustCompile(`(?i)^##\s+\[?unreleased\]?`)
+	lintHeadingRe           = regexp.MustCompile(`^(#{1,6})\s`)
+	lintLinkDefinitionRe    = regexp.MustCompile(`^\[([^\]]+)\]:\s*(\S+)`)
+	lintCompareRangeRe      = regexp.MustCompile(`/compare/([^\s)]+?)\.\.\.([^\s)]+)`)
+	lintFullChangelogRe     = regexp.MustCompile(`^\*\*Full Changelog:?\*\*`)
+	lintYankedSuffixRe      = regexp.MustCompile(`(?i)\s*\[yanked\]$`)
+	lintURLRe               = regexp.MustCompile(`https?://[^\s)]+`)
+)
+
+// LintIssue is a problem found in a changelog.
+type LintIssue struct {
+	Line    int
+	Rule    string
+	Message string
+}
+
+// String formats the issue as "line N: message (rule)".
+func (i LintIssue) String() string {
+	return fmt.Sprintf("line %d: %s (%s)", i.Line, i.Message, i.Rule)
+}
+
+// LintOptions configures LintChangelog and FixChangelog.
+type LintOptions struct {
+	// Tags lists the git tags of the repository. Versions without a matching
+	// tag are reported. When nil, tags are not checked.
+	Tags []string
+
+	// CompareURL builds the compare URL between two tags. When nil, it is
+	// derived from the compare links already present in the changelog.
+	CompareURL func(prev, curr string) string
+}
+
+// changelogDoc is a changelog split into its version sections.
+type changelogDoc struct {
+	preamble []string
+	blocks   []*changelogBlock
+	links    []linkDefinition
+}
+
+// changelogBlock is a "## " section of a changelog.
+type changelogBlock struct {
+	line       int
+	heading    string
+	body       []string
+	module     string
+	version    string
+	parsed     semver.SemVersion
+	date       string
+	bracketed  bool
+	unreleased bool
+}
+
+// linkDefinition is a Markdown link reference definition, e.g.
+// "[1.2.0]: https://github.com/owner/repo/compare/v1.1.0...v1.2.0".
+type linkDefinition struct {
+	line  int
+	label string
+	url   string
+}
+
+// isVersion reports whether the block is a released version section.
+func (b *changelogBlock) isVersion() bool {
+	return b.version != ""
+}
+
+// bodyLine returns the line number of the i-th body line.
+func (b *changelogBlock) bodyLine(i int) int {
+	return b.line + 1 + i
+}
+
+// hasEntries reports whether the block has any content besides headings.
+func (b *changelogBlock) hasEntries() bool {
+	return slices.ContainsFunc(b.body, isEntryLine)
+}
+
+// isEntryLine reports whether a line is neither blank nor a heading.
+func isEntryLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" && !lintHeadingRe.MatchString(trimmed)
+}
+
+// parseChangelogDoc splits changelog content into its preamble, sections and
+// link reference definitions.
+func parseChangelogDoc(content string) *changelogDoc {
+	doc := &changelogDoc{}
+	var current *changelogBlock
+
+	for i, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		lineNo := i + 1
+		trimmed := strings.TrimRight(line, " \t")
+
+		if m := lintLinkDefinitionRe.FindStringSubmatch(trimmed); m != nil {
+			doc.links = append(doc.links, linkDefinition{line: lineNo, label: m[1], url: m[2]})
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "## ") {
+			current = parseBlockHeading(trimmed, lineNo)
+			doc.blocks = append(doc.blocks, current)
+			continue
+		}
+
+		if current == nil {
+			doc.preamble = append(doc.preamble, line)
+			continue
+		}
+		// Keep line numbers aligned with body indexes; link definitions
+		// within a section are replaced by blank lines.
+		for current.bodyLine(len(current.body)) < lineNo {
+			current.body = append(current.body, "")
+		}
+		current.body = append(current.body, line)
+	}
+	return doc
+}
+
+// parseBlockHeading parses a "## " heading into a section.
+func parseBlockHeading(heading string, line int) *changelogBlock {
+	block := &changelogBlock{line: line, heading: heading}
+	if lintUnreleasedHeadingRe.MatchString(heading) {
+		block.unreleased = true
+		block.bracketed = strings.Contains(heading, "[")
+		return block
+	}
+	m := lintVersionHeadingRe.FindStringSubmatch(heading)
+	if m == nil {
+		return block
+	}
+	parsed, err := semver.ParseVersion(m[3])
+	if err != nil {
+		return block
+	}
+	block.module = m[1]
+	block.bracketed = m[2] != ""
+	block.version = m[3]
+	block.parsed = parsed
+	block.date = m[4]
+	return block
+}
+
+// LintChangelog checks changelog content for duplicate or unsorted versions,
+// versions without a git tag, missing or incorrect compare links, malformed
+// dates, empty sections and a stale Unreleased section. Issues are returned
+// in line order.
+func LintChangelog(content string, opts LintOptions) []LintIssue {
+	doc := parseChangelogDoc(content)
+	var issues []LintIssue
+	report := func(line int, rule, format string, args ...any) {
+		issues = append(issues, LintIssue{Line: line, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	lintVersions(doc, opts, report)
+	lintLinks(doc, opts, report)
+	lintSections(doc, report)
+
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Line < issues[j].Line })
+	return issues
+}
+
+// reportFunc records a lint issue.
+type reportFunc func(line int, rule, format string, args ...any)
+
+// lintVersions checks version ordering, duplicates, tags and dates.
+func lintVersions(doc *changelogDoc, opts LintOptions, report reportFunc) {
+	seen := make(map[string]int)
+	previous := make(map[string]*changelogBlock)
+	for _, b := range doc.blocks {
+		if !b.isVersion() {
+			continue
+		}
+		label := displayVersion(b)
+		key := b.module + "\x00" + b.parsed.String()
+
+		if first, ok := seen[key]; ok {
+			report(b.line, RuleDuplicateVersion, "version %s is already listed on line %d", label, first)
+		} else {
+			seen[key] = b.line
+			if prev := previous[b.module]; prev != nil && prev.parsed.Compare(b.parsed) < 0 {
+				report(b.line, RuleUnsortedVersions, "version %s is listed after older version %s", label, displayVersion(prev))
+			}
+			previous[b.module] = b
+		}
+
+		if opts.Tags != nil && !slices.ContainsFunc(opts.Tags, func(tag string) bool { return tagMatches(tag, b.module, b.version) }) {
+			report(b.line, RuleMissingTag, "version %s has no matching git tag", label)
+		}
+
+		if b.date != "" {
+			date := lintYankedSuffixRe.ReplaceAllString(b.date, "")
+			if _, err := time.Parse(time.DateOnly, date); err != nil {
+				report(b.line, RuleMalformedDate, "version %s has malformed date %q (expected YYYY-MM-DD)", label, b.date)
+			}
+		}
+	}
+}
+
+// lintLinks checks the compare link of every section against the previous
+// version: link reference definitions for "## [x.y.z]" headings and
+// "**Full Changelog**" lines otherwise.
+func lintLinks(doc *changelogDoc, opts LintOptions, report reportFunc) {
+	compareURL := resolveCompareURL(doc, opts)
+	links := make(map[string]linkDefinition, len(doc.links))
+	for _, def := range doc.links {
+		links[strings.ToLower(def.label)] = def
+	}
+
+	for _, lb := range linkedBlocks(doc, opts) {
+		b := lb.block
+		if !b.bracketed {
+			lintFullChangelogLink(b, lb, compareURL, report)
+			continue
+		}
+
+		label := b.version
+		if b.unreleased {
+			label = "Unreleased"
+		}
+		def, ok := links[strings.ToLower(label)]
+		if !ok {
+			if lb.prevTag != "" {
+				report(b.line, RuleMissingLink, "version %s has no compare link definition", label)
+			}
+			continue
+		}
+		if lb.prevTag == "" {
+			continue
+		}
+		if !compareLinkMatches(def.url, lb.prevTag, lb.tag, compareURL) {
+			report(def.line, RuleIncorrectLink, "compare link for %s should compare %s with %s", label, lb.prevTag, lb.tag)
+		}
+	}
+}
+
+// lintFullChangelogLink checks the "**Full Changelog**" line of a section.
+func lintFullChangelogLink(b *changelogBlock, lb linkedBlock, compareURL func(prev, curr string) string, report reportFunc) {
+	for i, line := range b.body {
+		if !lintFullChangelogRe.MatchString(strings.TrimSpace(line)) {
+			continue
+		}
+		if lb.prevTag == "" || !lintCompareRangeRe.MatchString(line) {
+			return
+		}
+		if !compareLinkMatches(line, lb.prevTag, lb.tag, compareURL) {
+			report(b.bodyLine(i), RuleIncorrectLink, "compare link for %s should compare %s with %s", displayVersion(b), lb.prevTag, lb.tag)
+		}
+		return
+	}
+}
+
+// compareLinkMatches reports whether a compare link compares prev with curr.
+// Links without a recognizable range must equal the expected compare URL.
+func compareLinkMatches(link, prev, curr string, compareURL func(prev, curr string) string) bool {
+	if m := lintCompareRangeRe.FindStringSubmatch(link); m != nil {
+		return sameTag(m[1], prev) && sameTag(m[2], curr)
+	}
+	return compareURL == nil || link == compareURL(prev, curr)
+}
+
+// lintSections checks for empty sections and a stale Unreleased section.
+func lintSections(doc *changelogDoc, report reportFunc) {
+	var latest *changelogBlock
+	for i, b := range doc.blocks {
+		if b.unreleased {
+			if latest != nil {
+				report(b.line, RuleStaleUnreleased, "unreleased section is listed after release %s", displayVersion(latest))
+			} else if next := nextVersionBlock(doc.blocks[i+1:]); next != nil {
+				for _, entry := range duplicatedEntries(b, next) {
+					report(entry, RuleStaleUnreleased, "unreleased entry was already released in %s", displayVersion(next))
+				}
+			}
+		} else if b.isVersion() && latest == nil {
+			latest = b
+		}
+
+		if b.isVersion() && !b.hasEntries() {
+			report(b.line, RuleEmptySection, "version %s has no entries", displayVersion(b))
+		}
+		for _, line := range emptySubsections(b) {
+			report(line, RuleEmptySection, "section %q has no entries", strings.TrimSpace(strings.TrimLeft(b.body[line-b.line-1], "#")))
+		}
+	}
+}
+
+// nextVersionBlock returns the first version section in blocks.
+func nextVersionBlock(blocks []*changelogBlock) *changelogBlock {
+	for _, b := range blocks {
+		if b.isVersion() {
+			return b
+		}
+	}
+	return nil
+}
+
+// duplicatedEntries returns the lines of unreleased entries that also appear
+// in the released section.
+func duplicatedEntries(unreleased, release *changelogBlock) []int {
+	released := make(map[string]bool)
+	for _, line := range release.body {
+		if isEntryLine(line) {
+			released[strings.TrimSpace(line)] = true
+		}
+	}
+	var lines []int
+	for i, line := range unreleased.body {
+		if isEntryLine(line) && released[strings.TrimSpace(line)] {
+			lines = append(lines, unreleased.bodyLine(i))
+		}
+	}
+	return lines
+}
+
+// emptySubsections returns the lines of subsection headings in a section
+// with no content before the next heading of the same or a higher level.
+func emptySubsections(b *changelogBlock) []int {
+	var lines []int
+	for i, line := range b.body {
+		m := lintHeadingRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		level := len(m[1])
+		empty := true
+		for _, next := range b.body[i+1:] {
+			if h := lintHeadingRe.FindStringSubmatch(next); h != nil && len(h[1]) <= level {
+				break
+			}
+			if isEntryLine(next) {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			lines = append(lines, b.bodyLine(i))
+		}
+	}
+	return lines
+}
+
+// linkedBlock is a section with the tags its compare link should use.
+type linkedBlock struct {
+	block   *changelogBlock
+	tag     string
+	prevTag string
+}
+
+// linkedBlocks returns the sections that carry a compare link, with the
+// tags of the version and of the previous version of the same module, in
+// version order.
+func linkedBlocks(doc *changelogDoc, opts LintOptions) []linkedBlock {
+	sorted := sortedVersionBlocks(doc.blocks)
+
+	byModule := make(map[string][]*changelogBlock)
+	for _, b := range sorted {
+		if !slices.ContainsFunc(byModule[b.module], func(o *changelogBlock) bool { return o.parsed.Compare(b.parsed) == 0 }) {
+			byModule[b.module] = append(byModule[b.module], b)
+		}
+	}
+
+	var result []linkedBlock
+	for _, b := range doc.blocks {
+		if b.unreleased {
+			if latest := byModule[""]; len(latest) > 0 {
+				result = append(result, linkedBlock{block: b, tag: "HEAD", prevTag: tagForVersion(latest[0].module, latest[0].version, opts.Tags)})
+			} else {
+				result = append(result, linkedBlock{block: b, tag: "HEAD"})
+			}
+			continue
+		}
+		if !b.isVersion() {
+			continue
+		}
+		lb := linkedBlock{block: b, tag: tagForVersion(b.module, b.version, opts.Tags)}
+		versions := byModule[b.module]
+		for i, v := range versions {
+			if v.parsed.Compare(b.parsed) == 0 && i+1 < len(versions) {
+				lb.prevTag = tagForVersion(versions[i+1].module, versions[i+1].version, opts.Tags)
+			}
+		}
+		result = append(result, lb)
+	}
+	return result
+}
+
+// sortedVersionBlocks returns the version sections sorted newest first
+// within each module. Each module keeps the positions its sections occupy,
+// so interleaved modules stay interleaved.
+func sortedVersionBlocks(blocks []*changelogBlock) []*changelogBlock {
+	var versions []*changelogBlock
+	byModule := make(map[string][]*changelogBlock)
+	for _, b := range blocks {
+		if b.isVersion() {
+			versions = append(versions, b)
+			byModule[b.module] = append(byModule[b.module], b)
+		}
+	}
+	for _, moduleBlocks := range byModule {
+		sort.SliceStable(moduleBlocks, func(i, j int) bool {
+			return moduleBlocks[i].parsed.Compare(moduleBlocks[j].parsed) > 0
+		})
+	}
+
+	sorted := make([]*changelogBlock, len(versions))
+	next := make(map[string]int, len(byModule))
+	for i, b := range versions {
+		sorted[i] = byModule[b.module][next[b.module]]
+		next[b.module]++
+	}
+	return sorted
+}
+
+// resolveCompareURL returns the configured compare URL builder, or one
+// derived from the first compare link in the changelog.
+func resolveCompareURL(doc *changelogDoc, opts LintOptions) func(prev, curr string) string {
+	if opts.CompareURL != nil {
+		return opts.CompareURL
+	}
+	candidates := make([]string, 0, len(doc.links))
+	for _, def := range doc.links {
+		candidates = append(candidates, def.url)
+	}
+	for _, b := range doc.blocks {
+		for _, line := range b.body {
+			if lintFullChangelogRe.MatchString(strings.TrimSpace(line)) {
+				candidates = append(candidates, line)
+			}
+		}
+	}
+	for _, candidate := range candidates {
+		url := lintURLRe.FindString(candidate)
+		loc := lintCompareRangeRe.FindStringSubmatchIndex(url)
+		if loc == nil {
+			continue
+		}
+		base := url[:loc[2]]
+		return func(prev, curr string) string {
+			return base + prev + "..." + curr
+		}
+	}
+	return nil
+}
+
+// tagForVersion returns the git tag of a module's changelog version: the
+// matching tag when one exists, otherwise the version with a "v" prefix,
+// under "<module>/" for module sections (e.g. "api/v1.2.0").
+func tagForVersion(module, version string, tags []string) string {
+	bare := strings.TrimPrefix(version, "v")
+	prefix := ""
+	if module != "" {
+		prefix = module + "/"
+	}
+	for _, candidate := range []string{prefix + "v" + bare, prefix + bare} {
+		if slices.Contains(tags, candidate) {
+			return candidate
+		}
+	}
+	for _, tag := range tags {
+		if tagMatches(tag, module, bare) {
+			return tag
+		}
+	}
+	return prefix + "v" + bare
+}
+
+// splitTag splits a tag into its path prefix (e.g. "services/api") and its
+// version without the "v" prefix.
+func splitTag(tag string) (prefix, version string) {
+	if i := strings.LastIndex(tag, "/"); i >= 0 {
+		prefix, tag = tag[:i], tag[i+1:]
+	}
+	return prefix, strings.TrimPrefix(tag, "v")
+}
+
+// tagMatches reports whether tag is the tag of a module's version. Root
+// versions match tags without a path prefix; module versions match tags
+// whose prefix is the module name or ends with it (e.g. "services/api").
+func tagMatches(tag, module, version string) bool {
+	prefix, tagVersion := splitTag(tag)
+	if tagVersion != strings.TrimPrefix(version, "v") {
+		return false
+	}
+	if module == "" {
+		return prefix == ""
+	}
+	return prefix == module || strings.HasSuffix(prefix, "/"+module)
+}
+
+// sameTag reports whether two tags name the same version under the same
+// prefix, ignoring the "v" prefix of the version.
+func sameTag(a, b string) bool {
+	aPrefix, aVersion := splitTag(a)
+	bPrefix, bVersion := splitTag(b)
+	return aPrefix == bPrefix && aVersion == bVersion
+}
+
+// displayVersion returns the version of a section, prefixed by its module.
+func displayVersion(b *changelogBlock) string {
+	if b.module != "" {
+		return b.module + " " + b.version
+	}
+	return b.version
+}
+
+// FixChangelog normalises the changelog: the Unreleased section comes first,
+// versions are sorted newest first within each module, and compare links are
+// rebuilt from the tags. Link definitions that cannot be rebuilt are kept as they are.
+func FixChangelog(content string, opts LintOptions) string {
+	doc := parseChangelogDoc(content)
+	compareURL := resolveCompareURL(doc, opts)
+
+	// Sort the Unreleased and version sections within the slots they occupy,
+	// leaving any other section in place.
+	var slots []int
+	var ordered []*changelogBlock
+	for i, b := range doc.blocks {
+		if b.unreleased || b.isVersion() {
+			slots = append(slots, i)
+			if b.unreleased {
+				ordered = append(ordered, b)
+			}
+		}
+	}
+	ordered = append(ordered, sortedVersionBlocks(doc.blocks)...)
+	blocks := slices.Clone(doc.blocks)
+	for i, slot := range slots {
+		blocks[slot] = ordered[i]
+	}
+	doc.blocks = blocks
+
+	var links []linkDefinition
+	if compareURL != nil {
+		links = rebuildLinks(doc, opts, compareURL)
+	} else {
+		links = doc.links
+	}
+
+	return renderChangelogDoc(doc, links)
+}
+
+// rebuildLinks regenerates the compare links of the changelog, keeping link
+// definitions that do not belong to a version.
+func rebuildLinks(doc *changelogDoc, opts LintOptions, compareURL func(prev, curr string) string) []linkDefinition {
+	existing := make(map[string]linkDefinition, len(doc.links))
+	for _, def := range doc.links {
+		existing[strings.ToLower(def.label)] = def
+	}
+
+	var links []linkDefinition
+	used := make(map[string]bool)
+	for _, lb := range linkedBlocks(doc, opts) {
+		b := lb.block
+		if !b.bracketed {
+			fixFullChangelogLink(b, lb, compareURL)
+			continue
+		}
+
+		label := b.version
+		if b.unreleased {
+			label = "Unreleased"
+		}
+		key := strings.ToLower(label)
+		if used[key] {
+			continue
+		}
+		used[key] = true
+
+		if def, ok := existing[key]; ok {
+			label = def.label
+		}
+		switch {
+		case lb.prevTag != "":
+			links = append(links, linkDefinition{label: label, url: compareURL(lb.prevTag, lb.tag)})
+		case existing[key].url != "":
+			links = append(links, linkDefinition{label: label, url: existing[key].url})
+		}
+	}
+
+	for _, def := range doc.links {
+		if !used[strings.ToLower(def.label)] {
+			links = append(links, def)
+		}
+	}
+	return links
+}
+
+// fixFullChangelogLink rewrites an existing "**Full Changelog**" line to
+// compare the section with its previous version.
+func fixFullChangelogLink(b *changelogBlock, lb linkedBlock, compareURL func(prev, curr string) string) {
+	if lb.prevTag == "" {
+		return
+	}
+	for i, line := range b.body {
+		if lintFullChangelogRe.MatchString(strings.TrimSpace(line)) && lintCompareRangeRe.MatchString(line) {
+			b.body[i] = fmt.Sprintf("**Full Changelog:** [%s...%s](%s)", lb.prevTag, lb.tag, compareURL(lb.prevTag, lb.tag))
+			return
+		}
+	}
+}
+
+// renderChangelogDoc writes the changelog back with one blank line between
+// sections and the link definitions at the end.
+func renderChangelogDoc(doc *changelogDoc, links []linkDefinition) string {
+	var parts []string
+	if preamble := strings.TrimSpace(strings.Join(doc.preamble, "\n")); preamble != "" {
+		parts = append(parts, preamble)
+	}
+	for _, b := range doc.blocks {
+		section := b.heading
+		if body := strings.Trim(strings.Join(b.body, "\n"), "\n"); strings.TrimSpace(body) != "" {
+			section += "\n\n" + body
+		}
+		parts = append(parts, section)
+	}
+	if len(links) > 0 {
+		defs := make([]string, len(links))
+		for i, def := range links {
+			defs[i] = fmt.Sprintf("[%s]: %s", def.label, def.url)
+		}
+		parts = append(parts, strings.Join(defs, "\n"))
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
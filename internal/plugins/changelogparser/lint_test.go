@@ -0,0 +1,433 @@
+package changelogparser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func lintRules(issues []LintIssue) []string {
+	rules := make([]string, len(issues))
+	for i, issue := range issues {
+		rules[i] = issue.Rule
+	}
+	return rules
+}
+
+func TestLintChangelog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		content   string
+		opts      LintOptions
+		wantRules []string
+		wantLines []int
+	}{
+		{
+			name: "clean keepachangelog",
+			content: `# Changelog
+
+## [Unreleased]
+
+## [1.1.0] - 2024-02-01
+
+### Added
+- Feature B
+
+## [1.0.0] - 2024-01-01
+
+### Added
+- Feature A
+
+[Unreleased]: https://github.com/owner/repo/compare/v1.1.0...HEAD
+[1.1.0]: https://github.com/owner/repo/compare/v1.0.0...v1.1.0
+[1.0.0]: https://github.com/owner/repo/releases/tag/v1.0.0
+`,
+			opts:      LintOptions{Tags: []string{"v1.0.0", "v1.1.0"}},
+			wantRules: nil,
+		},
+		{
+			name: "clean grouped",
+			content: `# Changelog
+
+## v1.1.0 - 2024-02-01
+
+### Features
+
+- Feature B
+
+**Full Changelog:** [v1.0.0...v1.1.0](https://github.com/owner/repo/compare/v1.0.0...v1.1.0)
+
+## v1.0.0 - 2024-01-01
+
+### Features
+
+- Feature A
+`,
+			wantRules: nil,
+		},
+		{
+			name: "duplicate and unsorted versions",
+			content: `## v1.0.0
+- A
+
+## v1.1.0
+- B
+
+## v1.0.0
+- C
+`,
+			wantRules: []string{RuleUnsortedVersions, RuleDuplicateVersion},
+			wantLines: []int{4, 7},
+		},
+		{
+			name: "modules are ordered independently",
+			content: `## api - v2.0.0 - 2024-02-01
+- A
+
+## web - v1.0.0 - 2024-02-01
+- B
+
+## api - v1.0.0 - 2024-01-01
+- C
+`,
+			wantRules: nil,
+		},
+		{
+			name: "missing tag",
+			content: `## v1.1.0
+- B
+
+## v1.0.0
+- A
+`,
+			opts:      LintOptions{Tags: []string{"v1.0.0"}},
+			wantRules: []string{RuleMissingTag},
+			wantLines: []int{1},
+		},
+		{
+			name: "module versions need module tags",
+			content: `## api - v1.2.0 - 2024-02-01
+- A
+
+## web - v0.3.0 - 2024-02-01
+- B
+
+## v1.2.0 - 2024-01-01
+- C
+`,
+			opts:      LintOptions{Tags: []string{"v1.2.0", "services/web/v0.3.0"}},
+			wantRules: []string{RuleMissingTag},
+			wantLines: []int{1},
+		},
+		{
+			name: "missing and incorrect link definitions",
+			content: `## [1.2.0] - 2024-03-01
+- C
+
+## [1.1.0] - 2024-02-01
+- B
+
+## [1.0.0] - 2024-01-01
+- A
+
+[1.1.0]: https://github.com/owner/repo/compare/v0.9.0...v1.1.0
+`,
+			wantRules: []string{RuleMissingLink, RuleIncorrectLink},
+			wantLines: []int{1, 10},
+		},
+		{
+			name: "incorrect full changelog link",
+			content: `## v1.2.0
+- C
+
+**Full Changelog:** [v1.0.0...v1.2.0](https://github.com/owner/repo/compare/v1.0.0...v1.2.0)
+
+## v1.1.0
+- B
+
+## v1.0.0
+- A
+`,
+			wantRules: []string{RuleIncorrectLink},
+			wantLines: []int{4},
+		},
+		{
+			name: "malformed dates",
+			content: `## [1.2.0] - 2024-13-01
+- C
+
+## [1.1.0] - 01/02/2024
+- B
+
+## [1.0.0] - 2024-01-01 [YANKED]
+- A
+`,
+			wantRules: []string{RuleMalformedDate, RuleMissingLink, RuleMalformedDate, RuleMissingLink},
+			wantLines: []int{1, 1, 4, 4},
+		},
+		{
+			name: "empty sections",
+			content: `## Unreleased
+
+### Added
+
+## v1.1.0
+
+## v1.0.0
+
+### Fixed
+
+### Added
+- A
+`,
+			wantRules: []string{RuleEmptySection, RuleEmptySection, RuleEmptySection},
+			wantLines: []int{3, 5, 9},
+		},
+		{
+			name: "unreleased after a release",
+			content: `## v1.0.0
+- A
+
+## Unreleased
+- B
+`,
+			wantRules: []string{RuleStaleUnreleased},
+			wantLines: []int{4},
+		},
+		{
+			name: "unreleased entries already released",
+			content: `## Unreleased
+- A
+- B
+
+## v1.0.0
+- A
+`,
+			wantRules: []string{RuleStaleUnreleased},
+			wantLines: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			issues := LintChangelog(tt.content, tt.opts)
+			if got := lintRules(issues); !slices.Equal(got, tt.wantRules) {
+				t.Fatalf("rules = %v, want %v (issues: %v)", got, tt.wantRules, issues)
+			}
+			for i, issue := range issues {
+				if tt.wantLines != nil && issue.Line != tt.wantLines[i] {
+					t.Errorf("issue %d line = %d, want %d (%s)", i, issue.Line, tt.wantLines[i], issue)
+				}
+			}
+		})
+	}
+}
+
+func TestLintIssue_String(t *testing.T) {
+	t.Parallel()
+
+	issue := LintIssue{Line: 3, Rule: RuleMissingTag, Message: "version 1.0.0 has no matching git tag"}
+	want := "line 3: version 1.0.0 has no matching git tag (missing-tag)"
+	if got := issue.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestFixChangelog(t *testing.T) {
+	t.Parallel()
+
+	content := `# Changelog
+
+## [1.0.0] - 2024-01-01
+
+### Added
+- A
+
+## [Unreleased]
+
+## [1.2.0] - 2024-03-01
+
+### Fixed
+- C
+
+## [1.1.0] - 2024-02-01
+
+### Added
+- B
+
+[1.1.0]: https://github.com/owner/repo/compare/v0.9.0...v1.1.0
+[1.0.0]: https://github.com/owner/repo/releases/tag/v1.0.0
+[docs]: https://example.com/docs
+`
+	want := `# Changelog
+
+## [Unreleased]
+
+## [1.2.0] - 2024-03-01
+
+### Fixed
+- C
+
+## [1.1.0] - 2024-02-01
+
+### Added
+- B
+
+## [1.0.0] - 2024-01-01
+
+### Added
+- A
+
+[Unreleased]: https://github.com/owner/repo/compare/v1.2.0...HEAD
+[1.2.0]: https://github.com/owner/repo/compare/v1.1.0...v1.2.0
+[1.1.0]: https://github.com/owner/repo/compare/v1.0.0...v1.1.0
+[1.0.0]: https://github.com/owner/repo/releases/tag/v1.0.0
+[docs]: https://example.com/docs
+`
+
+	got := FixChangelog(content, LintOptions{})
+	if got != want {
+		t.Errorf("FixChangelog() =\n%s\nwant:\n%s", got, want)
+	}
+	if issues := LintChangelog(got, LintOptions{}); len(issues) != 0 {
+		t.Errorf("fixed changelog still has issues: %v", issues)
+	}
+	if again := FixChangelog(got, LintOptions{}); again != got {
+		t.Errorf("FixChangelog() is not idempotent:\n%s", again)
+	}
+}
+
+func TestFixChangelog_FullChangelogLinks(t *testing.T) {
+	t.Parallel()
+
+	content := `## v1.0.0 - 2024-01-01
+
+- A
+
+## v1.1.0 - 2024-02-01
+
+- B
+
+**Full Changelog:** [v0.9.0...v1.1.0](https://old.example/compare/v0.9.0...v1.1.0)
+`
+	opts := LintOptions{
+		Tags: []string{"v1.0.0", "v1.1.0"},
+		CompareURL: func(prev, curr string) string {
+			return "https://gitlab.com/owner/repo/-/compare/" + prev + "..." + curr
+		},
+	}
+
+	got := FixChangelog(content, opts)
+	if !strings.HasPrefix(got, "## v1.1.0") {
+		t.Errorf("expected v1.1.0 first, got:\n%s", got)
+	}
+	wantLink := "**Full Changelog:** [v1.0.0...v1.1.0](https://gitlab.com/owner/repo/-/compare/v1.0.0...v1.1.0)"
+	if !strings.Contains(got, wantLink) {
+		t.Errorf("expected rebuilt link %q, got:\n%s", wantLink, got)
+	}
+	if issues := LintChangelog(got, opts); len(issues) != 0 {
+		t.Errorf("fixed changelog still has issues: %v", issues)
+	}
+}
+
+func TestFixChangelog_NoCompareURL(t *testing.T) {
+	t.Parallel()
+
+	content := `## [1.0.0] - 2024-01-01
+- A
+
+## [1.1.0] - 2024-02-01
+- B
+
+[1.0.0]: https://example.com/v1.0.0
+`
+	got := FixChangelog(content, LintOptions{})
+	if !strings.HasPrefix(got, "## [1.1.0]") {
+		t.Errorf("expected versions sorted, got:\n%s", got)
+	}
+	if !strings.HasSuffix(got, "[1.0.0]: https://example.com/v1.0.0\n") {
+		t.Errorf("expected existing link definition kept, got:\n%s", got)
+	}
+}
+
+func TestFixChangelog_Modules(t *testing.T) {
+	t.Parallel()
+
+	content := `## web - v0.2.0 - 2024-02-01
+
+- B
+
+**Full Changelog:** [web/v0.1.0...web/v0.2.0](https://github.com/owner/repo/compare/web/v0.1.0...web/v0.2.0)
+
+## api - v1.2.0 - 2024-03-01
+
+- C
+
+## web - v0.3.0 - 2024-04-01
+
+- D
+
+**Full Changelog:** [web/v0.1.0...web/v0.3.0](https://github.com/owner/repo/compare/web/v0.1.0...web/v0.3.0)
+
+## api - v1.1.0 - 2024-01-01
+
+- A
+`
+	want := `## web - v0.3.0 - 2024-04-01
+
+- D
+
+**Full Changelog:** [web/v0.2.0...web/v0.3.0](https://github.com/owner/repo/compare/web/v0.2.0...web/v0.3.0)
+
+## api - v1.2.0 - 2024-03-01
+
+- C
+
+## web - v0.2.0 - 2024-02-01
+
+- B
+
+**Full Changelog:** [web/v0.1.0...web/v0.2.0](https://github.com/owner/repo/compare/web/v0.1.0...web/v0.2.0)
+
+## api - v1.1.0 - 2024-01-01
+
+- A
+`
+	opts := LintOptions{Tags: []string{"api/v1.1.0", "api/v1.2.0", "web/v0.1.0", "web/v0.2.0", "web/v0.3.0"}}
+
+	got := FixChangelog(content, opts)
+	if got != want {
+		t.Errorf("FixChangelog() =\n%s\nwant:\n%s", got, want)
+	}
+	if issues := LintChangelog(got, opts); len(issues) != 0 {
+		t.Errorf("fixed changelog still has issues: %v", issues)
+	}
+	if again := FixChangelog(got, opts); again != got {
+		t.Errorf("FixChangelog() changed a sorted changelog:\n%s", again)
+	}
+}
+
+func TestTagForVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		module  string
+		version string
+		tags    []string
+		want    string
+	}{
+		{"", "1.2.0", []string{"api/v1.2.0", "1.2.0"}, "1.2.0"},
+		{"", "v1.2.0", nil, "v1.2.0"},
+		{"api", "1.2.0", []string{"v1.2.0"}, "api/v1.2.0"},
+		{"api", "1.2.0", []string{"v1.2.0", "services/api/v1.2.0"}, "services/api/v1.2.0"},
+	}
+
+	for _, tt := range tests {
+		if got := tagForVersion(tt.module, tt.version, tt.tags); got != tt.want {
+			t.Errorf("tagForVersion(%q, %q, %v) = %q, want %q", tt.module, tt.version, tt.tags, got, tt.want)
+		}
+	}
+}
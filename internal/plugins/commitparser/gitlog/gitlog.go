@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/indaco/sley/internal/git"
+	"github.com/indaco/sley/internal/semver"
 )
 
 // validGitRef matches safe git reference names: alphanumeric, dots, hyphens, slashes, tildes, carets.
@@ -40,6 +41,9 @@ type GitLog struct {
 	// ModulePath scopes git log to commits touching this directory.
 	// Empty means no path filtering (all commits).
 	ModulePath string
+	// Line scopes tag resolution to a maintenance release line, so commits
+	// on release/1.x are counted from the last 1.x tag. Nil means no line.
+	Line *semver.ReleaseLine
 }
 
 // NewGitLog creates a GitLog with the real exec.Command implementation.
@@ -119,6 +123,10 @@ func (g *GitLog) GetCommits(since string, until string) ([]string, error) {
 }
 
 func (g *GitLog) getLastTag() (string, error) {
+	if g.Line != nil {
+		return g.getLastTagInLine(*g.Line)
+	}
+
 	// When a tag prefix is set, use git tag --list with version sorting
 	// to find the latest tag matching the prefix (e.g. "<module-name>/v*").
 	if g.TagPrefix != "" {
@@ -146,6 +154,32 @@ func (g *GitLog) getLastTag() (string, error) {
 	return tag, nil
 }
 
+// getLastTagInLine returns the most recent tag of a release line.
+func (g *GitLog) getLastTagInLine(line semver.ReleaseLine) (string, error) {
+	args := []string{"tag", "--list"}
+	if g.TagPrefix != "" {
+		args = append(args, line.TagPattern(g.TagPrefix))
+	}
+	cmd := g.ExecCommandFn("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return "", fmt.Errorf("git tag list failed: %s: %w", stderrMsg, err)
+		}
+		return "", fmt.Errorf("git tag list failed: %w", err)
+	}
+
+	tag, ok := semver.LatestInLine(strings.Split(string(out), "\n"), g.TagPrefix, line)
+	if !ok {
+		return "", fmt.Errorf("no tags found in release line %s", line)
+	}
+	return tag, nil
+}
+
 // getLastTagWithPrefix returns the most recent tag matching the given prefix.
 // Uses git tag --list with version sorting to find the latest match.
 func (g *GitLog) getLastTagWithPrefix(prefix string) (string, error) {
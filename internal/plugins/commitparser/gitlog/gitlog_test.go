@@ -5,6 +5,8 @@ import (
 	"os/exec"
 	"strings"
 	"testing"
+
+	"github.com/indaco/sley/internal/semver"
 )
 
 var fakeGitCommands = map[string]string{}
@@ -157,3 +159,23 @@ func TestGetCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCommits_ReleaseLine(t *testing.T) {
+	fakeGitCommands = map[string]string{
+		"git tag --list v1.3.*":                    "v1.3.4\nv1.3.10\nv1.3.9",
+		"git log --pretty=format:%s v1.3.10..HEAD": "fix: backport",
+	}
+
+	gl := &GitLog{
+		ExecCommandFn: fakeExecCommand,
+		TagPrefix:     "v",
+		Line:          &semver.ReleaseLine{Major: 1, Minor: 3, HasMinor: true},
+	}
+	commits, err := gl.GetCommits("", "HEAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(commits) != 1 || commits[0] != "fix: backport" {
+		t.Errorf("expected commits since v1.3.10, got %v", commits)
+	}
+}
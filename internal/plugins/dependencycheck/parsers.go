@@ -169,3 +169,22 @@ func writeRegexVersion(path, pattern, version string) error {
 		Pattern: pattern,
 	}, version)
 }
+
+// readManifestVersion reads a version from an XML, properties or source file.
+// An empty field selects the format's default (e.g. "version" or "Version").
+func readManifestVersion(format, path, field string) (string, error) {
+	return getParserReader().ReadVersion(context.Background(), parser.FileConfig{
+		Path:   path,
+		Format: parser.Format(format),
+		Field:  field,
+	})
+}
+
+// writeManifestVersion replaces the version in an XML, properties or source file.
+func writeManifestVersion(format, path, field, version string) error {
+	return getParserWriter().Write(context.Background(), parser.FileConfig{
+		Path:   path,
+		Format: parser.Format(format),
+		Field:  field,
+	}, version)
+}
@@ -3,6 +3,8 @@ package dependencycheck
 import (
 	"fmt"
 	"strings"
+
+	"github.com/indaco/sley/internal/parser"
 )
 
 // DependencyChecker defines the interface for dependency version checking.
@@ -17,6 +19,10 @@ type DependencyChecker interface {
 	// SyncVersions updates all configured files to the new version.
 	SyncVersions(newVersion string) error
 
+	// SyncReferences updates the dependency references that follow the given
+	// module to its new version.
+	SyncReferences(module, newVersion string) error
+
 	// IsEnabled returns whether the plugin is active.
 	IsEnabled() bool
 
@@ -45,17 +51,37 @@ type FileConfig struct {
 	// Example: "version", "tool.poetry.version", "metadata.version"
 	Field string
 
-	// Format specifies the file format: json, yaml, toml, raw, regex
+	// Format specifies the file format: json, yaml, toml, raw, regex, xml,
+	// properties, gradle, ruby, elixir, swift, go or gomod
 	Format string
 
 	// Pattern is the regex pattern for "regex" format.
 	// Use capturing group for version: e.g., `version = "(.*?)"`
 	Pattern string
+
+	// References lists dependencies whose version constraints are updated
+	// (json, toml and gomod formats). When set, Field is only synced if given.
+	References []Reference
+
+	// Policy controls how reference constraints are updated: "preserve"
+	// (default), "widen" or "pin".
+	Policy string
+}
+
+// Reference names a dependency entry in a manifest.
+type Reference struct {
+	// Name is the dependency name, e.g. "@acme/core", "core" or "example.com/core".
+	Name string
+
+	// Module is the workspace module whose version the reference follows.
+	// Empty means the version being synced.
+	Module string
 }
 
 // Inconsistency represents a version mismatch in a file.
 type Inconsistency struct {
 	Path     string
+	Field    string
 	Expected string
 	Found    string
 	Format   string
@@ -63,7 +89,11 @@ type Inconsistency struct {
 
 // String returns a formatted string representation of the inconsistency.
 func (i Inconsistency) String() string {
-	return fmt.Sprintf("%s: expected %s, found %s (format: %s)", i.Path, i.Expected, i.Found, i.Format)
+	path := i.Path
+	if i.Field != "" {
+		path += " (" + i.Field + ")"
+	}
+	return fmt.Sprintf("%s: expected %s, found %s (format: %s)", path, i.Expected, i.Found, i.Format)
 }
 
 // DependencyCheckerPlugin implements the DependencyChecker interface.
@@ -77,12 +107,22 @@ type DependencyCheckerPlugin struct {
 	readRawVersionFn   func(path string) (string, error)
 	readRegexVersionFn func(path, pattern string) (string, error)
 
+	// readManifestVersionFn reads the XML, properties and source formats.
+	readManifestVersionFn func(format, path, field string) (string, error)
+
 	// Format-specific write functions (injected for testability).
 	writeJSONVersionFn  func(path, field, version string) error
 	writeYAMLVersionFn  func(path, field, version string) error
 	writeTOMLVersionFn  func(path, field, version string) error
 	writeRawVersionFn   func(path, version string) error
 	writeRegexVersionFn func(path, pattern, version string) error
+
+	// writeManifestVersionFn writes the XML, properties and source formats.
+	writeManifestVersionFn func(format, path, field, version string) error
+
+	// Dependency reference functions (injected for testability).
+	readReferencesFn  func(format, path, name string) ([]parser.Reference, error)
+	writeReferencesFn func(format, path, name string, update func(parser.Reference) (string, error)) (int, error)
 }
 
 // Ensure DependencyCheckerPlugin implements DependencyChecker.
@@ -100,17 +140,21 @@ func NewDependencyChecker(cfg *Config) *DependencyCheckerPlugin {
 		cfg = DefaultConfig()
 	}
 	return &DependencyCheckerPlugin{
-		config:              cfg,
-		readJSONVersionFn:   readJSONVersion,
-		readYAMLVersionFn:   readYAMLVersion,
-		readTOMLVersionFn:   readTOMLVersion,
-		readRawVersionFn:    readRawVersion,
-		readRegexVersionFn:  readRegexVersion,
-		writeJSONVersionFn:  writeJSONVersion,
-		writeYAMLVersionFn:  writeYAMLVersion,
-		writeTOMLVersionFn:  writeTOMLVersion,
-		writeRawVersionFn:   writeRawVersion,
-		writeRegexVersionFn: writeRegexVersion,
+		config:                 cfg,
+		readJSONVersionFn:      readJSONVersion,
+		readYAMLVersionFn:      readYAMLVersion,
+		readTOMLVersionFn:      readTOMLVersion,
+		readRawVersionFn:       readRawVersion,
+		readRegexVersionFn:     readRegexVersion,
+		readManifestVersionFn:  readManifestVersion,
+		writeJSONVersionFn:     writeJSONVersion,
+		writeYAMLVersionFn:     writeYAMLVersion,
+		writeTOMLVersionFn:     writeTOMLVersion,
+		writeRawVersionFn:      writeRawVersion,
+		writeRegexVersionFn:    writeRegexVersion,
+		writeManifestVersionFn: writeManifestVersion,
+		readReferencesFn:       readReferences,
+		writeReferencesFn:      writeReferences,
 	}
 }
 
@@ -143,6 +187,15 @@ func (p *DependencyCheckerPlugin) CheckConsistency(currentVersion string) ([]Inc
 	normalizedExpected := normalizeVersion(currentVersion)
 
 	for _, file := range p.config.Files {
+		refs, err := p.checkReferences(file, currentVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check references in %s: %w", file.Path, err)
+		}
+		inconsistencies = append(inconsistencies, refs...)
+		if !file.hasVersionField() {
+			continue
+		}
+
 		version, err := p.readVersionFromFile(file)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read version from %s: %w", file.Path, err)
@@ -152,6 +205,7 @@ func (p *DependencyCheckerPlugin) CheckConsistency(currentVersion string) ([]Inc
 		if normalizedFound != normalizedExpected {
 			inconsistencies = append(inconsistencies, Inconsistency{
 				Path:     file.Path,
+				Field:    file.Field,
 				Expected: currentVersion,
 				Found:    version,
 				Format:   file.Format,
@@ -169,8 +223,29 @@ func (p *DependencyCheckerPlugin) SyncVersions(newVersion string) error {
 	}
 
 	for _, file := range p.config.Files {
-		if err := p.writeVersionToFile(file, newVersion); err != nil {
-			return fmt.Errorf("failed to write version to %s: %w", file.Path, err)
+		if file.hasVersionField() {
+			if err := p.writeVersionToFile(file, newVersion); err != nil {
+				return fmt.Errorf("failed to write version to %s: %w", file.Path, err)
+			}
+		}
+		if err := p.syncReferences(file, "", newVersion); err != nil {
+			return fmt.Errorf("failed to update references in %s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// SyncReferences updates the dependency references that follow the given
+// module to its new version.
+func (p *DependencyCheckerPlugin) SyncReferences(module, newVersion string) error {
+	if !p.IsEnabled() || module == "" {
+		return nil
+	}
+
+	for _, file := range p.config.Files {
+		if err := p.syncReferences(file, module, newVersion); err != nil {
+			return fmt.Errorf("failed to update references in %s: %w", file.Path, err)
 		}
 	}
 
@@ -193,6 +268,8 @@ func (p *DependencyCheckerPlugin) readVersionFromFile(file FileConfig) (string,
 			return "", fmt.Errorf("regex format requires a pattern")
 		}
 		return p.readRegexVersionFn(file.Path, file.Pattern)
+	case "xml", "properties", "gradle", "ruby", "elixir", "swift", "go":
+		return p.readManifestVersionFn(file.Format, file.Path, file.Field)
 	default:
 		return "", fmt.Errorf("unsupported format: %s", file.Format)
 	}
@@ -214,6 +291,8 @@ func (p *DependencyCheckerPlugin) writeVersionToFile(file FileConfig, version st
 			return fmt.Errorf("regex format requires a pattern")
 		}
 		return p.writeRegexVersionFn(file.Path, file.Pattern, version)
+	case "xml", "properties", "gradle", "ruby", "elixir", "swift", "go":
+		return p.writeManifestVersionFn(file.Format, file.Path, file.Field, version)
 	default:
 		return fmt.Errorf("unsupported format: %s", file.Format)
 	}
@@ -322,6 +322,13 @@ func TestInconsistency_String(t *testing.T) {
 	if got != want {
 		t.Errorf("Inconsistency.String() = %q, want %q", got, want)
 	}
+
+	inc.Field = "dependencies.core"
+	got = inc.String()
+	want = "package.json (dependencies.core): expected 1.2.3, found 1.2.2 (format: json)"
+	if got != want {
+		t.Errorf("Inconsistency.String() with field = %q, want %q", got, want)
+	}
 }
 
 func TestNormalizeVersion(t *testing.T) {
@@ -377,6 +384,7 @@ func TestReadVersionFromFile_AllFormats(t *testing.T) {
 	dc.readTOMLVersionFn = func(path, field string) (string, error) { return "1.0.0", nil }
 	dc.readRawVersionFn = func(path string) (string, error) { return "1.0.0", nil }
 	dc.readRegexVersionFn = func(path, pattern string) (string, error) { return "1.0.0", nil }
+	dc.readManifestVersionFn = func(format, path, field string) (string, error) { return "1.0.0", nil }
 
 	tests := []struct {
 		name    string
@@ -409,6 +417,16 @@ func TestReadVersionFromFile_AllFormats(t *testing.T) {
 			file:    FileConfig{Path: "version.go", Pattern: `const Version = "(.*?)"`, Format: "regex"},
 			wantErr: false,
 		},
+		{
+			name:    "xml format",
+			file:    FileConfig{Path: "pom.xml", Field: "project/version", Format: "xml"},
+			wantErr: false,
+		},
+		{
+			name:    "go format",
+			file:    FileConfig{Path: "version.go", Format: "go"},
+			wantErr: false,
+		},
 		{
 			name:    "regex format without pattern",
 			file:    FileConfig{Path: "version.go", Format: "regex"},
@@ -451,6 +469,7 @@ func TestWriteVersionToFile_AllFormats(t *testing.T) {
 	dc.writeTOMLVersionFn = func(path, field, version string) error { return nil }
 	dc.writeRawVersionFn = func(path, version string) error { return nil }
 	dc.writeRegexVersionFn = func(path, pattern, version string) error { return nil }
+	dc.writeManifestVersionFn = func(format, path, field, version string) error { return nil }
 
 	tests := []struct {
 		name    string
@@ -483,6 +502,16 @@ func TestWriteVersionToFile_AllFormats(t *testing.T) {
 			file:    FileConfig{Path: "version.go", Pattern: `const Version = "(.*?)"`, Format: "regex"},
 			wantErr: false,
 		},
+		{
+			name:    "xml format",
+			file:    FileConfig{Path: "pom.xml", Field: "project/version", Format: "xml"},
+			wantErr: false,
+		},
+		{
+			name:    "go format",
+			file:    FileConfig{Path: "version.go", Format: "go"},
+			wantErr: false,
+		},
 		{
 			name:    "regex format without pattern",
 			file:    FileConfig{Path: "version.go", Format: "regex"},
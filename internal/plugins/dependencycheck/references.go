@@ -0,0 +1,317 @@
+package dependencycheck
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/indaco/sley/internal/parser"
+	"github.com/indaco/sley/internal/semver"
+)
+
+// Reference policies control how the constraint of a dependency reference is
+// rewritten when the referenced module gets a new version.
+const (
+	// PolicyPreserve keeps the range operator and precision and replaces the
+	// version: "^1.4.0" -> "^1.5.0", "1.4" -> "1.5".
+	PolicyPreserve = "preserve"
+
+	// PolicyWiden leaves constraints that already admit the new version alone.
+	// Others are extended with an alternative ("^1.4.0 || ^2.0.0") where the
+	// format supports it, or updated as with PolicyPreserve.
+	PolicyWiden = "widen"
+
+	// PolicyPin replaces the constraint with the exact new version.
+	PolicyPin = "pin"
+)
+
+// workspacePrefix is the npm/pnpm/yarn workspace protocol ("workspace:^1.4.0").
+const workspacePrefix = "workspace:"
+
+// constraintRegex matches a single comparator such as "^1.4.0", ">= 1.4",
+// "=v1.4.0-rc.1" or "1".
+var constraintRegex = regexp.MustCompile(
+	`^(>=|<=|\^|~|=|>|<)?\s*(v?)(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z.-]+))?(?:\+([0-9A-Za-z.-]+))?$`,
+)
+
+// constraint is a parsed single-comparator version constraint.
+type constraint struct {
+	op      string
+	vPrefix string
+	parts   int // number of version components written (1-3)
+	version semver.SemVersion
+}
+
+// parseConstraint parses a single comparator. Compound ranges ("1.x",
+// ">=1.0 <2.0", "^1 || ^2") are rejected.
+func parseConstraint(s string) (constraint, error) {
+	m := constraintRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return constraint{}, fmt.Errorf("unsupported version constraint %q", s)
+	}
+
+	c := constraint{op: m[1], vPrefix: m[2], parts: 1}
+	c.version.Major, _ = strconv.ParseUint(m[3], 10, 64)
+	if m[4] != "" {
+		c.parts = 2
+		c.version.Minor, _ = strconv.ParseUint(m[4], 10, 64)
+	}
+	if m[5] != "" {
+		c.parts = 3
+		c.version.Patch, _ = strconv.ParseUint(m[5], 10, 64)
+	}
+	if (m[6] != "" || m[7] != "") && c.parts < 3 {
+		return constraint{}, fmt.Errorf("unsupported version constraint %q", s)
+	}
+	c.version.PreRelease, c.version.Build = m[6], m[7]
+	return c, nil
+}
+
+// bareOperator returns the meaning of a constraint without an operator:
+// exact in package.json, caret in Cargo.toml and a minimum in go.mod.
+func bareOperator(format string) string {
+	switch format {
+	case string(parser.FormatTOML):
+		return "^"
+	case string(parser.FormatGoMod):
+		return ">="
+	default:
+		return "="
+	}
+}
+
+// admits reports whether the constraint is satisfied by v.
+func (c constraint) admits(format string, v semver.SemVersion) bool {
+	op := c.op
+	if op == "" {
+		op = bareOperator(format)
+	}
+
+	cmp := v.Compare(c.version)
+	switch op {
+	case ">=":
+		return cmp >= 0
+	case ">":
+		return cmp > 0
+	case "<=":
+		return cmp <= 0
+	case "<":
+		return cmp < 0
+	case "=":
+		if c.parts == 3 {
+			return cmp == 0
+		}
+		return c.sameComponents(v, c.parts)
+	case "~":
+		return cmp >= 0 && c.sameComponents(v, min(c.parts, 2))
+	case "^":
+		return cmp >= 0 && c.sameComponents(v, c.caretComponents())
+	default:
+		return false
+	}
+}
+
+// caretComponents returns how many leading components a caret range locks:
+// everything up to and including the first non-zero one ("^0.2.3" locks
+// major and minor), or all written components when they are all zero.
+func (c constraint) caretComponents() int {
+	components := []uint64{c.version.Major, c.version.Minor, c.version.Patch}
+	for i := range c.parts {
+		if components[i] != 0 {
+			return i + 1
+		}
+	}
+	return c.parts
+}
+
+// sameComponents reports whether the first n components of v match.
+func (c constraint) sameComponents(v semver.SemVersion, n int) bool {
+	want := []uint64{c.version.Major, c.version.Minor, c.version.Patch}
+	got := []uint64{v.Major, v.Minor, v.Patch}
+	for i := range n {
+		if want[i] != got[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// with returns the constraint text for v, keeping the operator, the "v"
+// prefix and the number of components written.
+func (c constraint) with(v semver.SemVersion) string {
+	version := v.String()
+	if v.PreRelease == "" && v.Build == "" {
+		switch c.parts {
+		case 1:
+			version = strconv.FormatUint(v.Major, 10)
+		case 2:
+			version = fmt.Sprintf("%d.%d", v.Major, v.Minor)
+		}
+	}
+	return c.op + c.vPrefix + version
+}
+
+// goModMajorSuffix matches the major version suffix of a Go module path:
+// "example.com/core/v2" or gopkg.in's "gopkg.in/yaml.v3".
+var goModMajorSuffix = regexp.MustCompile(`(?:/v(\d+)|^gopkg\.in/.+\.v(\d+))$`)
+
+// goModVersion returns the go.mod version of module name at v. Go requires
+// a v2+ module to carry the major in its path ("example.com/core/v2"), so a
+// major that does not match the path is an error rather than a go.mod that
+// no longer builds. Modules required as "+incompatible" keep the suffix.
+func goModVersion(name, current string, v semver.SemVersion) (string, error) {
+	pathMajor := uint64(1)
+	if m := goModMajorSuffix.FindStringSubmatch(name); m != nil {
+		pathMajor, _ = strconv.ParseUint(m[1]+m[2], 10, 64)
+	}
+
+	switch {
+	case pathMajor == 1 && v.Major >= 2 && strings.HasSuffix(current, "+incompatible"):
+		return "v" + v.String() + "+incompatible", nil
+	case pathMajor == 1 && v.Major >= 2:
+		return "", fmt.Errorf("cannot require %s at v%s: a v2+ Go module needs the major in its module path (%s/v%d), so update the module path and imports instead",
+			name, v, name, v.Major)
+	case pathMajor >= 2 && v.Major != pathMajor:
+		return "", fmt.Errorf("cannot require %s at v%s: the module path only admits v%d versions", name, v, pathMajor)
+	default:
+		return "v" + v.String(), nil
+	}
+}
+
+// updateConstraint returns the constraint a reference to the dependency name
+// should have once the referenced module is at version, according to policy.
+func updateConstraint(format, policy, name, current, version string) (string, error) {
+	v, err := semver.ParseVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := ""
+	if format == string(parser.FormatJSON) {
+		if rest, ok := strings.CutPrefix(current, workspacePrefix); ok {
+			prefix, current = workspacePrefix, rest
+		}
+	}
+
+	if policy == PolicyPin {
+		switch format {
+		case string(parser.FormatTOML):
+			return "=" + v.String(), nil
+		case string(parser.FormatGoMod):
+			return goModVersion(name, current, v)
+		default:
+			return prefix + v.String(), nil
+		}
+	}
+
+	if policy == PolicyWiden {
+		// npm and composer ranges may list alternatives; keep them if any
+		// already admits the new version.
+		alternatives := strings.Split(current, "||")
+		if format != string(parser.FormatJSON) {
+			alternatives = []string{current}
+		}
+		for _, alt := range alternatives {
+			if c, err := parseConstraint(alt); err == nil && c.admits(format, v) {
+				return prefix + current, nil
+			}
+		}
+		if format == string(parser.FormatJSON) {
+			last, err := parseConstraint(alternatives[len(alternatives)-1])
+			if err != nil {
+				return "", err
+			}
+			return prefix + current + " || " + last.with(v), nil
+		}
+	}
+
+	if format == string(parser.FormatGoMod) {
+		return goModVersion(name, current, v)
+	}
+
+	c, err := parseConstraint(current)
+	if err != nil {
+		return "", err
+	}
+	return prefix + c.with(v), nil
+}
+
+// readReferences returns the references to a dependency in a manifest.
+func readReferences(format, path, name string) ([]parser.Reference, error) {
+	return getParserReader().ReadReferences(context.Background(), path, parser.Format(format), name)
+}
+
+// writeReferences rewrites the references to a dependency in a manifest.
+func writeReferences(format, path, name string, update func(parser.Reference) (string, error)) (int, error) {
+	return getParserWriter().WriteReferences(context.Background(), path, parser.Format(format), name, update)
+}
+
+// hasVersionField reports whether the file's own version field is managed,
+// which is always the case unless the file only lists references.
+func (f FileConfig) hasVersionField() bool {
+	return len(f.References) == 0 || f.Field != ""
+}
+
+// policy returns the configured reference policy, defaulting to PolicyPreserve.
+func (f FileConfig) policy() string {
+	if f.Policy == "" {
+		return PolicyPreserve
+	}
+	return f.Policy
+}
+
+// checkReferences reports the references following the root version that do
+// not match what a sync would write.
+func (p *DependencyCheckerPlugin) checkReferences(file FileConfig, version string) ([]Inconsistency, error) {
+	var inconsistencies []Inconsistency
+	for _, ref := range file.References {
+		if ref.Module != "" {
+			continue
+		}
+		found, err := p.readReferencesFn(file.Format, file.Path, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("dependency %q not found", ref.Name)
+		}
+		for _, r := range found {
+			want, err := updateConstraint(file.Format, file.policy(), ref.Name, r.Constraint, version)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", r.Section, ref.Name, err)
+			}
+			if want != r.Constraint {
+				inconsistencies = append(inconsistencies, Inconsistency{
+					Path:     file.Path,
+					Field:    r.Section + "." + ref.Name,
+					Expected: want,
+					Found:    r.Constraint,
+					Format:   file.Format,
+				})
+			}
+		}
+	}
+	return inconsistencies, nil
+}
+
+// syncReferences updates the references of a file that follow module
+// (empty for references following the root version).
+func (p *DependencyCheckerPlugin) syncReferences(file FileConfig, module, version string) error {
+	for _, ref := range file.References {
+		if ref.Module != module {
+			continue
+		}
+		n, err := p.writeReferencesFn(file.Format, file.Path, ref.Name, func(r parser.Reference) (string, error) {
+			return updateConstraint(file.Format, file.policy(), ref.Name, r.Constraint, version)
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("dependency %q not found", ref.Name)
+		}
+	}
+	return nil
+}
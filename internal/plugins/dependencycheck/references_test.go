@@ -0,0 +1,217 @@
+package dependencycheck
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpdateConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		format  string
+		policy  string
+		current string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{"caret", "json", PolicyPreserve, "^1.4.0", "1.5.0", "^1.5.0", false},
+		{"tilde", "json", PolicyPreserve, "~1.4.0", "1.4.2", "~1.4.2", false},
+		{"minimum with space", "json", PolicyPreserve, ">= 1.4.0", "2.0.0", ">=2.0.0", false},
+		{"exact", "json", PolicyPreserve, "=1.4.0", "1.5.0", "=1.5.0", false},
+		{"workspace protocol", "json", PolicyPreserve, "workspace:^1.4.0", "1.5.0", "workspace:^1.5.0", false},
+		{"keeps precision", "toml", PolicyPreserve, "1.4", "1.5.0", "1.5", false},
+		{"precision with pre-release", "toml", PolicyPreserve, "1.4", "1.5.0-rc.1", "1.5.0-rc.1", false},
+		{"gomod", "gomod", PolicyPreserve, "v1.4.0", "1.5.0", "v1.5.0", false},
+		{"compound range", "json", PolicyPreserve, ">=1.0.0 <2.0.0", "1.5.0", "", true},
+		{"wildcard", "json", PolicyPreserve, "1.x", "1.5.0", "", true},
+
+		{"pin json", "json", PolicyPin, "^1.4.0", "1.5.0", "1.5.0", false},
+		{"pin workspace", "json", PolicyPin, "workspace:^1.4.0", "1.5.0", "workspace:1.5.0", false},
+		{"pin toml", "toml", PolicyPin, "1.4", "1.5.0", "=1.5.0", false},
+		{"pin gomod", "gomod", PolicyPin, "v1.4.0", "1.5.0", "v1.5.0", false},
+
+		{"widen admitted caret", "json", PolicyWiden, "^1.4.0", "1.9.0", "^1.4.0", false},
+		{"widen admitted bare toml", "toml", PolicyWiden, "1.4", "1.9.0", "1.4", false},
+		{"widen admitted alternative", "json", PolicyWiden, "^1.4.0 || ^2.0.0", "2.1.0", "^1.4.0 || ^2.0.0", false},
+		{"widen json adds alternative", "json", PolicyWiden, "^1.4.0", "2.0.0", "^1.4.0 || ^2.0.0", false},
+		{"widen zero major caret", "json", PolicyWiden, "^0.4.0", "0.5.0", "^0.4.0 || ^0.5.0", false},
+		{"widen toml updates", "toml", PolicyWiden, "1.4", "2.0.0", "2.0", false},
+		{"widen gomod minimum", "gomod", PolicyWiden, "v1.4.0", "1.5.0", "v1.4.0", false},
+		{"widen tilde", "json", PolicyWiden, "~1.4.0", "1.5.0", "~1.4.0 || ~1.5.0", false},
+
+		{"invalid version", "json", PolicyPreserve, "^1.4.0", "next", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := updateConstraint(tt.format, tt.policy, "example.com/core", tt.current, tt.version)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("updateConstraint(%q, %q) = %q, want %q", tt.current, tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateConstraint_GoModMajor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		module  string
+		policy  string
+		current string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{"v1 path to v2", "example.com/core", PolicyPreserve, "v1.4.0", "2.0.0", "", true},
+		{"v1 path pinned to v2", "example.com/core", PolicyPin, "v1.4.0", "2.0.0", "", true},
+		{"v2 path within major", "example.com/core/v2", PolicyPreserve, "v2.1.0", "2.2.0", "v2.2.0", false},
+		{"v2 path to v3", "example.com/core/v2", PolicyPreserve, "v2.1.0", "3.0.0", "", true},
+		{"v2 path back to v1", "example.com/core/v2", PolicyPin, "v2.1.0", "1.9.0", "", true},
+		{"gopkg.in major", "gopkg.in/core.v3", PolicyPreserve, "v3.0.1", "3.1.0", "v3.1.0", false},
+		{"gopkg.in new major", "gopkg.in/core.v3", PolicyPreserve, "v3.0.1", "4.0.0", "", true},
+		{"incompatible keeps suffix", "example.com/core", PolicyPreserve, "v2.3.0+incompatible", "3.0.0", "v3.0.0+incompatible", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := updateConstraint("gomod", tt.policy, tt.module, tt.current, tt.version)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("updateConstraint(%q, %q) = %q, want %q", tt.current, tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncVersions_References(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pkg := filepath.Join(dir, "package.json")
+	cargo := filepath.Join(dir, "Cargo.toml")
+	gomod := filepath.Join(dir, "go.mod")
+
+	files := map[string]string{
+		pkg:   "{\n  \"name\": \"web\",\n  \"version\": \"0.1.0\",\n  \"dependencies\": {\n    \"@acme/core\": \"^1.4.0\",\n    \"@acme/ui\": \"~2.0.0\"\n  }\n}\n",
+		cargo: "[dependencies]\ncore = { version = \"1.4\", path = \"../core\" }\n",
+		gomod: "module example.com/app\n\nrequire example.com/core v1.4.0\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dc := NewDependencyChecker(&Config{
+		Enabled: true,
+		Files: []FileConfig{
+			{Path: pkg, Format: "json", References: []Reference{{Name: "@acme/core"}, {Name: "@acme/ui", Module: "ui"}}},
+			{Path: cargo, Format: "toml", References: []Reference{{Name: "core"}}, Policy: PolicyPin},
+			{Path: gomod, Format: "gomod", References: []Reference{{Name: "example.com/core"}}},
+		},
+	})
+
+	inconsistencies, err := dc.CheckConsistency("1.5.0")
+	if err != nil {
+		t.Fatalf("CheckConsistency() error = %v", err)
+	}
+	if len(inconsistencies) != 3 {
+		t.Errorf("CheckConsistency() = %v, want 3 inconsistencies", inconsistencies)
+	}
+
+	if err := dc.SyncVersions("1.5.0"); err != nil {
+		t.Fatalf("SyncVersions() error = %v", err)
+	}
+	if err := dc.SyncReferences("ui", "2.1.0"); err != nil {
+		t.Fatalf("SyncReferences() error = %v", err)
+	}
+
+	want := map[string]string{
+		pkg:   "{\n  \"name\": \"web\",\n  \"version\": \"0.1.0\",\n  \"dependencies\": {\n    \"@acme/core\": \"^1.5.0\",\n    \"@acme/ui\": \"~2.1.0\"\n  }\n}\n",
+		cargo: "[dependencies]\ncore = { version = \"=1.5.0\", path = \"../core\" }\n",
+		gomod: "module example.com/app\n\nrequire example.com/core v1.5.0\n",
+	}
+	for path, content := range want {
+		got, _ := os.ReadFile(path)
+		if string(got) != content {
+			t.Errorf("%s = %q, want %q", filepath.Base(path), got, content)
+		}
+	}
+
+	inconsistencies, err = dc.CheckConsistency("1.5.0")
+	if err != nil {
+		t.Fatalf("CheckConsistency() error = %v", err)
+	}
+	if len(inconsistencies) != 0 {
+		t.Errorf("CheckConsistency() after sync = %v, want none", inconsistencies)
+	}
+}
+
+func TestSyncVersions_GoModMajorBump(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "go.mod")
+	content := "module example.com/app\n\nrequire example.com/core v1.4.0\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dc := NewDependencyChecker(&Config{
+		Enabled: true,
+		Files:   []FileConfig{{Path: path, Format: "gomod", References: []Reference{{Name: "example.com/core"}}}},
+	})
+	err := dc.SyncVersions("2.0.0")
+	if err == nil || !strings.Contains(err.Error(), "example.com/core/v2") {
+		t.Errorf("SyncVersions() error = %v, want module path error", err)
+	}
+
+	got, _ := os.ReadFile(path)
+	if string(got) != content {
+		t.Errorf("go.mod = %q, want it unchanged", got)
+	}
+}
+
+func TestSyncVersions_ReferenceNotFound(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "package.json")
+	if err := os.WriteFile(path, []byte(`{"dependencies": {}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dc := NewDependencyChecker(&Config{
+		Enabled: true,
+		Files:   []FileConfig{{Path: path, Format: "json", References: []Reference{{Name: "@acme/core"}}}},
+	})
+	if err := dc.SyncVersions("1.5.0"); err == nil {
+		t.Error("SyncVersions() expected error for missing dependency, got nil")
+	}
+}
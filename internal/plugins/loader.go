@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/plugins/auditlog"
 	"github.com/indaco/sley/internal/plugins/changeloggenerator"
 	"github.com/indaco/sley/internal/plugins/changelogparser"
@@ -13,24 +14,56 @@ import (
 	"github.com/indaco/sley/internal/plugins/releasegate"
 	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/plugins/versionvalidator"
+	"github.com/indaco/sley/internal/semver"
 )
 
 // RegisterBuiltinPlugins registers all builtin plugins with the provided registry.
 func RegisterBuiltinPlugins(cfg *config.Config, registry *PluginRegistry) {
-	if cfg == nil || cfg.Plugins == nil {
+	if cfg == nil {
+		return
+	}
+
+	if len(cfg.ReleaseLines) > 0 {
+		registry.SetReleaseLine(resolveReleaseLine(cfg, tagmanager.ReadBranch()))
+	}
+	if cfg.Plugins == nil {
 		return
 	}
 
 	registerCommitParser(cfg.Plugins, registry)
 	registerTagManager(cfg.Plugins, registry)
-	registerVersionValidator(cfg.Plugins, registry)
+	registerVersionValidator(cfg, registry)
 	registerDependencyCheck(cfg.Plugins, registry)
 	registerChangelogParser(cfg.Plugins, registry)
 	registerChangelogGenerator(cfg.Plugins, registry)
-	registerReleaseGate(cfg.Plugins, registry)
+	registerReleaseGate(cfg, registry)
 	registerAuditLog(cfg.Plugins, registry)
 }
 
+// resolveReleaseLine returns the release line of branch when it matches a
+// configured maintenance branch, or nil otherwise.
+func resolveReleaseLine(cfg *config.Config, branch string) *semver.ReleaseLine {
+	rl, ok := cfg.ReleaseLineForBranch(branch)
+	if !ok {
+		return nil
+	}
+
+	var (
+		line semver.ReleaseLine
+		err  error
+	)
+	if rl.Line == "" {
+		line, err = semver.ReleaseLineFromBranch(branch)
+	} else {
+		line, err = semver.ParseReleaseLine(rl.Line)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: release-lines: %v\n", err)
+		return nil
+	}
+	return &line
+}
+
 func registerCommitParser(plugins *config.PluginConfig, registry *PluginRegistry) {
 	if plugins.CommitParser {
 		plugin := commitparser.NewCommitParser()
@@ -50,9 +83,14 @@ func registerTagManager(plugins *config.PluginConfig, registry *PluginRegistry)
 			Push:                  plugins.TagManager.Push,
 			TagPrereleases:        plugins.TagManager.GetTagPrereleases(),
 			Sign:                  plugins.TagManager.GetSign(),
+			SigningFormat:         plugins.TagManager.GetSigningFormat(),
 			SigningKey:            plugins.TagManager.GetSigningKey(),
+			SigningProgram:        plugins.TagManager.SigningProgram,
+			AllowedSignersFile:    plugins.TagManager.AllowedSignersFile,
 			MessageTemplate:       plugins.TagManager.GetMessageTemplate(),
 			CommitMessageTemplate: plugins.TagManager.GetCommitMessageTemplate(),
+			Aliases:               plugins.TagManager.Aliases,
+			Line:                  registry.ReleaseLine(),
 		}
 		plugin := tagmanager.NewTagManager(tmCfg)
 		if err := registry.RegisterTagManager(plugin); err != nil {
@@ -61,11 +99,14 @@ func registerTagManager(plugins *config.PluginConfig, registry *PluginRegistry)
 	}
 }
 
-func registerVersionValidator(plugins *config.PluginConfig, registry *PluginRegistry) {
+func registerVersionValidator(cfg *config.Config, registry *PluginRegistry) {
+	plugins := cfg.Plugins
 	if plugins.VersionValidator != nil && plugins.VersionValidator.Enabled {
 		vvCfg := &versionvalidator.Config{
-			Enabled: true,
-			Rules:   convertValidationRules(plugins.VersionValidator.Rules),
+			Enabled:     true,
+			Rules:       convertValidationRules(plugins.VersionValidator.Rules),
+			Channels:    cfg.GetPreReleaseChannels(),
+			ReleaseLine: registry.ReleaseLine(),
 		}
 		plugin := versionvalidator.NewVersionValidator(vvCfg)
 		if err := registry.RegisterVersionValidator(plugin); err != nil {
@@ -102,15 +143,19 @@ func registerChangelogGenerator(plugins *config.PluginConfig, registry *PluginRe
 			fmt.Fprintf(os.Stderr, "Warning: failed to create changelog generator: %v\n", err)
 			return
 		}
+		plugin.SetReleaseLine(registry.ReleaseLine())
 		if err := registry.RegisterChangelogGenerator(plugin); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
 		}
 	}
 }
 
-func registerReleaseGate(plugins *config.PluginConfig, registry *PluginRegistry) {
+func registerReleaseGate(cfg *config.Config, registry *PluginRegistry) {
+	plugins := cfg.Plugins
 	if plugins.ReleaseGate != nil && plugins.ReleaseGate.Enabled {
-		rgCfg := convertReleaseGateConfig(plugins.ReleaseGate)
+		rgCfg := convertReleaseGateConfig(plugins.ReleaseGate, plugins.TagManager)
+		channels := cfg.GetPreReleaseChannels()
+		rgCfg.RCChannel = channels[len(channels)-1]
 		plugin := releasegate.NewReleaseGate(rgCfg)
 		if err := registry.RegisterReleaseGate(plugin); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
@@ -153,6 +198,13 @@ func convertDependencyCheckConfig(cfg *config.DependencyCheckConfig) *dependency
 			Field:   f.Field,
 			Format:  f.Format,
 			Pattern: f.Pattern,
+			Policy:  f.Policy,
+		}
+		for _, ref := range f.References {
+			files[i].References = append(files[i].References, dependencycheck.Reference{
+				Name:   ref.Name,
+				Module: ref.Module,
+			})
 		}
 	}
 	return &dependencycheck.Config{
@@ -174,7 +226,11 @@ func convertChangelogParserConfig(cfg *config.ChangelogParserConfig) *changelogp
 }
 
 // convertReleaseGateConfig converts config to releasegate config.
-func convertReleaseGateConfig(cfg *config.ReleaseGateConfig) *releasegate.Config {
+// Signed tags are verified with the tag-manager prefix and signing settings.
+func convertReleaseGateConfig(cfg *config.ReleaseGateConfig, tagCfg *config.TagManagerConfig) *releasegate.Config {
+	if tagCfg == nil {
+		tagCfg = &config.TagManagerConfig{}
+	}
 	return &releasegate.Config{
 		Enabled:              cfg.Enabled,
 		RequireCleanWorktree: cfg.RequireCleanWorktree,
@@ -182,5 +238,16 @@ func convertReleaseGateConfig(cfg *config.ReleaseGateConfig) *releasegate.Config
 		BlockedOnWIPCommits:  cfg.BlockedOnWIPCommits,
 		AllowedBranches:      cfg.AllowedBranches,
 		BlockedBranches:      cfg.BlockedBranches,
+		RequireChangelogLint: cfg.RequireChangelogLint,
+		ChangelogPath:        cfg.GetChangelogPath(),
+		RequireSignedTag:     cfg.RequireSignedTag,
+		RequireRCForMajor:    cfg.RequireRCForMajor,
+		TagPrefix:            tagCfg.GetPrefix(),
+		TagSigning: core.TagSigning{
+			Format:             tagCfg.GetSigningFormat(),
+			Key:                tagCfg.GetSigningKey(),
+			AllowedSignersFile: tagCfg.AllowedSignersFile,
+			Program:            tagCfg.SigningProgram,
+		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/plugins/versionvalidator"
 )
 
@@ -648,6 +649,29 @@ func TestConvertDependencyCheckConfig_FileDetails(t *testing.T) {
 	}
 }
 
+func TestConvertDependencyCheckConfig_References(t *testing.T) {
+	t.Parallel()
+	input := &config.DependencyCheckConfig{
+		Enabled: true,
+		Files: []config.DependencyFileConfig{
+			{
+				Path:       "go.mod",
+				Format:     "gomod",
+				Policy:     "pin",
+				References: []config.DependencyReferenceConfig{{Name: "example.com/core", Module: "core"}},
+			},
+		},
+	}
+
+	file := convertDependencyCheckConfig(input).Files[0]
+	if file.Policy != "pin" {
+		t.Errorf("expected policy 'pin', got %q", file.Policy)
+	}
+	if len(file.References) != 1 || file.References[0].Name != "example.com/core" || file.References[0].Module != "core" {
+		t.Errorf("unexpected references: %+v", file.References)
+	}
+}
+
 func TestConvertChangelogParserConfig(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -750,7 +774,7 @@ func TestConvertReleaseGateConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			result := convertReleaseGateConfig(tt.input)
+			result := convertReleaseGateConfig(tt.input, nil)
 			if result.Enabled != tt.wantEnabled {
 				t.Errorf("expected Enabled %v, got %v", tt.wantEnabled, result.Enabled)
 			}
@@ -772,3 +796,69 @@ func TestConvertReleaseGateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertReleaseGateConfig_TagSigning(t *testing.T) {
+	t.Parallel()
+
+	result := convertReleaseGateConfig(
+		&config.ReleaseGateConfig{Enabled: true, RequireSignedTag: true},
+		&config.TagManagerConfig{
+			Prefix:             "release-",
+			SigningFormat:      "ssh",
+			SigningKey:         "~/.ssh/id_ed25519.pub",
+			AllowedSignersFile: ".github/allowed_signers",
+		},
+	)
+	if !result.RequireSignedTag {
+		t.Error("expected RequireSignedTag to be true")
+	}
+	if result.TagPrefix != "release-" {
+		t.Errorf("expected TagPrefix 'release-', got %q", result.TagPrefix)
+	}
+	want := core.TagSigning{Format: "ssh", Key: "~/.ssh/id_ed25519.pub", AllowedSignersFile: ".github/allowed_signers"}
+	if result.TagSigning != want {
+		t.Errorf("expected TagSigning %+v, got %+v", want, result.TagSigning)
+	}
+
+	defaults := convertReleaseGateConfig(&config.ReleaseGateConfig{Enabled: true}, nil)
+	if defaults.TagPrefix != "v" || defaults.TagSigning.Format != "gpg" {
+		t.Errorf("expected default prefix and gpg format, got %q and %q", defaults.TagPrefix, defaults.TagSigning.Format)
+	}
+}
+
+func TestResolveReleaseLine(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{
+		ReleaseLines: []config.ReleaseLineConfig{
+			{Branch: "release/*"},
+			{Branch: "support/legacy", Line: "0.9.x"},
+			{Branch: "maint/*", Line: "not-a-line"},
+		},
+	}
+
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{branch: "release/1.x", want: "1.x"},
+		{branch: "release/1.3.x", want: "1.3.x"},
+		{branch: "support/legacy", want: "0.9.x"},
+		{branch: "main", want: ""},
+		{branch: "release/next", want: ""},
+		{branch: "maint/1.x", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			t.Parallel()
+			line := resolveReleaseLine(cfg, tt.branch)
+			got := ""
+			if line != nil {
+				got = line.String()
+			}
+			if got != tt.want {
+				t.Errorf("resolveReleaseLine(%q) = %q, want %q", tt.branch, got, tt.want)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/indaco/sley/internal/plugins/releasegate"
 	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/plugins/versionvalidator"
+	"github.com/indaco/sley/internal/semver"
 )
 
 // PluginRegistry is a thread-safe registry for all plugin instances.
@@ -26,6 +27,7 @@ type PluginRegistry struct {
 	changelogGenerator changeloggenerator.ChangelogGenerator
 	releaseGate        releasegate.ReleaseGate
 	auditLog           auditlog.AuditLog
+	releaseLine        *semver.ReleaseLine
 }
 
 // NewPluginRegistry creates a new empty plugin registry.
@@ -193,6 +195,21 @@ func (r *PluginRegistry) GetAuditLog() auditlog.AuditLog {
 	return r.auditLog
 }
 
+// SetReleaseLine records the release line of the current maintenance branch.
+func (r *PluginRegistry) SetReleaseLine(line *semver.ReleaseLine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.releaseLine = line
+}
+
+// ReleaseLine returns the release line of the current branch, or nil if the
+// branch is not a configured maintenance branch.
+func (r *PluginRegistry) ReleaseLine() *semver.ReleaseLine {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.releaseLine
+}
+
 // Reset clears all registered plugins. Useful for testing.
 func (r *PluginRegistry) Reset() {
 	r.mu.Lock()
@@ -206,4 +223,5 @@ func (r *PluginRegistry) Reset() {
 	r.changelogGenerator = nil
 	r.releaseGate = nil
 	r.auditLog = nil
+	r.releaseLine = nil
 }
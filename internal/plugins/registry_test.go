@@ -41,6 +41,7 @@ func (m *mockTagManager) FormatTagName(version semver.SemVersion) string      {
 func (m *mockTagManager) IsAutoCreateEnabled() bool                           { return false }
 func (m *mockTagManager) GetConfig() *tagmanager.Config                       { return tagmanager.DefaultConfig() }
 func (m *mockTagManager) CommitChanges(_ semver.SemVersion, _ []string) error { return nil }
+func (m *mockTagManager) UpdateAliases(_ semver.SemVersion) ([]string, error) { return nil, nil }
 
 func TestPluginRegistry_CommitParser(t *testing.T) {
 	t.Parallel()
@@ -1,5 +1,7 @@
 package releasegate
 
+import "github.com/indaco/sley/internal/core"
+
 // Config holds configuration for the release gate plugin.
 type Config struct {
 	// Enabled controls whether the plugin is active.
@@ -19,6 +21,28 @@ type Config struct {
 
 	// BlockedBranches lists branches where bumps are never allowed.
 	BlockedBranches []string
+
+	// RequireChangelogLint blocks bumps if the changelog has lint issues.
+	RequireChangelogLint bool
+
+	// ChangelogPath is the changelog checked by RequireChangelogLint.
+	ChangelogPath string
+
+	// RequireSignedTag blocks bumps if the previous release tag is not validly signed.
+	RequireSignedTag bool
+
+	// TagPrefix selects the release tags checked by RequireSignedTag.
+	TagPrefix string
+
+	// TagSigning configures how RequireSignedTag verifies signatures.
+	TagSigning core.TagSigning
+
+	// RequireRCForMajor blocks stable major releases that were not
+	// promoted from a pre-release on RCChannel.
+	RequireRCForMajor bool
+
+	// RCChannel is the pre-release channel required by RequireRCForMajor.
+	RCChannel string
 }
 
 // DefaultConfig returns the default release gate configuration.
@@ -30,5 +54,8 @@ func DefaultConfig() *Config {
 		BlockedOnWIPCommits:  true,
 		AllowedBranches:      []string{},
 		BlockedBranches:      []string{},
+		ChangelogPath:        "CHANGELOG.md",
+		TagPrefix:            "v",
+		RCChannel:            "rc",
 	}
 }
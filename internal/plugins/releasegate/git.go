@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"os/exec"
 	"strings"
+
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
 )
 
 // GitOperations defines the interface for git operations used by release gate.
@@ -17,6 +20,16 @@ type GitOperations interface {
 
 	// GetRecentCommits retrieves the last N commit messages.
 	GetRecentCommits(count int) ([]string, error)
+
+	// ListTags retrieves all git tag names.
+	ListTags() ([]string, error)
+
+	// GetLatestTag retrieves the most recent tag reachable from HEAD whose
+	// name starts with prefix.
+	GetLatestTag(prefix string) (string, error)
+
+	// VerifyTag verifies the signature of a tag.
+	VerifyTag(name string, signing core.TagSigning) error
 }
 
 // OSGitOperations implements GitOperations using actual git commands.
@@ -105,3 +118,66 @@ func (g *OSGitOperations) GetRecentCommits(count int) ([]string, error) {
 	commits := strings.Split(output, "\n")
 	return commits, nil
 }
+
+// ListTags retrieves all git tag names.
+func (g *OSGitOperations) ListTags() ([]string, error) {
+	cmd := g.execCommand("git", "tag", "--list")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return nil, fmt.Errorf("failed to list tags: %s: %w", stderrMsg, err)
+		}
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	output := strings.TrimSpace(stdout.String())
+	if output == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(output, "\n"), nil
+}
+
+// GetLatestTag retrieves the most recent tag reachable from HEAD whose name
+// starts with prefix.
+func (g *OSGitOperations) GetLatestTag(prefix string) (string, error) {
+	cmd := g.execCommand("git", "describe", "--tags", "--abbrev=0", "--match", prefix+"*")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return "", fmt.Errorf("failed to find latest tag: %s: %w", stderrMsg, err)
+		}
+		return "", fmt.Errorf("failed to find latest tag: %w", err)
+	}
+
+	tag := strings.TrimSpace(stdout.String())
+	if tag == "" {
+		return "", fmt.Errorf("no tags found")
+	}
+	return tag, nil
+}
+
+// VerifyTag verifies the signature of a tag with git tag -v.
+func (g *OSGitOperations) VerifyTag(name string, signing core.TagSigning) error {
+	args := append(tagmanager.SigningConfigArgs(signing), "tag", "-v", name)
+	cmd := g.execCommand("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return fmt.Errorf("git tag verify failed: %w", err)
+	}
+	return nil
+}
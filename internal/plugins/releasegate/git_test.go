@@ -2,7 +2,11 @@ package releasegate
 
 import (
 	"os/exec"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/indaco/sley/internal/core"
 )
 
 // createTestGitOps creates an OSGitOperations with a custom exec.Command for testing.
@@ -311,3 +315,77 @@ func TestNewOSGitOperations(t *testing.T) {
 		t.Error("execCommand should not be nil")
 	}
 }
+
+func TestOSGitOperations_ListTags(t *testing.T) {
+	t.Parallel()
+	ops := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		return exec.Command("printf", "v1.0.0\nv1.1.0\n")
+	})
+
+	tags, err := ops.ListTags()
+	if err != nil {
+		t.Fatalf("ListTags() unexpected error: %v", err)
+	}
+	if len(tags) != 2 || tags[0] != "v1.0.0" || tags[1] != "v1.1.0" {
+		t.Errorf("ListTags() = %v, want [v1.0.0 v1.1.0]", tags)
+	}
+
+	empty := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		return exec.Command("true")
+	})
+	if tags, err := empty.ListTags(); err != nil || len(tags) != 0 {
+		t.Errorf("ListTags() = %v, %v, want no tags", tags, err)
+	}
+
+	failing := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo 'not a git repo' >&2 && exit 1")
+	})
+	if _, err := failing.ListTags(); err == nil {
+		t.Error("ListTags() expected error")
+	}
+}
+
+func TestOSGitOperations_GetLatestTag(t *testing.T) {
+	t.Parallel()
+	ops := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		want := []string{"describe", "--tags", "--abbrev=0", "--match", "v*"}
+		if !slices.Equal(args, want) {
+			t.Errorf("args = %v, want %v", args, want)
+		}
+		return exec.Command("echo", "v1.1.0")
+	})
+
+	tag, err := ops.GetLatestTag("v")
+	if err != nil || tag != "v1.1.0" {
+		t.Errorf("GetLatestTag() = %q, %v, want v1.1.0", tag, err)
+	}
+
+	failing := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo 'fatal: No names found' >&2 && exit 128")
+	})
+	if _, err := failing.GetLatestTag("v"); err == nil {
+		t.Error("GetLatestTag() expected error")
+	}
+}
+
+func TestOSGitOperations_VerifyTag(t *testing.T) {
+	t.Parallel()
+	ops := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		want := []string{"-c", "gpg.format=x509", "-c", "gpg.x509.program=gitsign", "tag", "-v", "v1.0.0"}
+		if !slices.Equal(args, want) {
+			t.Errorf("args = %v, want %v", args, want)
+		}
+		return exec.Command("true")
+	})
+	if err := ops.VerifyTag("v1.0.0", core.TagSigning{Format: "x509"}); err != nil {
+		t.Errorf("VerifyTag() unexpected error: %v", err)
+	}
+
+	failing := createTestGitOps(func(name string, args ...string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo 'error: no signature found' >&2 && exit 1")
+	})
+	err := failing.VerifyTag("v1.0.0", core.TagSigning{})
+	if err == nil || !strings.Contains(err.Error(), "no signature found") {
+		t.Errorf("VerifyTag() error = %v, want no signature error", err)
+	}
+}
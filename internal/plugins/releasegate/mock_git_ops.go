@@ -1,10 +1,19 @@
 package releasegate
 
+import (
+	"fmt"
+
+	"github.com/indaco/sley/internal/core"
+)
+
 // MockGitOperations is a mock implementation of GitOperations for testing.
 type MockGitOperations struct {
 	IsWorktreeCleanFn  func() (bool, error)
 	GetCurrentBranchFn func() (string, error)
 	GetRecentCommitsFn func(count int) ([]string, error)
+	ListTagsFn         func() ([]string, error)
+	GetLatestTagFn     func(prefix string) (string, error)
+	VerifyTagFn        func(name string, signing core.TagSigning) error
 }
 
 // Verify MockGitOperations implements GitOperations.
@@ -33,3 +42,27 @@ func (m *MockGitOperations) GetRecentCommits(count int) ([]string, error) {
 	}
 	return []string{}, nil
 }
+
+// ListTags implements GitOperations.
+func (m *MockGitOperations) ListTags() ([]string, error) {
+	if m.ListTagsFn != nil {
+		return m.ListTagsFn()
+	}
+	return []string{}, nil
+}
+
+// GetLatestTag implements GitOperations.
+func (m *MockGitOperations) GetLatestTag(prefix string) (string, error) {
+	if m.GetLatestTagFn != nil {
+		return m.GetLatestTagFn(prefix)
+	}
+	return "", fmt.Errorf("no tags found")
+}
+
+// VerifyTag implements GitOperations.
+func (m *MockGitOperations) VerifyTag(name string, signing core.TagSigning) error {
+	if m.VerifyTagFn != nil {
+		return m.VerifyTagFn(name, signing)
+	}
+	return nil
+}
@@ -2,9 +2,11 @@ package releasegate
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 
+	"github.com/indaco/sley/internal/plugins/changelogparser"
 	"github.com/indaco/sley/internal/semver"
 )
 
@@ -97,6 +99,27 @@ func (p *ReleaseGatePlugin) ValidateRelease(newVersion, previousVersion semver.S
 		}
 	}
 
+	// Check the signature of the previous release tag
+	if p.cfg.RequireSignedTag {
+		if err := p.checkSignedTag(); err != nil {
+			return err
+		}
+	}
+
+	// Check that major releases went through the rc channel
+	if p.cfg.RequireRCForMajor {
+		if err := p.checkRCForMajor(newVersion, previousVersion); err != nil {
+			return err
+		}
+	}
+
+	// Check the changelog
+	if p.cfg.RequireChangelogLint {
+		if err := p.checkChangelogLint(newVersion); err != nil {
+			return err
+		}
+	}
+
 	// CI status check is not yet implemented
 	// When enabled, this will check CI status before allowing bumps
 	// For now, we skip this check even if enabled
@@ -217,3 +240,69 @@ func matchBranchPattern(pattern, branch string) (bool, error) {
 
 	return re.MatchString(branch), nil
 }
+
+// checkChangelogLint verifies that the changelog has no lint issues. The
+// version being released is not tagged yet, so it counts as tagged.
+func (p *ReleaseGatePlugin) checkChangelogLint(newVersion semver.SemVersion) error {
+	path := p.cfg.ChangelogPath
+	if path == "" {
+		path = "CHANGELOG.md"
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("release-gate: failed to read changelog: %w", err)
+	}
+
+	opts := changelogparser.LintOptions{}
+	// If we can't list tags, skip the tag check
+	if tags, err := p.gitOps.ListTags(); err == nil {
+		opts.Tags = append(tags, newVersion.String())
+	}
+
+	issues := changelogparser.LintChangelog(string(data), opts)
+	if len(issues) > 0 {
+		return fmt.Errorf("release-gate: changelog %s has %d lint issue(s), first: %s. Run 'sley changelog lint' for details", path, len(issues), issues[0])
+	}
+
+	return nil
+}
+
+// checkSignedTag verifies that the previous release tag is validly signed.
+func (p *ReleaseGatePlugin) checkSignedTag() error {
+	tag, err := p.gitOps.GetLatestTag(p.cfg.TagPrefix)
+	if err != nil {
+		// No previous release to check (or no git repository)
+		return nil
+	}
+
+	if err := p.gitOps.VerifyTag(tag, p.cfg.TagSigning); err != nil {
+		return fmt.Errorf("release-gate: previous release tag %s is not validly signed: %w", tag, err)
+	}
+
+	return nil
+}
+
+// checkRCForMajor requires a stable major release (X.0.0) to be promoted from
+// a pre-release of the same version on the rc channel.
+func (p *ReleaseGatePlugin) checkRCForMajor(newVersion, previousVersion semver.SemVersion) error {
+	if newVersion.PreRelease != "" || newVersion.Major == 0 || newVersion.Minor != 0 || newVersion.Patch != 0 {
+		return nil
+	}
+
+	channel := p.cfg.RCChannel
+	if channel == "" {
+		channel = "rc"
+	}
+
+	sameVersion := previousVersion.Major == newVersion.Major &&
+		previousVersion.Minor == newVersion.Minor &&
+		previousVersion.Patch == newVersion.Patch
+	if sameVersion && previousVersion.PreRelease != "" &&
+		semver.ExtractPreReleaseBase(previousVersion.PreRelease) == channel {
+		return nil
+	}
+
+	return fmt.Errorf("release-gate: major release %s requires a %s pre-release first (e.g., %s-%s.1)",
+		newVersion.String(), channel, newVersion.String(), channel)
+}
@@ -2,8 +2,11 @@ package releasegate
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/semver"
 )
 
@@ -428,6 +431,196 @@ func TestReleaseGatePlugin_CheckWIPCommits(t *testing.T) {
 	}
 }
 
+func TestReleaseGatePlugin_CheckChangelogLint(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		content     string
+		tags        []string
+		tagsErr     error
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "clean changelog",
+			content: "## v1.1.0 - 2024-02-01\n\n- B\n\n## v1.0.0 - 2024-01-01\n\n- A\n",
+			tags:    []string{"v1.0.0", "v1.1.0"},
+		},
+		{
+			name:    "version being released needs no tag",
+			content: "## v1.2.0 - 2024-03-01\n\n- C\n\n## v1.0.0 - 2024-01-01\n\n- A\n",
+			tags:    []string{"v1.0.0"},
+		},
+		{
+			name:        "unsorted versions",
+			content:     "## v1.0.0 - 2024-01-01\n\n- A\n\n## v1.1.0 - 2024-02-01\n\n- B\n",
+			tags:        []string{"v1.0.0", "v1.1.0"},
+			wantErr:     true,
+			errContains: "unsorted-versions",
+		},
+		{
+			name:        "untagged version",
+			content:     "## v1.1.0 - 2024-02-01\n\n- B\n\n## v1.0.0 - 2024-01-01\n\n- A\n",
+			tags:        []string{"v1.0.0"},
+			wantErr:     true,
+			errContains: "missing-tag",
+		},
+		{
+			name:    "git error skips tag check",
+			content: "## v1.1.0 - 2024-02-01\n\n- B\n",
+			tagsErr: errors.New("not a git repository"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "CHANGELOG.md")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			mockOps := &MockGitOperations{
+				ListTagsFn: func() ([]string, error) {
+					return tt.tags, tt.tagsErr
+				},
+			}
+
+			plugin := NewReleaseGateWithOps(&Config{
+				Enabled:              true,
+				RequireChangelogLint: true,
+				ChangelogPath:        path,
+			}, mockOps)
+
+			err := plugin.ValidateRelease(semver.SemVersion{Major: 1, Minor: 2}, semver.SemVersion{Major: 1, Minor: 1}, "minor")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("ValidateRelease() expected error, got nil")
+				} else if !containsString(err.Error(), tt.errContains) {
+					t.Errorf("ValidateRelease() error = %q, want to contain %q", err.Error(), tt.errContains)
+				}
+			} else if err != nil {
+				t.Errorf("ValidateRelease() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestReleaseGatePlugin_CheckChangelogLint_MissingFile(t *testing.T) {
+	t.Parallel()
+	plugin := NewReleaseGateWithOps(&Config{
+		Enabled:              true,
+		RequireChangelogLint: true,
+		ChangelogPath:        filepath.Join(t.TempDir(), "missing.md"),
+	}, &MockGitOperations{})
+
+	err := plugin.ValidateRelease(semver.SemVersion{Major: 1}, semver.SemVersion{}, "major")
+	if err == nil || !containsString(err.Error(), "failed to read changelog") {
+		t.Errorf("ValidateRelease() error = %v, want read error", err)
+	}
+}
+
+func TestReleaseGatePlugin_CheckSignedTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		latestErr   error
+		verifyErr   error
+		wantErr     bool
+		errContains string
+	}{
+		{name: "valid signature"},
+		{name: "no previous tag skips check", latestErr: errors.New("no tags found"), verifyErr: errors.New("unreachable")},
+		{
+			name:        "invalid signature",
+			verifyErr:   errors.New("error: no signature found"),
+			wantErr:     true,
+			errContains: "previous release tag v1.1.0 is not validly signed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gotPrefix string
+			var gotSigning core.TagSigning
+			mockOps := &MockGitOperations{
+				GetLatestTagFn: func(prefix string) (string, error) {
+					gotPrefix = prefix
+					return "v1.1.0", tt.latestErr
+				},
+				VerifyTagFn: func(name string, signing core.TagSigning) error {
+					gotSigning = signing
+					return tt.verifyErr
+				},
+			}
+
+			plugin := NewReleaseGateWithOps(&Config{
+				Enabled:          true,
+				RequireSignedTag: true,
+				TagPrefix:        "v",
+				TagSigning:       core.TagSigning{Format: "ssh", AllowedSignersFile: ".allowed_signers"},
+			}, mockOps)
+
+			err := plugin.ValidateRelease(semver.SemVersion{Major: 1, Minor: 2}, semver.SemVersion{Major: 1, Minor: 1}, "minor")
+
+			if gotPrefix != "v" {
+				t.Errorf("GetLatestTag() prefix = %q, want %q", gotPrefix, "v")
+			}
+			if tt.latestErr == nil && gotSigning.Format != "ssh" {
+				t.Errorf("VerifyTag() signing = %+v, want ssh format", gotSigning)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Error("ValidateRelease() expected error, got nil")
+				} else if !containsString(err.Error(), tt.errContains) {
+					t.Errorf("ValidateRelease() error = %q, want to contain %q", err.Error(), tt.errContains)
+				}
+			} else if err != nil {
+				t.Errorf("ValidateRelease() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestReleaseGatePlugin_CheckRCForMajor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		channel  string
+		previous string
+		next     string
+		wantErr  bool
+	}{
+		{name: "promoted from rc", previous: "2.0.0-rc.2", next: "2.0.0"},
+		{name: "promoted from beta", previous: "2.0.0-beta.1", next: "2.0.0", wantErr: true},
+		{name: "direct major bump", previous: "1.4.0", next: "2.0.0", wantErr: true},
+		{name: "rc of another version", previous: "1.4.0-rc.1", next: "2.0.0", wantErr: true},
+		{name: "custom channel", channel: "preview", previous: "2.0.0-preview.1", next: "2.0.0"},
+		{name: "minor release", previous: "1.3.0", next: "1.4.0"},
+		{name: "major pre-release", previous: "1.4.0", next: "2.0.0-alpha.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			plugin := NewReleaseGateWithOps(&Config{
+				Enabled:           true,
+				RequireRCForMajor: true,
+				RCChannel:         tt.channel,
+			}, &MockGitOperations{})
+
+			previous, _ := semver.ParseVersion(tt.previous)
+			next, _ := semver.ParseVersion(tt.next)
+			err := plugin.ValidateRelease(next, previous, "major")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRelease() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestReleaseGatePlugin_ValidateRelease_Integration(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
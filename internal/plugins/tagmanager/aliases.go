@@ -0,0 +1,154 @@
+package tagmanager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/indaco/sley/internal/semver"
+)
+
+// Built-in alias kinds for the aliases option. Any other entry is treated
+// as a template using the tag message placeholders (e.g. "{prefix}{major}-stable").
+const (
+	AliasMajor  = "major"  // {prefix}{major}, e.g. v1
+	AliasMinor  = "minor"  // {prefix}{major}.{minor}, e.g. v1.4
+	AliasLatest = "latest" // the literal tag "latest"
+)
+
+// AliasTag is a moving alias tag and the version tag it points at.
+type AliasTag struct {
+	Name   string
+	Target string
+}
+
+// AliasNames returns the alias tag names for a version. Pre-releases never
+// get aliases, so the result is empty for them.
+func (p *TagManagerPlugin) AliasNames(version semver.SemVersion) []string {
+	if version.PreRelease != "" || len(p.config.Aliases) == 0 {
+		return nil
+	}
+
+	data := NewTemplateData(version, p.config.Prefix, "")
+	names := make([]string, 0, len(p.config.Aliases))
+	for _, alias := range p.config.Aliases {
+		var name string
+		switch alias {
+		case AliasMajor:
+			name = p.config.Prefix + strconv.FormatUint(version.Major, 10)
+		case AliasMinor:
+			name = p.config.Prefix + strconv.FormatUint(version.Major, 10) + "." + strconv.FormatUint(version.Minor, 10)
+		case AliasLatest:
+			name = "latest"
+		default:
+			name = FormatMessage(alias, data)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return deduplicateStrings(names)
+}
+
+// ResolveAliases computes where every alias should point: the newest stable
+// version tag among the existing tags that produces that alias name.
+// The result is sorted by alias name.
+func (p *TagManagerPlugin) ResolveAliases() ([]AliasTag, error) {
+	tags, err := p.gitOps.ListTags(context.TODO(), p.config.Prefix+"*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return p.resolveAliases(tags), nil
+}
+
+// resolveAliases maps alias names to the newest stable version tag in tags.
+// Tags that are not semver versions (including the aliases themselves) are ignored.
+func (p *TagManagerPlugin) resolveAliases(tags []string) []AliasTag {
+	newest := make(map[string]semver.SemVersion)
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, p.config.Prefix) {
+			continue
+		}
+		version, err := semver.ParseVersion(strings.TrimPrefix(tag, p.config.Prefix))
+		if err != nil || version.PreRelease != "" {
+			continue
+		}
+		for _, name := range p.AliasNames(version) {
+			if current, ok := newest[name]; !ok || version.Compare(current) > 0 {
+				newest[name] = version
+			}
+		}
+	}
+
+	aliases := make([]AliasTag, 0, len(newest))
+	for name, version := range newest {
+		aliases = append(aliases, AliasTag{Name: name, Target: p.FormatTagName(version)})
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+	return aliases
+}
+
+// UpdateAliases force-moves the alias tags of a newly tagged stable version.
+// An alias only moves when the version is the newest one it covers, so
+// tagging a maintenance release (e.g. v1.3.5 after v1.4.0) leaves v1 and
+// latest alone. Moved aliases are pushed with a lease when Push is enabled.
+// Returns the names of the aliases that now point at the version's tag.
+func (p *TagManagerPlugin) UpdateAliases(version semver.SemVersion) ([]string, error) {
+	if len(p.AliasNames(version)) == 0 {
+		return nil, nil
+	}
+
+	tags, err := p.gitOps.ListTags(context.TODO(), p.config.Prefix+"*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tagName := p.FormatTagName(version)
+	tags = append(tags, tagName)
+
+	var moved []string
+	for _, alias := range p.resolveAliases(tags) {
+		if alias.Target != tagName {
+			continue
+		}
+		if err := p.moveAlias(alias); err != nil {
+			return moved, err
+		}
+		moved = append(moved, alias.Name)
+	}
+	return moved, nil
+}
+
+// RepairAliases recomputes every alias from the existing semver tags and
+// force-moves each one to its expected version tag.
+func (p *TagManagerPlugin) RepairAliases() ([]AliasTag, error) {
+	aliases, err := p.ResolveAliases()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, alias := range aliases {
+		if err := p.moveAlias(alias); err != nil {
+			return aliases[:i], err
+		}
+	}
+	return aliases, nil
+}
+
+// moveAlias points an alias tag at its target and optionally pushes it.
+func (p *TagManagerPlugin) moveAlias(alias AliasTag) error {
+	ctx := context.TODO()
+	previous, err := p.gitOps.MoveTag(ctx, alias.Name, alias.Target)
+	if err != nil {
+		return fmt.Errorf("failed to move alias tag %s: %w", alias.Name, err)
+	}
+
+	if p.config.Push {
+		if err := p.gitOps.ForcePushTag(ctx, alias.Name, previous); err != nil {
+			return fmt.Errorf("failed to push alias tag %s: %w", alias.Name, err)
+		}
+	}
+	return nil
+}
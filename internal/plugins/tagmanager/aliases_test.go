@@ -0,0 +1,227 @@
+package tagmanager
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/indaco/sley/internal/semver"
+)
+
+func TestTagManagerPlugin_AliasNames(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		prefix  string
+		aliases []string
+		version semver.SemVersion
+		want    []string
+	}{
+		{
+			name:    "built-in aliases",
+			prefix:  "v",
+			aliases: []string{AliasMajor, AliasMinor, AliasLatest},
+			version: semver.SemVersion{Major: 1, Minor: 4, Patch: 2},
+			want:    []string{"v1", "v1.4", "latest"},
+		},
+		{
+			name:    "custom template",
+			prefix:  "v",
+			aliases: []string{"{prefix}{major}-stable", "stable"},
+			version: semver.SemVersion{Major: 2, Minor: 0, Patch: 1},
+			want:    []string{"v2-stable", "stable"},
+		},
+		{
+			name:    "module prefix",
+			prefix:  "api/v",
+			aliases: []string{AliasMajor},
+			version: semver.SemVersion{Major: 3},
+			want:    []string{"api/v3"},
+		},
+		{
+			name:    "pre-release has no aliases",
+			prefix:  "v",
+			aliases: []string{AliasMajor, AliasLatest},
+			version: semver.SemVersion{Major: 1, PreRelease: "rc.1"},
+			want:    nil,
+		},
+		{
+			name:    "no aliases configured",
+			prefix:  "v",
+			version: semver.SemVersion{Major: 1},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Prefix = tt.prefix
+			cfg.Aliases = tt.aliases
+			tm := NewTagManagerWithOps(cfg, &MockGitTagOperations{}, nil)
+
+			if got := tm.AliasNames(tt.version); !slices.Equal(got, tt.want) {
+				t.Errorf("AliasNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagManagerPlugin_ResolveAliases(t *testing.T) {
+
+	mockOps := &MockGitTagOperations{
+		ListTagsFn: func(ctx context.Context, pattern string) ([]string, error) {
+			if pattern != "v*" {
+				t.Errorf("ListTags() pattern = %q, want v*", pattern)
+			}
+			return []string{"v1", "v1.3.5", "v1.4.0", "v1.4.2", "v2.0.0-rc.1", "v0.9.0", "v1.4"}, nil
+		},
+	}
+	cfg := DefaultConfig()
+	cfg.Aliases = []string{AliasMajor, AliasMinor, AliasLatest}
+	tm := NewTagManagerWithOps(cfg, mockOps, nil)
+
+	got, err := tm.ResolveAliases()
+	if err != nil {
+		t.Fatalf("ResolveAliases() error = %v", err)
+	}
+	want := []AliasTag{
+		{Name: "latest", Target: "v1.4.2"},
+		{Name: "v0", Target: "v0.9.0"},
+		{Name: "v0.9", Target: "v0.9.0"},
+		{Name: "v1", Target: "v1.4.2"},
+		{Name: "v1.3", Target: "v1.3.5"},
+		{Name: "v1.4", Target: "v1.4.2"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ResolveAliases() = %v, want %v", got, want)
+	}
+}
+
+func TestTagManagerPlugin_UpdateAliases(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		version   semver.SemVersion
+		tags      []string
+		push      bool
+		wantMoved []string
+		wantPush  []string
+	}{
+		{
+			name:      "newest release moves every alias",
+			version:   semver.SemVersion{Major: 1, Minor: 5, Patch: 0},
+			tags:      []string{"v1.4.2"},
+			push:      true,
+			wantMoved: []string{"latest", "v1", "v1.5"},
+			wantPush:  []string{"latest", "v1", "v1.5"},
+		},
+		{
+			name:      "maintenance release only moves its minor alias",
+			version:   semver.SemVersion{Major: 1, Minor: 3, Patch: 6},
+			tags:      []string{"v1.3.5", "v1.4.2", "v1.3.6"},
+			wantMoved: []string{"v1.3"},
+		},
+		{
+			name:    "pre-release moves nothing",
+			version: semver.SemVersion{Major: 2, PreRelease: "rc.1"},
+			tags:    []string{"v1.4.2", "v2.0.0-rc.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var moved, pushed []string
+			mockOps := &MockGitTagOperations{
+				ListTagsFn: func(ctx context.Context, pattern string) ([]string, error) {
+					return tt.tags, nil
+				},
+				MoveTagFn: func(ctx context.Context, name, target string) (string, error) {
+					moved = append(moved, name)
+					return "old-" + name, nil
+				},
+				ForcePushTagFn: func(ctx context.Context, name, expected string) error {
+					if expected != "old-"+name {
+						t.Errorf("ForcePushTag(%s) expected = %q, want %q", name, expected, "old-"+name)
+					}
+					pushed = append(pushed, name)
+					return nil
+				},
+			}
+			cfg := DefaultConfig()
+			cfg.Push = tt.push
+			cfg.Aliases = []string{AliasMajor, AliasMinor, AliasLatest}
+			tm := NewTagManagerWithOps(cfg, mockOps, nil)
+
+			got, err := tm.UpdateAliases(tt.version)
+			if err != nil {
+				t.Fatalf("UpdateAliases() error = %v", err)
+			}
+			if !slices.Equal(got, tt.wantMoved) || !slices.Equal(moved, tt.wantMoved) {
+				t.Errorf("UpdateAliases() = %v (moved %v), want %v", got, moved, tt.wantMoved)
+			}
+			if !slices.Equal(pushed, tt.wantPush) {
+				t.Errorf("UpdateAliases() pushed %v, want %v", pushed, tt.wantPush)
+			}
+		})
+	}
+}
+
+func TestTagManagerPlugin_UpdateAliases_Errors(t *testing.T) {
+
+	cfg := DefaultConfig()
+	cfg.Push = true
+	cfg.Aliases = []string{AliasMajor}
+	version := semver.SemVersion{Major: 1}
+
+	listErr := &MockGitTagOperations{
+		ListTagsFn: func(ctx context.Context, pattern string) ([]string, error) {
+			return nil, errors.New("not a git repository")
+		},
+	}
+	if _, err := NewTagManagerWithOps(cfg, listErr, nil).UpdateAliases(version); err == nil {
+		t.Error("UpdateAliases() expected list error")
+	}
+
+	pushErr := &MockGitTagOperations{
+		ForcePushTagFn: func(ctx context.Context, name, expected string) error {
+			return errors.New("stale info")
+		},
+	}
+	_, err := NewTagManagerWithOps(cfg, pushErr, nil).UpdateAliases(version)
+	if err == nil || err.Error() != "failed to push alias tag v1: stale info" {
+		t.Errorf("UpdateAliases() error = %v, want push error", err)
+	}
+}
+
+func TestTagManagerPlugin_RepairAliases(t *testing.T) {
+
+	moved := map[string]string{}
+	mockOps := &MockGitTagOperations{
+		ListTagsFn: func(ctx context.Context, pattern string) ([]string, error) {
+			return []string{"v1.0.0", "v1.1.0", "v2.0.0"}, nil
+		},
+		MoveTagFn: func(ctx context.Context, name, target string) (string, error) {
+			moved[name] = target
+			return "", nil
+		},
+	}
+	cfg := DefaultConfig()
+	cfg.Aliases = []string{AliasMajor, AliasLatest}
+	tm := NewTagManagerWithOps(cfg, mockOps, nil)
+
+	got, err := tm.RepairAliases()
+	if err != nil {
+		t.Fatalf("RepairAliases() error = %v", err)
+	}
+	if len(got) != 3 {
+		t.Errorf("RepairAliases() = %v, want 3 aliases", got)
+	}
+	want := map[string]string{"latest": "v2.0.0", "v1": "v1.1.0", "v2": "v2.0.0"}
+	for name, target := range want {
+		if moved[name] != target {
+			t.Errorf("alias %s moved to %q, want %q", name, moved[name], target)
+		}
+	}
+}
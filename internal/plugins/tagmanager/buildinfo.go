@@ -0,0 +1,149 @@
+package tagmanager
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BuildInfo is the git and CI context used by metadata templates and
+// dev pre-releases.
+type BuildInfo struct {
+	Commit      string
+	CommitShort string
+	CommitCount int
+	CommitTime  time.Time
+	Branch      string
+	CIRun       string
+}
+
+// ciRunVariables are the environment variables holding the CI run or build
+// number, checked in order.
+var ciRunVariables = []string{
+	"GITHUB_RUN_NUMBER",      // GitHub Actions
+	"CI_PIPELINE_IID",        // GitLab CI
+	"CIRCLE_BUILD_NUM",       // CircleCI
+	"BUILDKITE_BUILD_NUMBER", // Buildkite
+	"BUILD_NUMBER",           // Jenkins, TeamCity
+}
+
+// ciBranchVariables are the environment variables holding the branch name
+// in CI, where the checkout is often a detached HEAD. Checked in order.
+var ciBranchVariables = []string{
+	"GITHUB_HEAD_REF", // GitHub Actions pull requests
+	"GITHUB_REF_NAME", // GitHub Actions pushes
+	"CI_COMMIT_REF_NAME",
+	"CIRCLE_BRANCH",
+	"BUILDKITE_BRANCH",
+	"BRANCH_NAME",
+}
+
+// BuildInfo reads the commit, commit count, branch and CI run number of HEAD.
+// Values that cannot be determined are left empty; outside a git repository
+// only the CI run number may be set.
+func (g *OSGitTagOperations) BuildInfo(ctx context.Context) BuildInfo {
+	var info BuildInfo
+
+	if out, err := g.output(ctx, "git log", "log", "-1", "--format=%H%x09%h%x09%ct"); err == nil {
+		fields := strings.Split(strings.TrimSpace(out), "\t")
+		if len(fields) == 3 {
+			info.Commit, info.CommitShort = fields[0], fields[1]
+			if unix, err := strconv.ParseInt(fields[2], 10, 64); err == nil {
+				info.CommitTime = time.Unix(unix, 0).UTC()
+			}
+		}
+	}
+	if out, err := g.output(ctx, "git rev-list", "rev-list", "--count", "HEAD"); err == nil {
+		info.CommitCount, _ = strconv.Atoi(strings.TrimSpace(out))
+	}
+
+	info.Branch = g.Branch(ctx)
+	info.CIRun = firstEnv(ciRunVariables)
+	return info
+}
+
+// ReadBuildInfo reads the build context of HEAD (package-level convenience function).
+func ReadBuildInfo() BuildInfo {
+	return defaultGitTagOps.BuildInfo(context.Background())
+}
+
+// Branch returns the current branch, preferring the CI branch variables
+// since CI checkouts are often a detached HEAD. Returns "" if unknown.
+func (g *OSGitTagOperations) Branch(ctx context.Context) string {
+	if branch := firstEnv(ciBranchVariables); branch != "" {
+		return branch
+	}
+	out, err := g.output(ctx, "git rev-parse", "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return ""
+	}
+	if branch := strings.TrimSpace(out); branch != "HEAD" {
+		return branch
+	}
+	return ""
+}
+
+// ReadBranch returns the current branch (package-level convenience function).
+func ReadBranch() string {
+	return defaultGitTagOps.Branch(context.Background())
+}
+
+// firstEnv returns the first non-empty value among the environment variables.
+func firstEnv(names []string) string {
+	for _, name := range names {
+		if value := strings.TrimSpace(os.Getenv(name)); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// WithBuildInfo returns a copy of the data with the git and CI placeholders set.
+func (d TemplateData) WithBuildInfo(info BuildInfo) TemplateData {
+	d.Commit = info.Commit
+	d.CommitShort = info.CommitShort
+	if info.CommitCount > 0 {
+		d.CommitCount = strconv.Itoa(info.CommitCount)
+	}
+	d.Branch = info.Branch
+	d.BranchSlug = Slugify(info.Branch)
+	d.CIRun = info.CIRun
+	return d
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify lowercases s and replaces every run of non-alphanumeric characters
+// with "-", e.g. "feature/Login_Form" becomes "feature-login-form".
+func Slugify(s string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+var invalidMetadataChars = regexp.MustCompile(`[^0-9A-Za-z.\-]+`)
+
+// FormatMetadata resolves a build metadata template and makes the result
+// valid SemVer build metadata: invalid characters become "-" and the empty
+// identifiers left by unset placeholders are dropped, so "{commit_short}.{ci_run}"
+// outside CI yields just the commit hash.
+func FormatMetadata(template string, data TemplateData) string {
+	resolved := invalidMetadataChars.ReplaceAllString(FormatMessage(template, data), "-")
+
+	var identifiers []string
+	for identifier := range strings.SplitSeq(resolved, ".") {
+		if identifier != "" {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	return strings.Join(identifiers, ".")
+}
+
+// ResolveMetadata resolves a build metadata template against the current
+// date and the build context of HEAD. Version placeholders are not available
+// because metadata is computed before the new version is known.
+func ResolveMetadata(template string) string {
+	data := TemplateData{Date: time.Now().Format("2006-01-02")}
+	return FormatMetadata(template, data.WithBuildInfo(ReadBuildInfo()))
+}
@@ -0,0 +1,113 @@
+package tagmanager
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+// clearCIEnv unsets the CI variables read by BuildInfo.
+func clearCIEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range append(append([]string{}, ciRunVariables...), ciBranchVariables...) {
+		t.Setenv(name, "")
+	}
+}
+
+func TestOSGitTagOperations_BuildInfo(t *testing.T) {
+	clearCIEnv(t)
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		switch args[0] {
+		case "log":
+			return exec.Command("printf", "0123456789abcdef\t0123456\t1760572800\n")
+		case "rev-list":
+			return exec.Command("echo", "42")
+		case "rev-parse":
+			return exec.Command("echo", "feature/Login_Form")
+		}
+		t.Errorf("unexpected args %v", args)
+		return exec.Command("false")
+	})
+
+	info := ops.BuildInfo(context.Background())
+	want := BuildInfo{
+		Commit:      "0123456789abcdef",
+		CommitShort: "0123456",
+		CommitCount: 42,
+		CommitTime:  time.Unix(1760572800, 0).UTC(),
+		Branch:      "feature/Login_Form",
+	}
+	if info != want {
+		t.Errorf("BuildInfo() = %+v, want %+v", info, want)
+	}
+}
+
+func TestOSGitTagOperations_BuildInfo_CI(t *testing.T) {
+	clearCIEnv(t)
+	t.Setenv("GITHUB_RUN_NUMBER", "17")
+	t.Setenv("GITHUB_REF_NAME", "main")
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		if args[0] == "rev-parse" {
+			return exec.Command("echo", "HEAD")
+		}
+		return exec.Command("false")
+	})
+
+	info := ops.BuildInfo(context.Background())
+	if info.CIRun != "17" || info.Branch != "main" {
+		t.Errorf("BuildInfo() = %+v, want CI run 17 on main", info)
+	}
+	if info.Commit != "" || info.CommitCount != 0 {
+		t.Errorf("expected empty git values outside a repository, got %+v", info)
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"main":                "main",
+		"feature/Login_Form":  "feature-login-form",
+		"--Fix//Bug 12--":     "fix-bug-12",
+		"release/v1.4.x":      "release-v1-4-x",
+		"":                    "",
+		"dependabot/npm/a@b2": "dependabot-npm-a-b2",
+	}
+	for in, want := range tests {
+		if got := Slugify(in); got != want {
+			t.Errorf("Slugify(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFormatMetadata(t *testing.T) {
+	data := TemplateData{Date: "2026-10-16"}.WithBuildInfo(BuildInfo{
+		Commit:      "0123456789abcdef",
+		CommitShort: "0123456",
+		CommitCount: 42,
+		Branch:      "feature/Login_Form",
+		CIRun:       "17",
+	})
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"{commit_short}.{ci_run}", "0123456.17"},
+		{"{date}.{branch_slug}", "2026-10-16.feature-login-form"},
+		{"{branch}", "feature-Login-Form"},
+		{"build.{commit_count}", "build.42"},
+		{"{commit_short}..{prerelease}.", "0123456"},
+	}
+	for _, tt := range tests {
+		if got := FormatMetadata(tt.template, data); got != tt.want {
+			t.Errorf("FormatMetadata(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+
+	local := TemplateData{}.WithBuildInfo(BuildInfo{CommitShort: "0123456"})
+	if got := FormatMetadata("{commit_short}.{ci_run}", local); got != "0123456" {
+		t.Errorf("FormatMetadata() outside CI = %q, want 0123456", got)
+	}
+}
@@ -55,13 +55,12 @@ func (g *OSGitTagOperations) CreateLightweightTag(ctx context.Context, name stri
 	return nil
 }
 
-func (g *OSGitTagOperations) CreateSignedTag(ctx context.Context, name, message, keyID string) error {
-	var args []string
-	if keyID != "" {
-		args = []string{"tag", "-s", "-u", keyID, name, "-m", message}
-	} else {
-		args = []string{"tag", "-s", name, "-m", message}
+func (g *OSGitTagOperations) CreateSignedTag(ctx context.Context, name, message string, signing core.TagSigning) error {
+	args := append(SigningConfigArgs(signing), "tag", "-s")
+	if signing.Key != "" {
+		args = append(args, "-u", signing.Key)
 	}
+	args = append(args, name, "-m", message)
 
 	cmd := g.execCommandContext(ctx, "git", args...)
 	var stderr bytes.Buffer
@@ -77,6 +76,61 @@ func (g *OSGitTagOperations) CreateSignedTag(ctx context.Context, name, message,
 	return nil
 }
 
+func (g *OSGitTagOperations) VerifyTag(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+	args := append(SigningConfigArgs(signing), "tag", "-v", name)
+
+	// git prints the tag object on stdout and the signature check on stderr
+	cmd := g.execCommandContext(ctx, "git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	output := strings.TrimSpace(stderr.String())
+	if err != nil {
+		if output != "" {
+			return "", fmt.Errorf("%s: %w", output, err)
+		}
+		return "", fmt.Errorf("git tag verify failed: %w", err)
+	}
+	return output, nil
+}
+
+// SigningConfigArgs returns the "-c key=value" git options selecting the
+// signature format, program and SSH allowed signers file. The format is
+// always passed so a global gpg.format cannot override it; git calls the
+// gpg format "openpgp".
+func SigningConfigArgs(signing core.TagSigning) []string {
+	format := signing.Format
+	if format == "" {
+		format = SigningFormatGPG
+	}
+	gitFormat := format
+	if format == SigningFormatGPG {
+		gitFormat = "openpgp"
+	}
+	args := []string{"-c", "gpg.format=" + gitFormat}
+
+	program := signing.Program
+	if program == "" && format == SigningFormatX509 {
+		program = "gitsign"
+	}
+	if program != "" {
+		switch format {
+		case SigningFormatSSH:
+			args = append(args, "-c", "gpg.ssh.program="+program)
+		case SigningFormatX509:
+			args = append(args, "-c", "gpg.x509.program="+program)
+		default:
+			args = append(args, "-c", "gpg.program="+program)
+		}
+	}
+
+	if format == SigningFormatSSH && signing.AllowedSignersFile != "" {
+		args = append(args, "-c", "gpg.ssh.allowedSignersFile="+signing.AllowedSignersFile)
+	}
+	return args
+}
+
 func (g *OSGitTagOperations) TagExists(ctx context.Context, name string) (bool, error) {
 	cmd := g.execCommandContext(ctx, "git", "tag", "-l", name)
 	var stdout bytes.Buffer
@@ -185,6 +239,43 @@ func (g *OSGitTagOperations) DeleteRemoteTag(ctx context.Context, name string) e
 	return nil
 }
 
+func (g *OSGitTagOperations) MoveTag(ctx context.Context, name, target string) (string, error) {
+	// A missing tag makes rev-parse exit non-zero with no output
+	var previous bytes.Buffer
+	revParse := g.execCommandContext(ctx, "git", "rev-parse", "-q", "--verify", "refs/tags/"+name+"^{commit}")
+	revParse.Stdout = &previous
+	_ = revParse.Run()
+
+	cmd := g.execCommandContext(ctx, "git", "tag", "-f", name, target+"^{commit}")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return "", fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return "", fmt.Errorf("git tag -f failed: %w", err)
+	}
+	return strings.TrimSpace(previous.String()), nil
+}
+
+func (g *OSGitTagOperations) ForcePushTag(ctx context.Context, name, expected string) error {
+	ref := "refs/tags/" + name
+	cmd := g.execCommandContext(ctx, "git", "push", "--force-with-lease="+ref+":"+expected, "origin", ref)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return fmt.Errorf("git push --force-with-lease tag failed: %w", err)
+	}
+	return nil
+}
+
 // OSGitCommitOperations implements core.GitCommitOperations using actual git commands.
 type OSGitCommitOperations struct {
 	execCommandContext func(ctx context.Context, name string, arg ...string) *exec.Cmd
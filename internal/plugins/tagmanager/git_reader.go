@@ -0,0 +1,147 @@
+package tagmanager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+// Verify OSGitTagOperations implements core.GitTagReader.
+var _ core.GitTagReader = (*OSGitTagOperations)(nil)
+
+// showTagFormat is the for-each-ref format used by ShowTag. Fields are
+// NUL-separated because messages span multiple lines.
+const showTagFormat = "%(refname:short)%00%(objecttype)%00%(taggername) %(taggeremail)%00" +
+	"%(creatordate:unix)%00%(*objectname)%00%(objectname)%00" +
+	"%(contents:subject)%00%(contents:body)%00%(contents:signature)"
+
+// signatureHeaders maps armor headers to signature formats.
+var signatureHeaders = map[string]string{
+	"-----BEGIN PGP SIGNATURE-----":  SigningFormatGPG,
+	"-----BEGIN SSH SIGNATURE-----":  SigningFormatSSH,
+	"-----BEGIN SIGNED MESSAGE-----": SigningFormatX509,
+	"-----BEGIN CMS-----":            SigningFormatX509,
+}
+
+func (g *OSGitTagOperations) ShowTag(ctx context.Context, name string) (core.TagDetails, error) {
+	output, err := g.output(ctx, "git for-each-ref", "for-each-ref", "--format="+showTagFormat, "refs/tags/"+name)
+	if err != nil {
+		return core.TagDetails{}, err
+	}
+
+	fields := strings.Split(strings.TrimRight(output, "\n"), "\x00")
+	if len(fields) < 9 || fields[0] == "" {
+		return core.TagDetails{}, fmt.Errorf("tag %s not found", name)
+	}
+
+	details := core.TagDetails{
+		Name:      fields[0],
+		Annotated: fields[1] == "tag",
+		Tagger:    strings.TrimSpace(fields[2]),
+		Commit:    fields[4],
+		Message:   strings.TrimSpace(fields[6] + "\n\n" + fields[7]),
+	}
+	if details.Commit == "" {
+		details.Commit = fields[5]
+	}
+	if unix, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+		details.Date = time.Unix(unix, 0)
+	}
+	signature := strings.TrimSpace(fields[8])
+	for header, format := range signatureHeaders {
+		if strings.HasPrefix(signature, header) {
+			details.SignatureFormat = format
+			break
+		}
+	}
+	return details, nil
+}
+
+func (g *OSGitTagOperations) ListTagRefs(ctx context.Context, pattern string) ([]core.TagRef, error) {
+	output, err := g.output(ctx, "git for-each-ref", "for-each-ref",
+		"--format=%(refname:short)%09%(creatordate:unix)", "refs/tags/"+pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []core.TagRef
+	for line := range strings.SplitSeq(strings.TrimSpace(output), "\n") {
+		name, date, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		ref := core.TagRef{Name: name}
+		if unix, err := strconv.ParseInt(date, 10, 64); err == nil {
+			ref.Date = time.Unix(unix, 0)
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func (g *OSGitTagOperations) ListRemoteTags(ctx context.Context) ([]string, error) {
+	output, err := g.output(ctx, "git ls-remote", "ls-remote", "--tags", "--refs", "origin")
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	for line := range strings.SplitSeq(strings.TrimSpace(output), "\n") {
+		_, ref, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		tags = append(tags, strings.TrimPrefix(ref, "refs/tags/"))
+	}
+	return tags, nil
+}
+
+func (g *OSGitTagOperations) CommitsBetween(ctx context.Context, from, to string) ([]string, error) {
+	output, err := g.output(ctx, "git log", "log", "--oneline", "--no-decorate", from+".."+to)
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(output), nil
+}
+
+func (g *OSGitTagOperations) ChangedFiles(ctx context.Context, from, to string) ([]string, error) {
+	output, err := g.output(ctx, "git diff", "diff", "--name-only", "--relative", from, to)
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(output), nil
+}
+
+// output runs a git command and returns its stdout. The description names
+// the command in errors when git writes nothing to stderr.
+func (g *OSGitTagOperations) output(ctx context.Context, description string, args ...string) (string, error) {
+	cmd := g.execCommandContext(ctx, "git", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return "", fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return "", fmt.Errorf("%s failed: %w", description, err)
+	}
+	return stdout.String(), nil
+}
+
+// splitLines splits command output into non-empty lines.
+func splitLines(output string) []string {
+	var lines []string
+	for line := range strings.SplitSeq(strings.TrimSpace(output), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
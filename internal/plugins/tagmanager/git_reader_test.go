@@ -0,0 +1,144 @@
+package tagmanager
+
+import (
+	"context"
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOSGitTagOperations_ShowTag(t *testing.T) {
+
+	t.Run("signed annotated tag", func(t *testing.T) {
+		fields := []string{
+			"v1.2.0", "tag", "Jane Doe <jane@example.com>", "1709287200",
+			"abc123", "def456", "Release 1.2.0", `Highlights\n`,
+			`-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----\n`,
+		}
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			if args[0] != "for-each-ref" || args[2] != "refs/tags/v1.2.0" {
+				t.Errorf("unexpected args %v", args)
+			}
+			return exec.Command("sh", "-c", "printf '"+strings.Join(fields, `\000`)+`\n'`)
+		})
+
+		details, err := ops.ShowTag(context.Background(), "v1.2.0")
+		if err != nil {
+			t.Fatalf("ShowTag() error = %v", err)
+		}
+		if !details.Annotated || details.Tagger != "Jane Doe <jane@example.com>" {
+			t.Errorf("ShowTag() = %+v", details)
+		}
+		if details.Commit != "abc123" {
+			t.Errorf("Commit = %q, want peeled commit abc123", details.Commit)
+		}
+		if details.Message != "Release 1.2.0\n\nHighlights" {
+			t.Errorf("Message = %q", details.Message)
+		}
+		if details.SignatureFormat != SigningFormatSSH {
+			t.Errorf("SignatureFormat = %q, want ssh", details.SignatureFormat)
+		}
+		if !details.Date.Equal(time.Unix(1709287200, 0)) {
+			t.Errorf("Date = %v", details.Date)
+		}
+	})
+
+	t.Run("lightweight tag", func(t *testing.T) {
+		fields := []string{"v1.0.0", "commit", " ", "1704067200", "", "abc123", "feat: initial", "", ""}
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "printf '"+strings.Join(fields, `\000`)+`\n'`)
+		})
+
+		details, err := ops.ShowTag(context.Background(), "v1.0.0")
+		if err != nil {
+			t.Fatalf("ShowTag() error = %v", err)
+		}
+		if details.Annotated || details.Tagger != "" || details.Commit != "abc123" || details.SignatureFormat != "" {
+			t.Errorf("ShowTag() = %+v", details)
+		}
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			return exec.Command("true")
+		})
+		if _, err := ops.ShowTag(context.Background(), "v9.9.9"); err == nil || err.Error() != "tag v9.9.9 not found" {
+			t.Errorf("ShowTag() error = %v, want not found", err)
+		}
+	})
+}
+
+func TestOSGitTagOperations_ListTagRefs(t *testing.T) {
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		if args[len(args)-1] != "refs/tags/v*" {
+			t.Errorf("unexpected pattern in %v", args)
+		}
+		return exec.Command("printf", "v1.0.0\t1704067200\nv1.1.0\t1709287200\n")
+	})
+
+	refs, err := ops.ListTagRefs(context.Background(), "v*")
+	if err != nil {
+		t.Fatalf("ListTagRefs() error = %v", err)
+	}
+	if len(refs) != 2 || refs[1].Name != "v1.1.0" || !refs[1].Date.Equal(time.Unix(1709287200, 0)) {
+		t.Errorf("ListTagRefs() = %v", refs)
+	}
+}
+
+func TestOSGitTagOperations_ListRemoteTags(t *testing.T) {
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		want := []string{"ls-remote", "--tags", "--refs", "origin"}
+		if !slices.Equal(args, want) {
+			t.Errorf("args = %v, want %v", args, want)
+		}
+		return exec.Command("printf", "abc\trefs/tags/v1.0.0\ndef\trefs/tags/v1.1.0\n")
+	})
+
+	tags, err := ops.ListRemoteTags(context.Background())
+	if err != nil || !slices.Equal(tags, []string{"v1.0.0", "v1.1.0"}) {
+		t.Errorf("ListRemoteTags() = %v, %v", tags, err)
+	}
+
+	failing := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo \"fatal: 'origin' does not appear to be a git repository\" >&2 && exit 128")
+	})
+	if _, err := failing.ListRemoteTags(context.Background()); err == nil || !strings.Contains(err.Error(), "origin") {
+		t.Errorf("ListRemoteTags() error = %v", err)
+	}
+}
+
+func TestOSGitTagOperations_CommitsBetween(t *testing.T) {
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		want := []string{"log", "--oneline", "--no-decorate", "v1.0.0..v1.1.0"}
+		if !slices.Equal(args, want) {
+			t.Errorf("args = %v, want %v", args, want)
+		}
+		return exec.Command("printf", "abc feat: b\ndef fix: a\n")
+	})
+
+	commits, err := ops.CommitsBetween(context.Background(), "v1.0.0", "v1.1.0")
+	if err != nil || !slices.Equal(commits, []string{"abc feat: b", "def fix: a"}) {
+		t.Errorf("CommitsBetween() = %v, %v", commits, err)
+	}
+}
+
+func TestOSGitTagOperations_ChangedFiles(t *testing.T) {
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		want := []string{"diff", "--name-only", "--relative", "v1.0.0", "v1.1.0"}
+		if !slices.Equal(args, want) {
+			t.Errorf("args = %v, want %v", args, want)
+		}
+		return exec.Command("true")
+	})
+
+	files, err := ops.ChangedFiles(context.Background(), "v1.0.0", "v1.1.0")
+	if err != nil || len(files) != 0 {
+		t.Errorf("ChangedFiles() = %v, %v, want none", files, err)
+	}
+}
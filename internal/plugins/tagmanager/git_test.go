@@ -4,7 +4,10 @@ import (
 	"context"
 	"os/exec"
 	"slices"
+	"strings"
 	"testing"
+
+	"github.com/indaco/sley/internal/core"
 )
 
 // createTestGitTagOps creates an OSGitTagOperations with a custom exec.CommandContext for testing.
@@ -111,7 +114,7 @@ func TestOSGitTagOperations_CreateSignedTag_WithoutKey(t *testing.T) {
 		if name != "git" {
 			t.Errorf("expected git command, got %s", name)
 		}
-		if len(args) < 5 || args[0] != "tag" || args[1] != "-s" || args[2] != "v1.0.0" {
+		if len(args) < 7 || args[1] != "gpg.format=openpgp" || args[2] != "tag" || args[3] != "-s" || args[4] != "v1.0.0" {
 			t.Errorf("unexpected args: %v", args)
 		}
 		if slices.Contains(args, "-u") {
@@ -120,7 +123,7 @@ func TestOSGitTagOperations_CreateSignedTag_WithoutKey(t *testing.T) {
 		return exec.Command("true")
 	})
 
-	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", "")
+	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", core.TagSigning{})
 	if err != nil {
 		t.Errorf("CreateSignedTag() error = %v", err)
 	}
@@ -132,13 +135,13 @@ func TestOSGitTagOperations_CreateSignedTag_WithKey(t *testing.T) {
 		if name != "git" {
 			t.Errorf("expected git command, got %s", name)
 		}
-		if len(args) < 7 || args[0] != "tag" || args[1] != "-s" || args[2] != "-u" || args[3] != "ABC123" {
+		if len(args) < 9 || args[1] != "gpg.format=openpgp" || args[2] != "tag" || args[3] != "-s" || args[4] != "-u" || args[5] != "ABC123" {
 			t.Errorf("unexpected args: %v", args)
 		}
 		return exec.Command("true")
 	})
 
-	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", "ABC123")
+	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", core.TagSigning{Key: "ABC123"})
 	if err != nil {
 		t.Errorf("CreateSignedTag() error = %v", err)
 	}
@@ -150,7 +153,7 @@ func TestOSGitTagOperations_CreateSignedTag_ErrorWithStderr(t *testing.T) {
 		return exec.Command("sh", "-c", "echo 'gpg: signing failed: No secret key' >&2 && exit 1")
 	})
 
-	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", "")
+	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", core.TagSigning{})
 	if err == nil {
 		t.Error("CreateSignedTag() expected error")
 	}
@@ -165,12 +168,88 @@ func TestOSGitTagOperations_CreateSignedTag_ErrorWithoutStderr(t *testing.T) {
 		return exec.Command("false")
 	})
 
-	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", "")
+	err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", core.TagSigning{})
 	if err == nil {
 		t.Error("CreateSignedTag() expected error")
 	}
 }
 
+func TestOSGitTagOperations_CreateSignedTag_SSH(t *testing.T) {
+
+	ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+		want := []string{"-c", "gpg.format=ssh", "tag", "-s", "-u", "~/.ssh/id_ed25519.pub", "v1.0.0", "-m", "Release 1.0.0"}
+		if !slices.Equal(args, want) {
+			t.Errorf("args = %v, want %v", args, want)
+		}
+		return exec.Command("true")
+	})
+
+	signing := core.TagSigning{Format: SigningFormatSSH, Key: "~/.ssh/id_ed25519.pub"}
+	if err := ops.CreateSignedTag(context.Background(), "v1.0.0", "Release 1.0.0", signing); err != nil {
+		t.Errorf("CreateSignedTag() error = %v", err)
+	}
+}
+
+func TestSigningConfigArgs(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		signing core.TagSigning
+		want    []string
+	}{
+		{"default gpg", core.TagSigning{}, []string{"-c", "gpg.format=openpgp"}},
+		{"explicit gpg", core.TagSigning{Format: SigningFormatGPG}, []string{"-c", "gpg.format=openpgp"}},
+		{"gpg with program", core.TagSigning{Format: SigningFormatGPG, Program: "gpg2"}, []string{"-c", "gpg.format=openpgp", "-c", "gpg.program=gpg2"}},
+		{
+			"ssh with allowed signers",
+			core.TagSigning{Format: SigningFormatSSH, AllowedSignersFile: ".allowed_signers"},
+			[]string{"-c", "gpg.format=ssh", "-c", "gpg.ssh.allowedSignersFile=.allowed_signers"},
+		},
+		{"x509 defaults to gitsign", core.TagSigning{Format: SigningFormatX509}, []string{"-c", "gpg.format=x509", "-c", "gpg.x509.program=gitsign"}},
+		{"x509 with program", core.TagSigning{Format: SigningFormatX509, Program: "gpgsm"}, []string{"-c", "gpg.format=x509", "-c", "gpg.x509.program=gpgsm"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SigningConfigArgs(tt.signing); !slices.Equal(got, tt.want) {
+				t.Errorf("SigningConfigArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOSGitTagOperations_VerifyTag(t *testing.T) {
+
+	t.Run("valid signature", func(t *testing.T) {
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			want := []string{"-c", "gpg.format=ssh", "-c", "gpg.ssh.allowedSignersFile=signers", "tag", "-v", "v1.0.0"}
+			if !slices.Equal(args, want) {
+				t.Errorf("args = %v, want %v", args, want)
+			}
+			return exec.Command("sh", "-c", `echo 'Good "git" signature for dev@example.com' >&2`)
+		})
+
+		output, err := ops.VerifyTag(context.Background(), "v1.0.0", core.TagSigning{Format: SigningFormatSSH, AllowedSignersFile: "signers"})
+		if err != nil {
+			t.Fatalf("VerifyTag() error = %v", err)
+		}
+		if output != `Good "git" signature for dev@example.com` {
+			t.Errorf("VerifyTag() output = %q", output)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "echo 'error: no signature found' >&2 && exit 1")
+		})
+
+		_, err := ops.VerifyTag(context.Background(), "v1.0.0", core.TagSigning{})
+		if err == nil || !strings.Contains(err.Error(), "no signature found") {
+			t.Errorf("VerifyTag() error = %v, want no signature error", err)
+		}
+	})
+}
+
 func TestOSGitTagOperations_TagExists(t *testing.T) {
 
 	t.Run("tag exists", func(t *testing.T) {
@@ -472,6 +551,94 @@ func TestOSGitTagOperations_DeleteRemoteTag(t *testing.T) {
 	})
 }
 
+func TestOSGitTagOperations_MoveTag(t *testing.T) {
+
+	t.Run("existing alias", func(t *testing.T) {
+		var calls [][]string
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			calls = append(calls, args)
+			if args[0] == "rev-parse" {
+				return exec.Command("echo", "abc123")
+			}
+			return exec.Command("true")
+		})
+
+		previous, err := ops.MoveTag(context.Background(), "v1", "v1.4.2")
+		if err != nil {
+			t.Fatalf("MoveTag() error = %v", err)
+		}
+		if previous != "abc123" {
+			t.Errorf("MoveTag() previous = %q, want abc123", previous)
+		}
+		want := []string{"tag", "-f", "v1", "v1.4.2^{commit}"}
+		if len(calls) != 2 || !slices.Equal(calls[1], want) {
+			t.Errorf("MoveTag() calls = %v, want second call %v", calls, want)
+		}
+	})
+
+	t.Run("new alias", func(t *testing.T) {
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			if args[0] == "rev-parse" {
+				return exec.Command("false")
+			}
+			return exec.Command("true")
+		})
+
+		previous, err := ops.MoveTag(context.Background(), "latest", "v1.0.0")
+		if err != nil || previous != "" {
+			t.Errorf("MoveTag() = %q, %v, want empty previous", previous, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			if args[0] == "rev-parse" {
+				return exec.Command("false")
+			}
+			return exec.Command("sh", "-c", "echo 'fatal: Failed to resolve' >&2 && exit 128")
+		})
+
+		if _, err := ops.MoveTag(context.Background(), "v1", "v9.9.9"); err == nil || !strings.Contains(err.Error(), "Failed to resolve") {
+			t.Errorf("MoveTag() error = %v, want resolve error", err)
+		}
+	})
+}
+
+func TestOSGitTagOperations_ForcePushTag(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		expected string
+		want     []string
+	}{
+		{"with lease", "abc123", []string{"push", "--force-with-lease=refs/tags/v1:abc123", "origin", "refs/tags/v1"}},
+		{"new tag", "", []string{"push", "--force-with-lease=refs/tags/v1:", "origin", "refs/tags/v1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+				if !slices.Equal(args, tt.want) {
+					t.Errorf("args = %v, want %v", args, tt.want)
+				}
+				return exec.Command("true")
+			})
+			if err := ops.ForcePushTag(context.Background(), "v1", tt.expected); err != nil {
+				t.Errorf("ForcePushTag() error = %v", err)
+			}
+		})
+	}
+
+	t.Run("stale lease", func(t *testing.T) {
+		ops := createTestGitTagOps(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "echo '! [rejected] v1 (stale info)' >&2 && exit 1")
+		})
+		if err := ops.ForcePushTag(context.Background(), "v1", "abc123"); err == nil || !strings.Contains(err.Error(), "stale info") {
+			t.Errorf("ForcePushTag() error = %v, want stale info error", err)
+		}
+	})
+}
+
 func TestNewOSGitTagOperations(t *testing.T) {
 
 	ops := NewOSGitTagOperations()
@@ -10,13 +10,16 @@ import (
 type MockGitTagOperations struct {
 	CreateAnnotatedTagFn   func(ctx context.Context, name, message string) error
 	CreateLightweightTagFn func(ctx context.Context, name string) error
-	CreateSignedTagFn      func(ctx context.Context, name, message, keyID string) error
+	CreateSignedTagFn      func(ctx context.Context, name, message string, signing core.TagSigning) error
+	VerifyTagFn            func(ctx context.Context, name string, signing core.TagSigning) (string, error)
 	TagExistsFn            func(ctx context.Context, name string) (bool, error)
 	GetLatestTagFn         func(ctx context.Context) (string, error)
 	PushTagFn              func(ctx context.Context, name string) error
 	ListTagsFn             func(ctx context.Context, pattern string) ([]string, error)
 	DeleteTagFn            func(ctx context.Context, name string) error
 	DeleteRemoteTagFn      func(ctx context.Context, name string) error
+	MoveTagFn              func(ctx context.Context, name, target string) (string, error)
+	ForcePushTagFn         func(ctx context.Context, name, expected string) error
 }
 
 // Verify MockGitTagOperations implements core.GitTagOperations.
@@ -39,13 +42,21 @@ func (m *MockGitTagOperations) CreateLightweightTag(ctx context.Context, name st
 }
 
 // CreateSignedTag implements core.GitTagOperations.
-func (m *MockGitTagOperations) CreateSignedTag(ctx context.Context, name, message, keyID string) error {
+func (m *MockGitTagOperations) CreateSignedTag(ctx context.Context, name, message string, signing core.TagSigning) error {
 	if m.CreateSignedTagFn != nil {
-		return m.CreateSignedTagFn(ctx, name, message, keyID)
+		return m.CreateSignedTagFn(ctx, name, message, signing)
 	}
 	return nil
 }
 
+// VerifyTag implements core.GitTagOperations.
+func (m *MockGitTagOperations) VerifyTag(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+	if m.VerifyTagFn != nil {
+		return m.VerifyTagFn(ctx, name, signing)
+	}
+	return "", nil
+}
+
 // TagExists implements core.GitTagOperations.
 func (m *MockGitTagOperations) TagExists(ctx context.Context, name string) (bool, error) {
 	if m.TagExistsFn != nil {
@@ -93,3 +104,19 @@ func (m *MockGitTagOperations) DeleteRemoteTag(ctx context.Context, name string)
 	}
 	return nil
 }
+
+// MoveTag implements core.GitTagOperations.
+func (m *MockGitTagOperations) MoveTag(ctx context.Context, name, target string) (string, error) {
+	if m.MoveTagFn != nil {
+		return m.MoveTagFn(ctx, name, target)
+	}
+	return "", nil
+}
+
+// ForcePushTag implements core.GitTagOperations.
+func (m *MockGitTagOperations) ForcePushTag(ctx context.Context, name, expected string) error {
+	if m.ForcePushTagFn != nil {
+		return m.ForcePushTagFn(ctx, name, expected)
+	}
+	return nil
+}
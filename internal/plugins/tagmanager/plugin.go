@@ -3,6 +3,7 @@ package tagmanager
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/semver"
@@ -37,6 +38,10 @@ type TagManager interface {
 
 	// CommitChanges stages modified files and creates a commit before tagging.
 	CommitChanges(version semver.SemVersion, extraFiles []string) error
+
+	// UpdateAliases force-moves the alias tags (e.g. v1, v1.4, latest) of a
+	// newly tagged stable version and returns the aliases that were moved.
+	UpdateAliases(version semver.SemVersion) ([]string, error)
 }
 
 // Config holds configuration for the tag manager plugin.
@@ -61,16 +66,29 @@ type Config struct {
 	// Default: false (opt-in for pre-release tagging).
 	TagPrereleases bool
 
-	// Sign creates GPG-signed tags using git tag -s.
-	// Requires git to be configured with a GPG signing key.
+	// Sign creates signed tags using git tag -s.
+	// Requires git to be configured with a signing key.
 	// Default: false.
 	Sign bool
 
-	// SigningKey specifies the GPG key ID to use for signing.
+	// SigningFormat is the signature format: gpg, ssh or x509.
+	// Default: gpg.
+	SigningFormat string
+
+	// SigningKey specifies the key to sign with: a GPG key ID, an SSH key
+	// path or public key, or an X.509 identity.
 	// If empty, git uses the default signing key from user.signingkey config.
 	// Only used when Sign is true.
 	SigningKey string
 
+	// SigningProgram overrides the program git signs and verifies with.
+	// Default for x509: gitsign.
+	SigningProgram string
+
+	// AllowedSignersFile is the SSH allowed signers file used to verify tags.
+	// If empty, git uses gpg.ssh.allowedSignersFile.
+	AllowedSignersFile string
+
 	// MessageTemplate is a template for the tag message.
 	// Supports placeholders: {version}, {tag}, {prefix}, {date}, {major}, {minor}, {patch}, {prerelease}, {build}
 	// Default: "Release {version}" for annotated/signed tags.
@@ -80,6 +98,35 @@ type Config struct {
 	// Only used when AutoCreate is true. Supports the same placeholders as MessageTemplate.
 	// Default: "chore(release): {tag}"
 	CommitMessageTemplate string
+
+	// Aliases lists the moving alias tags kept on the newest stable release:
+	// "major" (v1), "minor" (v1.4), "latest", or a custom template using the
+	// MessageTemplate placeholders. Aliases are lightweight tags.
+	Aliases []string
+
+	// Line restricts GetLatestTag to a maintenance release line, set when the
+	// current branch matches a configured release line. Nil uses every tag.
+	Line *semver.ReleaseLine
+}
+
+// Signature formats supported for signed tags.
+const (
+	SigningFormatGPG  = "gpg"
+	SigningFormatSSH  = "ssh"
+	SigningFormatX509 = "x509"
+)
+
+// SigningFormats lists the supported signature formats.
+var SigningFormats = []string{SigningFormatGPG, SigningFormatSSH, SigningFormatX509}
+
+// Signing returns the settings used to sign and verify tags.
+func (c *Config) Signing() core.TagSigning {
+	return core.TagSigning{
+		Format:             c.SigningFormat,
+		Key:                c.SigningKey,
+		AllowedSignersFile: c.AllowedSignersFile,
+		Program:            c.SigningProgram,
+	}
 }
 
 // DefaultConfig returns the default tag manager configuration.
@@ -92,6 +139,7 @@ func DefaultConfig() *Config {
 		Push:                  false,
 		TagPrereleases:        false,
 		Sign:                  false,
+		SigningFormat:         SigningFormatGPG,
 		SigningKey:            "",
 		MessageTemplate:       "Release {version}",
 		CommitMessageTemplate: "chore(release): {tag}",
@@ -171,8 +219,8 @@ func (p *TagManagerPlugin) CreateTag(version semver.SemVersion, message string)
 	ctx := context.TODO()
 	switch {
 	case p.config.Sign:
-		// GPG-signed tag (implies annotated)
-		if err := p.gitOps.CreateSignedTag(ctx, tagName, message, p.config.SigningKey); err != nil {
+		// Signed tag (implies annotated)
+		if err := p.gitOps.CreateSignedTag(ctx, tagName, message, p.config.Signing()); err != nil {
 			return fmt.Errorf("failed to create signed tag: %w", err)
 		}
 	case p.config.Annotate:
@@ -197,6 +245,16 @@ func (p *TagManagerPlugin) CreateTag(version semver.SemVersion, message string)
 	return nil
 }
 
+// VerifyTag verifies the signature of a tag and returns git's verification
+// output, which identifies the signer.
+func (p *TagManagerPlugin) VerifyTag(tagName string) (string, error) {
+	output, err := p.gitOps.VerifyTag(context.TODO(), tagName, p.config.Signing())
+	if err != nil {
+		return "", fmt.Errorf("tag %s has no valid signature: %w", tagName, err)
+	}
+	return output, nil
+}
+
 // FormatTagMessage formats a tag message using the configured template.
 func (p *TagManagerPlugin) FormatTagMessage(version semver.SemVersion) string {
 	template := p.config.MessageTemplate
@@ -213,8 +271,14 @@ func (p *TagManagerPlugin) TagExists(version semver.SemVersion) (bool, error) {
 	return p.gitOps.TagExists(context.TODO(), tagName)
 }
 
-// GetLatestTag returns the latest semver tag from git.
+// GetLatestTag returns the latest semver tag from git. With a release line
+// configured, only tags of that line are considered, so a maintenance branch
+// never resolves to a newer line's tag.
 func (p *TagManagerPlugin) GetLatestTag() (semver.SemVersion, error) {
+	if p.config.Line != nil {
+		return p.getLatestTagInLine(*p.config.Line)
+	}
+
 	tag, err := p.gitOps.GetLatestTag(context.TODO())
 	if err != nil {
 		return semver.SemVersion{}, err
@@ -234,6 +298,20 @@ func (p *TagManagerPlugin) GetLatestTag() (semver.SemVersion, error) {
 	return version, nil
 }
 
+// getLatestTagInLine returns the newest version tag of a release line.
+func (p *TagManagerPlugin) getLatestTagInLine(line semver.ReleaseLine) (semver.SemVersion, error) {
+	tags, err := p.gitOps.ListTags(context.TODO(), line.TagPattern(p.config.Prefix))
+	if err != nil {
+		return semver.SemVersion{}, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tag, ok := semver.LatestInLine(tags, p.config.Prefix, line)
+	if !ok {
+		return semver.SemVersion{}, fmt.Errorf("no tags found in release line %s", line)
+	}
+	return semver.ParseVersion(strings.TrimPrefix(tag, p.config.Prefix))
+}
+
 // ValidateTagAvailable ensures a tag can be created for the version.
 func (p *TagManagerPlugin) ValidateTagAvailable(version semver.SemVersion) error {
 	exists, err := p.TagExists(version)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/semver"
 )
 
@@ -346,6 +347,38 @@ func TestTagManagerPlugin_GetLatestTag(t *testing.T) {
 	}
 }
 
+func TestTagManagerPlugin_GetLatestTag_ReleaseLine(t *testing.T) {
+	mockOps := &MockGitTagOperations{
+		GetLatestTagFn: func(ctx context.Context) (string, error) {
+			return "v2.1.0", nil
+		},
+		ListTagsFn: func(ctx context.Context, pattern string) ([]string, error) {
+			if pattern != "v1.3.*" {
+				t.Errorf("ListTags() pattern = %q, want v1.3.*", pattern)
+			}
+			return []string{"v1.3.4", "v1.3.10", "v1.3.9"}, nil
+		},
+	}
+
+	cfg := &Config{Prefix: "v", Line: &semver.ReleaseLine{Major: 1, Minor: 3, HasMinor: true}}
+	tm := NewTagManagerWithOps(cfg, mockOps, nil)
+
+	got, err := tm.GetLatestTag()
+	if err != nil {
+		t.Fatalf("GetLatestTag() error = %v", err)
+	}
+	if want := (semver.SemVersion{Major: 1, Minor: 3, Patch: 10}); got != want {
+		t.Errorf("GetLatestTag() = %v, want %v", got, want)
+	}
+
+	mockOps.ListTagsFn = func(ctx context.Context, pattern string) ([]string, error) {
+		return nil, nil
+	}
+	if _, err := tm.GetLatestTag(); err == nil {
+		t.Error("GetLatestTag() expected error for a release line without tags")
+	}
+}
+
 func TestTagManagerPlugin_IsAutoCreateEnabled(t *testing.T) {
 
 	tests := []struct {
@@ -623,10 +656,10 @@ func TestTagManagerPlugin_CreateTag_Signed(t *testing.T) {
 			TagExistsFn: func(ctx context.Context, name string) (bool, error) {
 				return false, nil
 			},
-			CreateSignedTagFn: func(ctx context.Context, name, msg, keyID string) error {
+			CreateSignedTagFn: func(ctx context.Context, name, msg string, signing core.TagSigning) error {
 				signedCalled = true
 				capturedMessage = msg
-				capturedKeyID = keyID
+				capturedKeyID = signing.Key
 				return nil
 			},
 		}
@@ -665,9 +698,9 @@ func TestTagManagerPlugin_CreateTag_Signed(t *testing.T) {
 			TagExistsFn: func(ctx context.Context, name string) (bool, error) {
 				return false, nil
 			},
-			CreateSignedTagFn: func(ctx context.Context, name, msg, keyID string) error {
+			CreateSignedTagFn: func(ctx context.Context, name, msg string, signing core.TagSigning) error {
 				signedCalled = true
-				capturedKeyID = keyID
+				capturedKeyID = signing.Key
 				return nil
 			},
 		}
@@ -700,7 +733,7 @@ func TestTagManagerPlugin_CreateTag_Signed(t *testing.T) {
 			TagExistsFn: func(ctx context.Context, name string) (bool, error) {
 				return false, nil
 			},
-			CreateSignedTagFn: func(ctx context.Context, name, msg, keyID string) error {
+			CreateSignedTagFn: func(ctx context.Context, name, msg string, signing core.TagSigning) error {
 				return errors.New("gpg signing failed")
 			},
 		}
@@ -1156,3 +1189,33 @@ func TestTagManagerPlugin_CommitChanges_CustomTemplate(t *testing.T) {
 		t.Errorf("CommitChanges() commit message = %q, want %q", commitMessage, "bump: 2.5.1 (2.5.1)")
 	}
 }
+
+func TestTagManagerPlugin_VerifyTag(t *testing.T) {
+
+	var captured core.TagSigning
+	mockOps := &MockGitTagOperations{
+		VerifyTagFn: func(ctx context.Context, name string, signing core.TagSigning) (string, error) {
+			captured = signing
+			if name == "v0.9.0" {
+				return "", errors.New("error: no signature found")
+			}
+			return "Good signature", nil
+		},
+	}
+	cfg := DefaultConfig()
+	cfg.SigningFormat = SigningFormatSSH
+	cfg.AllowedSignersFile = ".allowed_signers"
+	tm := NewTagManagerWithOps(cfg, mockOps, nil)
+
+	output, err := tm.VerifyTag("v1.0.0")
+	if err != nil || output != "Good signature" {
+		t.Errorf("VerifyTag() = %q, %v", output, err)
+	}
+	if captured.Format != SigningFormatSSH || captured.AllowedSignersFile != ".allowed_signers" {
+		t.Errorf("VerifyTag() signing = %+v", captured)
+	}
+
+	if _, err := tm.VerifyTag("v0.9.0"); err == nil || err.Error() != "tag v0.9.0 has no valid signature: error: no signature found" {
+		t.Errorf("VerifyTag() error = %v", err)
+	}
+}
@@ -11,16 +11,22 @@ import (
 
 // TemplatePlaceholders defines the available placeholders for message templates.
 var TemplatePlaceholders = []string{
-	"{version}",     // Full version string (e.g., "1.2.3-alpha.1+build.123")
-	"{tag}",         // Full tag name with prefix (e.g., "v1.2.3")
-	"{prefix}",      // Tag prefix (e.g., "v")
-	"{module_path}", // Module relative path (empty for root module)
-	"{date}",        // Current date in YYYY-MM-DD format
-	"{major}",       // Major version number
-	"{minor}",       // Minor version number
-	"{patch}",       // Patch version number
-	"{prerelease}",  // Pre-release identifier (empty if none)
-	"{build}",       // Build metadata (empty if none)
+	"{version}",      // Full version string (e.g., "1.2.3-alpha.1+build.123")
+	"{tag}",          // Full tag name with prefix (e.g., "v1.2.3")
+	"{prefix}",       // Tag prefix (e.g., "v")
+	"{module_path}",  // Module relative path (empty for root module)
+	"{date}",         // Current date in YYYY-MM-DD format
+	"{major}",        // Major version number
+	"{minor}",        // Minor version number
+	"{patch}",        // Patch version number
+	"{prerelease}",   // Pre-release identifier (empty if none)
+	"{build}",        // Build metadata (empty if none)
+	"{commit}",       // Full commit hash of HEAD
+	"{commit_short}", // Abbreviated commit hash of HEAD
+	"{commit_count}", // Number of commits reachable from HEAD
+	"{branch}",       // Current branch name
+	"{branch_slug}",  // Branch name lowercased with non-alphanumerics replaced by "-"
+	"{ci_run}",       // CI run or build number (empty outside CI)
 }
 
 // TemplateData holds values for template placeholder substitution.
@@ -35,6 +41,14 @@ type TemplateData struct {
 	Patch      string
 	PreRelease string
 	Build      string
+
+	// Git and CI context, filled in by WithBuildInfo.
+	Commit      string
+	CommitShort string
+	CommitCount string
+	Branch      string
+	BranchSlug  string
+	CIRun       string
 }
 
 // NowFunc returns the current time. Used to allow deterministic testing.
@@ -75,6 +89,12 @@ func FormatMessage(template string, data TemplateData) string {
 	result = strings.ReplaceAll(result, "{patch}", data.Patch)
 	result = strings.ReplaceAll(result, "{prerelease}", data.PreRelease)
 	result = strings.ReplaceAll(result, "{build}", data.Build)
+	result = strings.ReplaceAll(result, "{commit_short}", data.CommitShort)
+	result = strings.ReplaceAll(result, "{commit_count}", data.CommitCount)
+	result = strings.ReplaceAll(result, "{commit}", data.Commit)
+	result = strings.ReplaceAll(result, "{branch_slug}", data.BranchSlug)
+	result = strings.ReplaceAll(result, "{branch}", data.Branch)
+	result = strings.ReplaceAll(result, "{ci_run}", data.CIRun)
 	return result
 }
 
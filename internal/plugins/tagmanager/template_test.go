@@ -398,6 +398,12 @@ func TestTemplatePlaceholders(t *testing.T) {
 		"{patch}",
 		"{prerelease}",
 		"{build}",
+		"{commit}",
+		"{commit_short}",
+		"{commit_count}",
+		"{branch}",
+		"{branch_slug}",
+		"{ci_run}",
 	}
 
 	if len(TemplatePlaceholders) != len(expectedPlaceholders) {
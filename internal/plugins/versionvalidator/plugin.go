@@ -35,6 +35,8 @@ const (
 	RuleNoPatchBump         RuleType = "no-patch-bump"
 	RuleMaxPreReleaseIter   RuleType = "max-prerelease-iterations"
 	RuleRequireEvenMinor    RuleType = "require-even-minor"
+	RuleChannelOrder        RuleType = "channel-order"
+	RuleReleaseLine         RuleType = "release-line"
 )
 
 // Rule represents a single validation rule.
@@ -51,6 +53,14 @@ type Rule struct {
 type Config struct {
 	Enabled bool   `yaml:"enabled"`
 	Rules   []Rule `yaml:"rules,omitempty"`
+
+	// Channels is the ordered pre-release channel progression checked by the
+	// channel-order rule (default alpha, beta, rc).
+	Channels []string `yaml:"-"`
+
+	// ReleaseLine is the release line of the current maintenance branch,
+	// enforced by the release-line rule. Nil outside maintenance branches.
+	ReleaseLine *semver.ReleaseLine `yaml:"-"`
 }
 
 // DefaultConfig returns the default configuration for the version validator.
@@ -125,11 +135,19 @@ func (p *VersionValidatorPlugin) Validate(newVersion, previousVersion semver.Sem
 }
 
 // ValidateSet checks if a manually set version is valid according to configured rules.
+// The version must also conform strictly to the SemVer 2.0.0 grammar, so
+// pre-release identifiers such as "rc.01" or "rc..1" are rejected. Leading
+// zeros in major, minor and patch are lost once parsed, so callers check the
+// raw input with semver.ParseStrict, as the set command does.
 func (p *VersionValidatorPlugin) ValidateSet(version semver.SemVersion) error {
 	if !p.IsEnabled() {
 		return nil
 	}
 
+	if _, err := semver.ParseStrict(version.String()); err != nil {
+		return err
+	}
+
 	for i := range p.cfg.Rules {
 		if err := p.applySetRule(&p.cfg.Rules[i], version); err != nil {
 			return err
@@ -164,6 +182,10 @@ func (p *VersionValidatorPlugin) applyRule(rule *Rule, newVersion, previousVersi
 		return p.validateMaxPreReleaseIterations(rule, newVersion)
 	case RuleRequireEvenMinor:
 		return p.validateRequireEvenMinor(rule, newVersion)
+	case RuleChannelOrder:
+		return p.validateChannelOrder(rule, newVersion, previousVersion)
+	case RuleReleaseLine:
+		return p.validateReleaseLine(rule, newVersion)
 	default:
 		return fmt.Errorf("unknown rule type: %s", rule.Type)
 	}
@@ -186,6 +208,8 @@ func (p *VersionValidatorPlugin) applySetRule(rule *Rule, version semver.SemVers
 		return p.validateMaxPreReleaseIterations(rule, version)
 	case RuleRequireEvenMinor:
 		return p.validateRequireEvenMinor(rule, version)
+	case RuleReleaseLine:
+		return p.validateReleaseLine(rule, version)
 	default:
 		// Other rules don't apply to set operations
 		return nil
@@ -215,12 +239,12 @@ func (p *VersionValidatorPlugin) validatePreReleaseFormat(rule *Rule, version se
 }
 
 // validateMaxVersion checks if a version component exceeds the maximum allowed value.
-func (p *VersionValidatorPlugin) validateMaxVersion(rule *Rule, value int, component string) error {
+func (p *VersionValidatorPlugin) validateMaxVersion(rule *Rule, value uint64, component string) error {
 	if rule.Value <= 0 {
 		return nil // No max configured
 	}
 
-	if value > rule.Value {
+	if value > uint64(rule.Value) {
 		return fmt.Errorf("%s version %d exceeds maximum allowed value %d", component, value, rule.Value)
 	}
 
@@ -240,6 +264,49 @@ func (p *VersionValidatorPlugin) validateRequirePreRelease0x(rule *Rule, version
 	return nil
 }
 
+// validateChannelOrder checks that a pre-release does not move back to an
+// earlier channel of the same version (e.g., 1.4.0-beta.2 -> 1.4.0-alpha.1).
+func (p *VersionValidatorPlugin) validateChannelOrder(rule *Rule, newVersion, previousVersion semver.SemVersion) error {
+	if !rule.Enabled {
+		return nil
+	}
+
+	if newVersion.PreRelease == "" || previousVersion.PreRelease == "" ||
+		newVersion.Major != previousVersion.Major ||
+		newVersion.Minor != previousVersion.Minor ||
+		newVersion.Patch != previousVersion.Patch {
+		return nil
+	}
+
+	channels := p.cfg.Channels
+	if len(channels) == 0 {
+		channels = semver.DefaultChannels
+	}
+
+	newIdx := semver.ChannelIndex(newVersion.PreRelease, channels)
+	prevIdx := semver.ChannelIndex(previousVersion.PreRelease, channels)
+	if newIdx < 0 || prevIdx < 0 || newIdx >= prevIdx {
+		return nil
+	}
+
+	return fmt.Errorf("pre-release channel cannot go backwards from %q to %q (order: %v)",
+		channels[prevIdx], channels[newIdx], channels)
+}
+
+// validateReleaseLine checks that a version stays on the release line of the
+// current maintenance branch, so release/1.3.x cannot bump minor or major.
+func (p *VersionValidatorPlugin) validateReleaseLine(rule *Rule, version semver.SemVersion) error {
+	if !rule.Enabled || p.cfg.ReleaseLine == nil {
+		return nil
+	}
+
+	if !p.cfg.ReleaseLine.Contains(version) {
+		return fmt.Errorf("version %s is outside release line %s of this maintenance branch", version, p.cfg.ReleaseLine)
+	}
+
+	return nil
+}
+
 // defaultCurrentBranchReader is the default branch reader for backward compatibility.
 var defaultCurrentBranchReader core.GitBranchReader = defaultBranchReader
 
@@ -139,7 +139,7 @@ func TestVersionValidatorPlugin_MajorVersionMax(t *testing.T) {
 	tests := []struct {
 		name    string
 		maxVal  int
-		major   int
+		major   uint64
 		wantErr bool
 	}{
 		{
@@ -201,7 +201,7 @@ func TestVersionValidatorPlugin_MinorVersionMax(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		minor   int
+		minor   uint64
 		wantErr bool
 	}{
 		{"within limit", 50, false},
@@ -234,7 +234,7 @@ func TestVersionValidatorPlugin_PatchVersionMax(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		patch   int
+		patch   uint64
 		wantErr bool
 	}{
 		{"within limit", 25, false},
@@ -260,7 +260,7 @@ func TestVersionValidatorPlugin_RequirePreRelease0x(t *testing.T) {
 	tests := []struct {
 		name       string
 		enabled    bool
-		major      int
+		major      uint64
 		preRelease string
 		wantErr    bool
 	}{
@@ -770,6 +770,32 @@ func TestVersionValidatorPlugin_ValidateSet_AllRuleTypes(t *testing.T) {
 	}
 }
 
+func TestVersionValidatorPlugin_ValidateSet_Strict(t *testing.T) {
+	t.Parallel()
+	vv := NewVersionValidator(&Config{Enabled: true})
+
+	tests := []struct {
+		name    string
+		version semver.SemVersion
+		wantErr bool
+	}{
+		{"valid", semver.SemVersion{Major: 1, PreRelease: "rc.1", Build: "001"}, false},
+		{"leading zero pre-release", semver.SemVersion{Major: 1, PreRelease: "rc.01"}, true},
+		{"empty pre-release identifier", semver.SemVersion{Major: 1, PreRelease: "rc..1"}, true},
+		{"empty build identifier", semver.SemVersion{Major: 1, Build: "a..b"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := vv.ValidateSet(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestVersionValidatorPlugin_ValidateSetDisabled(t *testing.T) {
 	t.Parallel()
 	cfg := &Config{
@@ -1019,7 +1045,7 @@ func TestVersionValidatorPlugin_RequireEvenMinor(t *testing.T) {
 	tests := []struct {
 		name       string
 		enabled    bool
-		minor      int
+		minor      uint64
 		preRelease string
 		wantErr    bool
 	}{
@@ -1294,3 +1320,88 @@ func TestVersionValidatorPlugin_CombinedNewRules(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionValidatorPlugin_ChannelOrder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		channels []string
+		previous string
+		next     string
+		enabled  bool
+		wantErr  bool
+	}{
+		{name: "forward channel", previous: "1.4.0-alpha.3", next: "1.4.0-beta.1", enabled: true},
+		{name: "same channel", previous: "1.4.0-beta.1", next: "1.4.0-beta.2", enabled: true},
+		{name: "backwards channel", previous: "1.4.0-beta.2", next: "1.4.0-alpha.1", enabled: true, wantErr: true},
+		{name: "rc back to beta", previous: "1.4.0-rc.1", next: "1.4.0-beta.1", enabled: true, wantErr: true},
+		{name: "custom channels", channels: []string{"beta", "alpha"}, previous: "1.4.0-beta.2", next: "1.4.0-alpha.1", enabled: true},
+		{name: "new version line", previous: "1.4.0-rc.1", next: "1.5.0-alpha.1", enabled: true},
+		{name: "unknown channel", previous: "1.4.0-rc.1", next: "1.4.0-dev.1", enabled: true},
+		{name: "disabled", previous: "1.4.0-beta.2", next: "1.4.0-alpha.1", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := &Config{
+				Enabled:  true,
+				Rules:    []Rule{{Type: RuleChannelOrder, Enabled: tt.enabled}},
+				Channels: tt.channels,
+			}
+			vv := NewVersionValidator(cfg)
+
+			previous, _ := semver.ParseVersion(tt.previous)
+			next, _ := semver.ParseVersion(tt.next)
+			err := vv.Validate(next, previous, "pre")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersionValidatorPlugin_ReleaseLine(t *testing.T) {
+	t.Parallel()
+	minorLine := &semver.ReleaseLine{Major: 1, Minor: 3, HasMinor: true}
+	majorLine := &semver.ReleaseLine{Major: 1}
+
+	tests := []struct {
+		name    string
+		line    *semver.ReleaseLine
+		next    string
+		bump    string
+		enabled bool
+		wantErr bool
+	}{
+		{name: "patch on minor line", line: minorLine, next: "1.3.5", bump: "patch", enabled: true},
+		{name: "minor on minor line", line: minorLine, next: "1.4.0", bump: "minor", enabled: true, wantErr: true},
+		{name: "major on minor line", line: minorLine, next: "2.0.0", bump: "major", enabled: true, wantErr: true},
+		{name: "minor on major line", line: majorLine, next: "1.4.0", bump: "minor", enabled: true},
+		{name: "major on major line", line: majorLine, next: "2.0.0", bump: "major", enabled: true, wantErr: true},
+		{name: "not a maintenance branch", next: "2.0.0", bump: "major", enabled: true},
+		{name: "disabled", line: minorLine, next: "2.0.0", bump: "major", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := &Config{
+				Enabled:     true,
+				Rules:       []Rule{{Type: RuleReleaseLine, Enabled: tt.enabled}},
+				ReleaseLine: tt.line,
+			}
+			vv := NewVersionValidator(cfg)
+
+			previous, _ := semver.ParseVersion("1.3.4")
+			next, _ := semver.ParseVersion(tt.next)
+			if err := vv.Validate(next, previous, tt.bump); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := vv.ValidateSet(next); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -104,7 +104,7 @@ func BenchmarkIncrementPreRelease(b *testing.B) {
 		b.Run(tc.name, func(b *testing.B) {
 			b.ReportAllocs()
 			for i := 0; i < b.N; i++ {
-				_ = IncrementPreRelease(tc.current, tc.base)
+				_, _ = IncrementPreRelease(tc.current, tc.base)
 			}
 		})
 	}
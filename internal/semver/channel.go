@@ -0,0 +1,67 @@
+package semver
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// DefaultChannels is the pre-release channel progression used when none is configured.
+var DefaultChannels = []string{"alpha", "beta", "rc"}
+
+// ChannelIndex returns the position of a pre-release's channel in channels,
+// or -1 if the pre-release is empty or its label is not a configured channel.
+// e.g., ChannelIndex("beta.2", DefaultChannels) -> 1
+func ChannelIndex(preRelease string, channels []string) int {
+	if preRelease == "" {
+		return -1
+	}
+	return slices.Index(channels, ExtractPreReleaseBase(preRelease))
+}
+
+// NextChannel returns the first pre-release of the channel following the
+// channel of current, e.g. "alpha.3" -> "beta.1".
+//
+// Returns an error if current has no pre-release, is not on a configured
+// channel, or is already on the last channel.
+func NextChannel(current string, channels []string) (string, error) {
+	if current == "" {
+		return "", fmt.Errorf("current version has no pre-release; use --label to start a channel")
+	}
+
+	idx := ChannelIndex(current, channels)
+	if idx < 0 {
+		return "", fmt.Errorf("pre-release %q is not on a configured channel (channels: %v)", current, channels)
+	}
+	if idx == len(channels)-1 {
+		return "", fmt.Errorf("pre-release %q is already on the last channel %q; use 'bump release' to promote it", current, channels[idx])
+	}
+
+	return IncrementPreRelease("", channels[idx+1])
+}
+
+// CompareWithChannels compares two versions like Compare, but orders
+// pre-releases of the same version by channel position rather than lexically.
+// Labels outside channels rank after every configured channel and compare
+// among themselves by SemVer precedence, which keeps the order total and
+// transitive for sorting.
+func CompareWithChannels(a, b SemVersion, channels []string) int {
+	if a.PreRelease == "" || b.PreRelease == "" ||
+		a.Major != b.Major || a.Minor != b.Minor || a.Patch != b.Patch {
+		return a.Compare(b)
+	}
+
+	if c := cmp.Compare(channelRank(a.PreRelease, channels), channelRank(b.PreRelease, channels)); c != 0 {
+		return c
+	}
+	return comparePreRelease(a.PreRelease, b.PreRelease)
+}
+
+// channelRank returns the position of a pre-release's channel, or
+// len(channels) for labels that are not a configured channel.
+func channelRank(preRelease string, channels []string) int {
+	if idx := ChannelIndex(preRelease, channels); idx >= 0 {
+		return idx
+	}
+	return len(channels)
+}
@@ -0,0 +1,109 @@
+package semver
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestChannelIndex(t *testing.T) {
+	tests := []struct {
+		pre  string
+		want int
+	}{
+		{"", -1},
+		{"alpha", 0},
+		{"alpha.3", 0},
+		{"beta.1", 1},
+		{"rc2", 2},
+		{"dev.1", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pre, func(t *testing.T) {
+			if got := ChannelIndex(tt.pre, DefaultChannels); got != tt.want {
+				t.Errorf("ChannelIndex(%q) = %d, want %d", tt.pre, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextChannel(t *testing.T) {
+	tests := []struct {
+		current  string
+		channels []string
+		want     string
+		wantErr  bool
+	}{
+		{"alpha.3", DefaultChannels, "beta.1", false},
+		{"beta", DefaultChannels, "rc.1", false},
+		{"beta.2", []string{"alpha", "beta", "preview", "rc"}, "preview.1", false},
+		{"rc.1", DefaultChannels, "", true},
+		{"dev.4", DefaultChannels, "", true},
+		{"", DefaultChannels, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current, func(t *testing.T) {
+			got, err := NextChannel(tt.current, tt.channels)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextChannel(%q) error = %v, wantErr %v", tt.current, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NextChannel(%q) = %q, want %q", tt.current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareWithChannels(t *testing.T) {
+	channels := []string{"nightly", "beta", "rc"}
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0-nightly.5", "1.0.0-beta.1", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.9", 1},
+		{"1.0.0-beta.10", "1.0.0-beta.2", 1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0-nightly.1", "0.9.0", 1},
+		{"1.0.0-foo.1", "1.0.0-bar.1", 1},
+		{"1.0.0-beta.1", "1.0.0-beta.1", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_vs_"+tt.b, func(t *testing.T) {
+			a, b := mustParse(t, tt.a), mustParse(t, tt.b)
+			if got := CompareWithChannels(a, b, channels); got != tt.want {
+				t.Errorf("CompareWithChannels(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareWithChannels_UnknownLabelsTransitive(t *testing.T) {
+	channels := []string{"dev", "alpha"}
+	// Expected order: configured channels first, then unknown labels by SemVer.
+	ordered := []string{"1.0.0-dev.2", "1.0.0-alpha.1", "1.0.0-beta.1", "1.0.0-beta.2", "1.0.0-canary.1", "1.0.0"}
+
+	for i := range ordered {
+		for j := range ordered {
+			a, b := mustParse(t, ordered[i]), mustParse(t, ordered[j])
+			if got, want := CompareWithChannels(a, b, channels), cmp.Compare(i, j); got != want {
+				t.Errorf("CompareWithChannels(%s, %s) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+
+	shuffled := []string{"1.0.0-canary.1", "1.0.0-beta.2", "1.0.0", "1.0.0-alpha.1", "1.0.0-beta.1", "1.0.0-dev.2"}
+	versions := make([]SemVersion, len(shuffled))
+	for i, s := range shuffled {
+		versions[i] = mustParse(t, s)
+	}
+	slices.SortStableFunc(versions, func(a, b SemVersion) int { return CompareWithChannels(a, b, channels) })
+	for i, v := range versions {
+		if v.String() != ordered[i] {
+			t.Errorf("sorted[%d] = %s, want %s", i, v, ordered[i])
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// errInvalidConstraint is returned when a range expression cannot be parsed.
+var errInvalidConstraint = errors.New("invalid constraint")
+
+// Constraint is a parsed npm-style version range, such as "^1.3",
+// "~1.2.3", "1.x", "1.2.3 - 2.0" or ">=1.0.0 <2.0.0 || >=3.0.0".
+//
+// A version satisfies the constraint when it satisfies every comparator of
+// at least one "||"-separated set. As in npm, a pre-release version only
+// satisfies a set when one of its comparators has a pre-release on the same
+// major.minor.patch, unless IncludePrerelease is set.
+type Constraint struct {
+	// IncludePrerelease lets pre-release versions match any range they fall in.
+	IncludePrerelease bool
+
+	raw  string
+	sets [][]comparator
+}
+
+// comparator is a single primitive check such as ">=1.2.0".
+type comparator struct {
+	op      string // one of "=", "<", "<=", ">", ">="
+	version SemVersion
+	none    bool // matches nothing (e.g. "<0.0.0-0" or ">*")
+}
+
+// partial is a possibly incomplete version such as "1", "1.2" or "1.x".
+// Only the first n components are set; wildcard or missing ones are zero.
+type partial struct {
+	major, minor, patch uint64
+	n                   int
+	pre, build          string
+}
+
+// ParseConstraint parses an npm-style range expression.
+//
+// Supported syntax:
+//   - Primitives: "=1.2.3", "<1.2.3", "<=1.2.3", ">1.2.3", ">=1.2.3"
+//   - X-ranges: "*", "1.x", "1.2.*", "1", "1.2"
+//   - Tilde ranges: "~1.2.3" (>=1.2.3 <1.3.0), "~1" (>=1.0.0 <2.0.0)
+//   - Caret ranges: "^1.2.3" (>=1.2.3 <2.0.0), "^0.2.3" (>=0.2.3 <0.3.0)
+//   - Hyphen ranges: "1.2.3 - 2.3.4" (>=1.2.3 <=2.3.4)
+//   - Intersections separated by spaces and unions separated by "||"
+func ParseConstraint(s string) (*Constraint, error) {
+	if len(s) > maxVersionLength*8 {
+		return nil, fmt.Errorf("%w: expression too long", errInvalidConstraint)
+	}
+
+	c := &Constraint{raw: strings.TrimSpace(s)}
+	for set := range strings.SplitSeq(s, "||") {
+		comparators, err := parseComparatorSet(strings.TrimSpace(set))
+		if err != nil {
+			return nil, err
+		}
+		c.sets = append(c.sets, comparators)
+	}
+	return c, nil
+}
+
+// String returns the expression the constraint was parsed from.
+func (c *Constraint) String() string {
+	return c.raw
+}
+
+// Check reports whether the version satisfies the constraint.
+func (c *Constraint) Check(v SemVersion) bool {
+	for _, set := range c.sets {
+		if c.setMatches(set, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// setMatches reports whether v satisfies every comparator of a set.
+func (c *Constraint) setMatches(set []comparator, v SemVersion) bool {
+	for _, cmp := range set {
+		if !cmp.matches(v) {
+			return false
+		}
+	}
+	if v.PreRelease == "" || c.IncludePrerelease {
+		return true
+	}
+
+	// Pre-releases only match when the set opts into that major.minor.patch.
+	for _, cmp := range set {
+		cv := cmp.version
+		if cv.PreRelease != "" && cv.Major == v.Major && cv.Minor == v.Minor && cv.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+// matches applies the comparator to a version.
+func (cmp comparator) matches(v SemVersion) bool {
+	if cmp.none {
+		return false
+	}
+	c := v.Compare(cmp.version)
+	switch cmp.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	default:
+		return c == 0
+	}
+}
+
+// parseComparatorSet parses a space-separated intersection or a hyphen range.
+func parseComparatorSet(set string) ([]comparator, error) {
+	fields := strings.Fields(set)
+	if len(fields) == 0 {
+		return []comparator{{op: ">=", version: SemVersion{}}}, nil // empty set matches any version
+	}
+
+	if len(fields) == 3 && fields[1] == "-" {
+		return parseHyphenRange(fields[0], fields[2])
+	}
+
+	var comparators []comparator
+	for i := 0; i < len(fields); i++ {
+		token := fields[i]
+		// Allow whitespace between an operator and its version (">= 1.2.3").
+		if strings.Trim(token, "<>=~^") == "" && i+1 < len(fields) {
+			i++
+			token += fields[i]
+		}
+		parsed, err := parseRangeToken(token)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, parsed...)
+	}
+	return comparators, nil
+}
+
+// parseRangeToken desugars a single range token into primitive comparators.
+func parseRangeToken(token string) ([]comparator, error) {
+	op, rest := splitOperator(token)
+	p, err := parsePartial(rest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", errInvalidConstraint, token, err)
+	}
+
+	switch op {
+	case "^":
+		return caretRange(p), nil
+	case "~", "~>":
+		return tildeRange(p), nil
+	case "", "=":
+		return xRange(p), nil
+	default:
+		return primitiveRange(op, p), nil
+	}
+}
+
+// splitOperator separates a leading range operator from the version.
+func splitOperator(token string) (string, string) {
+	for _, op := range []string{">=", "<=", "~>", ">", "<", "=", "^", "~"} {
+		if rest, ok := strings.CutPrefix(token, op); ok {
+			return op, rest
+		}
+	}
+	return "", token
+}
+
+// parsePartial parses a full or partial version with optional wildcards.
+func parsePartial(s string) (partial, error) {
+	var p partial
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if s == "" || s == "*" || s == "x" || s == "X" {
+		return p, nil
+	}
+
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s, p.build = s[:i], s[i+1:]
+	}
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) == 3 {
+		if i := strings.IndexByte(parts[2], '-'); i >= 0 {
+			parts[2], p.pre = parts[2][:i], parts[2][i+1:]
+			if p.pre == "" {
+				return p, errors.New("empty pre-release")
+			}
+		}
+	}
+
+	values := []*uint64{&p.major, &p.minor, &p.patch}
+	wildcard := false
+	for i, part := range parts {
+		if part == "*" || part == "x" || part == "X" {
+			wildcard = true
+			continue
+		}
+		if wildcard {
+			return p, fmt.Errorf("number after wildcard in %q", s)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return p, fmt.Errorf("invalid number %q", part)
+		}
+		*values[i] = n
+		p.n = i + 1
+	}
+	if p.pre != "" && p.n < 3 {
+		return p, errors.New("pre-release requires a full version")
+	}
+	return p, nil
+}
+
+// full returns the version with missing components as zero.
+func (p partial) full() SemVersion {
+	return SemVersion{Major: p.major, Minor: p.minor, Patch: p.patch, PreRelease: p.pre}
+}
+
+// nextMajor returns the first release of the following major version.
+// It reports false when major is already the largest representable value.
+func nextMajor(major uint64) (SemVersion, bool) {
+	if major == math.MaxUint64 {
+		return SemVersion{}, false
+	}
+	return SemVersion{Major: major + 1}, true
+}
+
+// nextMinor returns the first release of the following minor version.
+func nextMinor(major, minor uint64) (SemVersion, bool) {
+	if minor == math.MaxUint64 {
+		return nextMajor(major)
+	}
+	return SemVersion{Major: major, Minor: minor + 1}, true
+}
+
+// nextPatch returns the release following major.minor.patch.
+func nextPatch(major, minor, patch uint64) (SemVersion, bool) {
+	if patch == math.MaxUint64 {
+		return nextMinor(major, minor)
+	}
+	return SemVersion{Major: major, Minor: minor, Patch: patch + 1}, true
+}
+
+// before returns the sentinel upper bound excluding next and its
+// pre-releases ("<X.Y.Z-0"), or nil when there is no next version and the
+// range is unbounded above.
+func before(next SemVersion, ok bool) []comparator {
+	if !ok {
+		return nil
+	}
+	next.PreRelease = "0"
+	return []comparator{{op: "<", version: next}}
+}
+
+// xRange desugars "1.2.x", "1" or an exact version.
+func xRange(p partial) []comparator {
+	switch p.n {
+	case 0:
+		return []comparator{{op: ">=", version: SemVersion{}}}
+	case 1:
+		return append([]comparator{{op: ">=", version: p.full()}}, before(nextMajor(p.major))...)
+	case 2:
+		return append([]comparator{{op: ">=", version: p.full()}}, before(nextMinor(p.major, p.minor))...)
+	default:
+		return []comparator{{op: "=", version: p.full()}}
+	}
+}
+
+// tildeRange desugars "~1.2.3" (patch-level changes) and "~1" (minor-level changes).
+func tildeRange(p partial) []comparator {
+	switch p.n {
+	case 0:
+		return xRange(p)
+	case 1:
+		return append([]comparator{{op: ">=", version: p.full()}}, before(nextMajor(p.major))...)
+	default:
+		return append([]comparator{{op: ">=", version: p.full()}}, before(nextMinor(p.major, p.minor))...)
+	}
+}
+
+// caretRange desugars "^1.2.3", allowing changes that do not modify the
+// left-most non-zero component.
+func caretRange(p partial) []comparator {
+	if p.n == 0 {
+		return xRange(p)
+	}
+
+	var upper []comparator
+	switch {
+	case p.major > 0 || p.n < 2:
+		upper = before(nextMajor(p.major))
+	case p.minor > 0 || p.n < 3:
+		upper = before(nextMinor(0, p.minor))
+	default:
+		upper = before(nextPatch(0, 0, p.patch))
+	}
+	return append([]comparator{{op: ">=", version: p.full()}}, upper...)
+}
+
+// primitiveRange desugars a comparison against a possibly partial version.
+func primitiveRange(op string, p partial) []comparator {
+	if p.n == 0 {
+		if op == "<" || op == ">" {
+			return []comparator{{none: true}}
+		}
+		return []comparator{{op: ">=", version: SemVersion{}}}
+	}
+	if p.n == 3 {
+		return []comparator{{op: op, version: p.full()}}
+	}
+
+	// Partial versions compare against the whole range they cover.
+	next, ok := nextMajor(p.major)
+	if p.n == 2 {
+		next, ok = nextMinor(p.major, p.minor)
+	}
+	switch op {
+	case ">":
+		if !ok {
+			return []comparator{{none: true}}
+		}
+		return []comparator{{op: ">=", version: next}}
+	case "<=":
+		if !ok {
+			return []comparator{{op: ">=", version: SemVersion{}}}
+		}
+		return before(next, ok)
+	case "<":
+		return before(SemVersion{Major: p.major, Minor: p.minor}, true)
+	default: // ">="
+		return []comparator{{op: ">=", version: p.full()}}
+	}
+}
+
+// parseHyphenRange desugars "A - B" into an inclusive range. A partial upper
+// bound includes everything it covers ("1.2.3 - 2.3" means <2.4.0).
+func parseHyphenRange(from, to string) ([]comparator, error) {
+	lo, err := parsePartial(from)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", errInvalidConstraint, from, err)
+	}
+	hi, err := parsePartial(to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", errInvalidConstraint, to, err)
+	}
+
+	comparators := []comparator{{op: ">=", version: lo.full()}}
+	switch hi.n {
+	case 0:
+	case 1:
+		comparators = append(comparators, before(nextMajor(hi.major))...)
+	case 2:
+		comparators = append(comparators, before(nextMinor(hi.major, hi.minor))...)
+	default:
+		comparators = append(comparators, comparator{op: "<=", version: hi.full()})
+	}
+	return comparators, nil
+}
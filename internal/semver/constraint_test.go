@@ -0,0 +1,107 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matches    []string
+		misses     []string
+	}{
+		{"^1.3", []string{"1.3.0", "1.4.2", "1.99.0"}, []string{"1.2.9", "2.0.0", "2.0.0-alpha"}},
+		{"^1.2.3", []string{"1.2.3", "1.9.9"}, []string{"1.2.2", "2.0.0"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0", "0.2.2"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4", "0.0.2"}},
+		{"^0.0", []string{"0.0.0", "0.0.9"}, []string{"0.1.0"}},
+		{"^0.x", []string{"0.0.1", "0.9.0"}, []string{"1.0.0"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0", "0.9.0"}},
+		{"1.x", []string{"1.0.0", "1.5.3"}, []string{"2.0.0", "0.9.9"}},
+		{"1.2.*", []string{"1.2.0", "1.2.7"}, []string{"1.3.0"}},
+		{"1", []string{"1.0.0", "1.9.9"}, []string{"2.0.0"}},
+		{"*", []string{"0.0.0", "9.9.9"}, []string{"1.0.0-rc.1"}},
+		{"", []string{"1.2.3"}, nil},
+		{"1.2.3", []string{"1.2.3", "v1.2.3", "1.2.3+build"}, []string{"1.2.4"}},
+		{"=1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{">=1.2.0 <1.5.0", []string{"1.2.0", "1.4.9"}, []string{"1.5.0", "1.1.9"}},
+		{">= 1.2.0 < 1.5.0", []string{"1.3.0"}, []string{"1.5.0"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.9"}},
+		{"<=1.2", []string{"1.2.9"}, []string{"1.3.0"}},
+		{"<1.2", []string{"1.1.9"}, []string{"1.2.0"}},
+		{"<*", nil, []string{"0.0.0", "1.0.0"}},
+		{"1.2.3 - 2.3.4", []string{"1.2.3", "2.3.4"}, []string{"2.3.5", "1.2.2"}},
+		{"1.2 - 2.3", []string{"1.2.0", "2.3.9"}, []string{"2.4.0"}},
+		{"1.2.3 - 2", []string{"2.9.9"}, []string{"3.0.0"}},
+		{"^18446744073709551615", []string{"18446744073709551615.0.0", "18446744073709551615.99.0"}, []string{"18446744073709551614.9.9"}},
+		{"~1.18446744073709551615", []string{"1.18446744073709551615.7"}, []string{"2.0.0"}},
+		{">18446744073709551615", nil, []string{"18446744073709551615.18446744073709551615.18446744073709551615"}},
+		{"^1.0.0 || ^3.0.0", []string{"1.5.0", "3.1.0"}, []string{"2.0.0"}},
+		{">=1.2.3-beta.2 <1.3.0", []string{"1.2.3-beta.2", "1.2.3-beta.10", "1.2.3", "1.2.8"}, []string{"1.2.3-beta.1", "1.2.4-beta.1"}},
+		{"~1.2.3-rc.1", []string{"1.2.3-rc.2", "1.2.5"}, []string{"1.2.4-rc.1", "1.3.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatalf("ParseConstraint(%q) error = %v", tt.constraint, err)
+			}
+			for _, raw := range tt.matches {
+				if !c.Check(mustParse(t, raw)) {
+					t.Errorf("%q should satisfy %q", raw, tt.constraint)
+				}
+			}
+			for _, raw := range tt.misses {
+				if c.Check(mustParse(t, raw)) {
+					t.Errorf("%q should not satisfy %q", raw, tt.constraint)
+				}
+			}
+		})
+	}
+}
+
+func TestConstraint_IncludePrerelease(t *testing.T) {
+	c, err := ParseConstraint("^1.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := mustParse(t, "1.4.0-rc.1")
+	if c.Check(v) {
+		t.Error("pre-release should not match by default")
+	}
+	c.IncludePrerelease = true
+	if !c.Check(v) {
+		t.Error("pre-release should match with IncludePrerelease")
+	}
+}
+
+func TestParseConstraint_Invalid(t *testing.T) {
+	for _, expr := range []string{"^a.b", "1.x.3", ">=1.2.3-", "1.2-beta", "1.2.3.4", "1 - x.y"} {
+		if _, err := ParseConstraint(expr); !errors.Is(err, errInvalidConstraint) {
+			t.Errorf("ParseConstraint(%q) error = %v, want errInvalidConstraint", expr, err)
+		}
+	}
+}
+
+func TestConstraint_String(t *testing.T) {
+	c, err := ParseConstraint(" ^1.3 || ~2.0 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.String() != "^1.3 || ~2.0" {
+		t.Errorf("String() = %q", c.String())
+	}
+}
+
+func mustParse(t *testing.T, raw string) SemVersion {
+	t.Helper()
+	v, err := ParseVersion(raw)
+	if err != nil {
+		t.Fatalf("ParseVersion(%q) error = %v", raw, err)
+	}
+	return v
+}
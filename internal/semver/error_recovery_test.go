@@ -147,14 +147,14 @@ func TestVersionManager_ConcurrentWrites_Recovery(t *testing.T) {
 
 	for i := range numWriters {
 		wg.Add(1)
-		go func(version int) {
+		go func(version uint64) {
 			defer wg.Done()
 			ctx := context.Background()
 			err := mgr.Save(ctx, "/test/.version", SemVersion{Major: 1, Minor: 0, Patch: version})
 			if err != nil {
 				errs <- err
 			}
-		}(i)
+		}(uint64(i))
 	}
 
 	wg.Wait()
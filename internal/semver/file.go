@@ -17,6 +17,30 @@ func ReadVersion(path string) (SemVersion, error) {
 	return defaultManager.Read(context.Background(), path)
 }
 
+// ReadVersionStrict reads a version file and rejects content that does not
+// conform exactly to the SemVer 2.0.0 grammar.
+// This is a convenience function that uses the default VersionManager with context.Background().
+func ReadVersionStrict(path string) (SemVersion, error) {
+	return defaultManager.ReadStrict(context.Background(), path)
+}
+
+// DescribeVersion returns the version to display for the current commit,
+// which is describe-style for untagged commits when versions come from git tags.
+// This is a convenience function that uses the default VersionManager with context.Background().
+func DescribeVersion(path string) (SemVersion, error) {
+	return defaultManager.Describe(context.Background(), path)
+}
+
+// UsesGitTags reports whether the default VersionManager derives versions from git tags.
+func UsesGitTags() bool {
+	return defaultManager.UsesGitTags()
+}
+
+// CheckRecordable reports whether the default VersionManager can record version.
+func CheckRecordable(version SemVersion) error {
+	return defaultManager.CheckRecordable(version)
+}
+
 // SaveVersion writes a SemVersion to the given file path.
 // This is a convenience function that uses the default VersionManager with context.Background().
 // For better testability and context control, use VersionManager.Save() instead.
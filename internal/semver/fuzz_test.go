@@ -1,8 +1,13 @@
 package semver
 
 import (
+	"cmp"
+	"errors"
+	"math"
 	"strings"
 	"testing"
+
+	"github.com/indaco/sley/internal/apperrors"
 )
 
 // FuzzParseVersion tests the version parser with random inputs.
@@ -94,8 +99,13 @@ func versionsEqual(v1, v2 SemVersion) bool {
 func verifySanity(t *testing.T, v SemVersion) {
 	t.Helper()
 
-	if v.Major < 0 || v.Minor < 0 || v.Patch < 0 {
-		t.Errorf("negative version component: %+v", v)
+	if v.Compare(v) != 0 {
+		t.Errorf("version does not compare equal to itself: %+v", v)
+	}
+	withoutBuild := v
+	withoutBuild.Build = ""
+	if v.Compare(withoutBuild) != 0 {
+		t.Errorf("build metadata affects precedence: %+v", v)
 	}
 }
 
@@ -133,26 +143,35 @@ func FuzzIncrementPreRelease(f *testing.F) {
 
 	f.Fuzz(func(t *testing.T, current, base string) {
 		// Should never panic
-		result := IncrementPreRelease(current, base)
+		result, err := IncrementPreRelease(current, base)
+		if err != nil {
+			var overflow *apperrors.VersionOverflowError
+			if !errors.As(err, &overflow) {
+				t.Errorf("unexpected error type for (%q, %q): %v", current, base, err)
+			}
+			return
+		}
 
 		// Result should contain the base
 		if !strings.HasPrefix(result, base) {
 			t.Errorf("result %q should start with base %q", result, base)
 		}
 
-		// Result should have format "base.N" where N >= 1
-		if !strings.Contains(result, ".") {
-			t.Errorf("result %q should contain a dot", result)
+		// Result should have format "base<sep>N" where sep is ".", "-" or empty
+		suffix := strings.TrimPrefix(result, base)
+		if trimmed := strings.TrimLeft(suffix, ".-"); len(suffix)-len(trimmed) > 1 || !isNumericIdentifier(trimmed) || trimmed == "0" {
+			t.Errorf("result %q should end in a separator and a number >= 1", result)
 		}
 	})
 }
 
-// FuzzBumpByLabel tests the bump logic with random inputs.
+// FuzzBumpByLabel tests the bump logic with random inputs, including
+// components at math.MaxUint64 that must report an overflow.
 func FuzzBumpByLabel(f *testing.F) {
 	seeds := []struct {
-		major int
-		minor int
-		patch int
+		major uint64
+		minor uint64
+		patch uint64
 		label string
 	}{
 		{1, 2, 3, "patch"},
@@ -161,92 +180,168 @@ func FuzzBumpByLabel(f *testing.F) {
 		{0, 0, 0, "patch"},
 		{999, 999, 999, "patch"},
 		{0, 0, 0, "invalid"},
+		{1, 2, math.MaxUint64, "patch"},
+		{1, math.MaxUint64, 3, "minor"},
+		{math.MaxUint64, 2, 3, "major"},
+		{math.MaxUint64, math.MaxUint64, 3, "patch"},
 	}
 
 	for _, seed := range seeds {
 		f.Add(seed.major, seed.minor, seed.patch, seed.label)
 	}
 
-	f.Fuzz(func(t *testing.T, major, minor, patch int, label string) {
-		fuzzBumpByLabelInput(t, major, minor, patch, label)
+	f.Fuzz(func(t *testing.T, major, minor, patch uint64, label string) {
+		v := SemVersion{Major: major, Minor: minor, Patch: patch}
+		result, err := BumpByLabel(v, label)
+
+		verifyBumpResult(t, v, result, err, label)
 	})
 }
 
-// fuzzBumpByLabelInput tests a single input for BumpByLabel.
-func fuzzBumpByLabelInput(t *testing.T, major, minor, patch int, label string) {
+// verifyBumpResult checks the result of BumpByLabel.
+func verifyBumpResult(t *testing.T, v, result SemVersion, err error, label string) {
 	t.Helper()
 
-	// Skip invalid inputs
-	if !isValidVersionInput(major, minor, patch) {
+	components := map[string]uint64{"patch": v.Patch, "minor": v.Minor, "major": v.Major}
+	component, ok := components[label]
+	switch {
+	case !ok:
+		if err == nil {
+			t.Errorf("expected error for invalid label %q", label)
+		}
+		return
+	case component == math.MaxUint64:
+		var overflow *apperrors.VersionOverflowError
+		if !errors.As(err, &overflow) {
+			t.Errorf("expected overflow error for %s bump of %s, got %v", label, v, err)
+		}
+		return
+	case err != nil:
+		t.Errorf("unexpected error for %s bump: %v", label, err)
 		return
 	}
 
-	v := SemVersion{Major: major, Minor: minor, Patch: patch}
-	result, err := BumpByLabel(v, label)
-
-	verifyBumpResult(t, v, result, err, label)
-}
-
-// isValidVersionInput checks if version components are valid for testing.
-func isValidVersionInput(major, minor, patch int) bool {
-	if major < 0 || minor < 0 || patch < 0 {
-		return false
+	if result.Compare(v) <= 0 {
+		t.Errorf("%s bump of %s produced %s, which is not greater", label, v, result)
 	}
-	// Cap very large values to avoid overflow issues
-	if major > 1000000 || minor > 1000000 || patch > 1000000 {
-		return false
+
+	want := map[string]SemVersion{
+		"patch": {Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1},
+		"minor": {Major: v.Major, Minor: v.Minor + 1},
+		"major": {Major: v.Major + 1},
+	}[label]
+	if result != want {
+		t.Errorf("%s bump of %s = %s, want %s", label, v, result, want)
 	}
-	return true
 }
 
-// verifyBumpResult checks the result of BumpByLabel.
-func verifyBumpResult(t *testing.T, v, result SemVersion, err error, label string) {
-	t.Helper()
-
-	validators := map[string]func(){
-		"patch": func() { verifyPatchBump(t, v, result, err) },
-		"minor": func() { verifyMinorBump(t, v, result, err) },
-		"major": func() { verifyMajorBump(t, v, result, err) },
-	}
+// FuzzBumpNext tests that BumpNext always moves forward or reports an overflow.
+func FuzzBumpNext(f *testing.F) {
+	f.Add(uint64(1), uint64(2), uint64(3), "")
+	f.Add(uint64(1), uint64(2), uint64(3), "rc.1")
+	f.Add(uint64(0), uint64(9), uint64(0), "")
+	f.Add(uint64(1), uint64(2), uint64(math.MaxUint64), "")
+	f.Add(uint64(1), uint64(2), uint64(math.MaxUint64), "beta")
+
+	f.Fuzz(func(t *testing.T, major, minor, patch uint64, pre string) {
+		v := SemVersion{Major: major, Minor: minor, Patch: patch, PreRelease: pre}
+		result, err := BumpNext(v)
+		if err != nil {
+			var overflow *apperrors.VersionOverflowError
+			if !errors.As(err, &overflow) || pre != "" || patch != math.MaxUint64 {
+				t.Errorf("unexpected error for BumpNext(%s): %v", v, err)
+			}
+			return
+		}
+		if result.Compare(v) <= 0 {
+			t.Errorf("BumpNext(%s) = %s, which is not greater", v, result)
+		}
+	})
+}
 
-	if validator, ok := validators[label]; ok {
-		validator()
-	} else if err == nil {
-		t.Errorf("expected error for invalid label %q", label)
-	}
+// specPrecedence lists versions in increasing precedence, taken from the
+// examples in the SemVer 2.0.0 specification (items 11.2 to 11.4).
+var specPrecedence = []string{
+	"1.0.0-alpha",
+	"1.0.0-alpha.1",
+	"1.0.0-alpha.beta",
+	"1.0.0-beta",
+	"1.0.0-beta.2",
+	"1.0.0-beta.11",
+	"1.0.0-rc.1",
+	"1.0.0",
+	"2.0.0",
+	"2.1.0",
+	"2.1.1",
 }
 
-func verifyPatchBump(t *testing.T, v, result SemVersion, err error) {
-	t.Helper()
-	if err != nil {
-		t.Errorf("unexpected error for patch bump: %v", err)
-		return
-	}
-	if result.Patch != v.Patch+1 {
-		t.Errorf("patch bump failed: expected %d, got %d", v.Patch+1, result.Patch)
+func TestCompare_SpecPrecedence(t *testing.T) {
+	for i := range specPrecedence {
+		for j := range specPrecedence {
+			a, b := mustParse(t, specPrecedence[i]), mustParse(t, specPrecedence[j])
+			if got, want := a.Compare(b), cmp.Compare(i, j); got != want {
+				t.Errorf("Compare(%s, %s) = %d, want %d", a, b, got, want)
+			}
+		}
 	}
 }
 
-func verifyMinorBump(t *testing.T, v, result SemVersion, err error) {
-	t.Helper()
-	if err != nil {
-		t.Errorf("unexpected error for minor bump: %v", err)
-		return
+// FuzzCompare checks that Compare is a total order consistent with the
+// specification's precedence examples: reflexive, antisymmetric, transitive,
+// independent of build metadata, and placing every version at a single
+// position in the spec's ordered list.
+func FuzzCompare(f *testing.F) {
+	seeds := [][3]string{
+		{"1.0.0", "1.0.0", "1.0.0"},
+		{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0"},
+		{"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-beta.011"},
+		{"1.0.0-rc.1+build.1", "1.0.0-rc.1+build.2", "1.0.0-rc.1"},
+		{"1.0.0-99999999999999999999", "1.0.0-100000000000000000000", "1.0.0-a"},
+		{"18446744073709551615.0.0", "1.18446744073709551615.0", "0.0.18446744073709551615"},
+		{"1.0.0-0", "1.0.0--", "1.0.0-A"},
 	}
-	if result.Minor != v.Minor+1 || result.Patch != 0 {
-		t.Errorf("minor bump failed: expected %d.%d.0, got %d.%d.%d",
-			v.Major, v.Minor+1, result.Major, result.Minor, result.Patch)
+	for _, seed := range seeds {
+		f.Add(seed[0], seed[1], seed[2])
 	}
-}
 
-func verifyMajorBump(t *testing.T, v, result SemVersion, err error) {
-	t.Helper()
-	if err != nil {
-		t.Errorf("unexpected error for major bump: %v", err)
-		return
-	}
-	if result.Major != v.Major+1 || result.Minor != 0 || result.Patch != 0 {
-		t.Errorf("major bump failed: expected %d.0.0, got %d.%d.%d",
-			v.Major+1, result.Major, result.Minor, result.Patch)
+	chain := make([]SemVersion, len(specPrecedence))
+	for i, s := range specPrecedence {
+		chain[i], _ = ParseVersion(s)
 	}
+
+	f.Fuzz(func(t *testing.T, sa, sb, sc string) {
+		a, errA := ParseVersion(sa)
+		b, errB := ParseVersion(sb)
+		c, errC := ParseVersion(sc)
+		if errA != nil || errB != nil || errC != nil {
+			return
+		}
+
+		if a.Compare(a) != 0 {
+			t.Errorf("Compare is not reflexive for %s", a)
+		}
+		if ab, ba := a.Compare(b), b.Compare(a); ab != -ba {
+			t.Errorf("Compare is not antisymmetric: (%s, %s) = %d, reverse = %d", a, b, ab, ba)
+		}
+		if a.Compare(b) <= 0 && b.Compare(c) <= 0 && a.Compare(c) > 0 {
+			t.Errorf("Compare is not transitive: %s <= %s <= %s but %s > %s", a, b, c, a, c)
+		}
+
+		stripped := a
+		stripped.Build = ""
+		if a.Compare(stripped) != 0 {
+			t.Errorf("build metadata affects precedence of %s", a)
+		}
+
+		// Once a is below an element of the spec chain it must stay below
+		// every later element.
+		below := false
+		for _, v := range chain {
+			c := a.Compare(v)
+			if below && c >= 0 {
+				t.Errorf("%s is below an earlier spec example but not below %s", a, v)
+			}
+			below = below || c < 0
+		}
+	})
 }
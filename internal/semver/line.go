@@ -0,0 +1,106 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ReleaseLine is a maintenance line of versions: 1.x covers every 1.y.z,
+// 1.3.x covers every 1.3.z.
+type ReleaseLine struct {
+	Major    uint64
+	Minor    uint64
+	HasMinor bool
+}
+
+var (
+	// releaseLineRegex matches line specs such as "1", "1.x", "v1.3" or "1.3.x".
+	releaseLineRegex = regexp.MustCompile(`^v?(\d+)(?:\.(\d+|x|\*))?(?:\.(?:x|\*))?$`)
+
+	// branchLineRegex matches a line spec at the end of a branch name,
+	// e.g. "release/1.x" or "hotfix-1.3.x".
+	branchLineRegex = regexp.MustCompile(`(?:^|[^0-9A-Za-z.])(v?\d+(?:\.(?:\d+|x|\*))?(?:\.(?:x|\*))?)$`)
+)
+
+// ParseReleaseLine parses a line spec such as "1.x", "1.3.x", "v1" or "1.3".
+func ParseReleaseLine(s string) (ReleaseLine, error) {
+	m := releaseLineRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return ReleaseLine{}, fmt.Errorf("invalid release line %q: use a form like 1.x or 1.3.x", s)
+	}
+
+	major, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return ReleaseLine{}, fmt.Errorf("invalid release line %q: %w", s, err)
+	}
+	line := ReleaseLine{Major: major}
+
+	if m[2] != "" && m[2] != "x" && m[2] != "*" {
+		if line.Minor, err = strconv.ParseUint(m[2], 10, 64); err != nil {
+			return ReleaseLine{}, fmt.Errorf("invalid release line %q: %w", s, err)
+		}
+		line.HasMinor = true
+	}
+	return line, nil
+}
+
+// ReleaseLineFromBranch derives the release line from the end of a branch
+// name, e.g. "release/1.x" -> 1.x and "release/1.3.x" -> 1.3.x.
+func ReleaseLineFromBranch(branch string) (ReleaseLine, error) {
+	m := branchLineRegex.FindStringSubmatch(branch)
+	if m == nil {
+		return ReleaseLine{}, fmt.Errorf("cannot derive a release line from branch %q: set line explicitly", branch)
+	}
+	return ParseReleaseLine(m[1])
+}
+
+// Contains reports whether the version belongs to the line.
+func (l ReleaseLine) Contains(v SemVersion) bool {
+	if v.Major != l.Major {
+		return false
+	}
+	return !l.HasMinor || v.Minor == l.Minor
+}
+
+// TagPattern returns the git tag glob for the line's tags, e.g. "v1.3.*".
+func (l ReleaseLine) TagPattern(prefix string) string {
+	if l.HasMinor {
+		return fmt.Sprintf("%s%d.%d.*", prefix, l.Major, l.Minor)
+	}
+	return fmt.Sprintf("%s%d.*", prefix, l.Major)
+}
+
+// String returns the line spec, e.g. "1.x" or "1.3.x".
+func (l ReleaseLine) String() string {
+	if l.HasMinor {
+		return fmt.Sprintf("%d.%d.x", l.Major, l.Minor)
+	}
+	return fmt.Sprintf("%d.x", l.Major)
+}
+
+// LatestInLine returns the newest tag whose version, after stripping prefix,
+// belongs to the line. Tags that are not semver versions are ignored.
+// Returns false when no tag matches.
+func LatestInLine(tags []string, prefix string, line ReleaseLine) (string, bool) {
+	var (
+		latestTag string
+		latest    SemVersion
+		found     bool
+	)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if !strings.HasPrefix(tag, prefix) {
+			continue
+		}
+		version, err := ParseVersion(strings.TrimPrefix(tag, prefix))
+		if err != nil || !line.Contains(version) {
+			continue
+		}
+		if !found || version.Compare(latest) > 0 {
+			latestTag, latest, found = tag, version, true
+		}
+	}
+	return latestTag, found
+}
@@ -0,0 +1,115 @@
+package semver
+
+import "testing"
+
+func TestParseReleaseLine(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ReleaseLine
+		wantErr bool
+	}{
+		{input: "1", want: ReleaseLine{Major: 1}},
+		{input: "1.x", want: ReleaseLine{Major: 1}},
+		{input: "v2.*", want: ReleaseLine{Major: 2}},
+		{input: "1.3", want: ReleaseLine{Major: 1, Minor: 3, HasMinor: true}},
+		{input: "1.3.x", want: ReleaseLine{Major: 1, Minor: 3, HasMinor: true}},
+		{input: "1.3.4", wantErr: true},
+		{input: "x", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseReleaseLine(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReleaseLine(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseReleaseLine(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseLineFromBranch(t *testing.T) {
+	tests := []struct {
+		branch  string
+		want    string
+		wantErr bool
+	}{
+		{branch: "release/1.x", want: "1.x"},
+		{branch: "release/1.3.x", want: "1.3.x"},
+		{branch: "maint/v2", want: "2.x"},
+		{branch: "hotfix-1.3", want: "1.3.x"},
+		{branch: "main", wantErr: true},
+		{branch: "release/next", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			got, err := ReleaseLineFromBranch(tt.branch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReleaseLineFromBranch(%q) error = %v, wantErr %v", tt.branch, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ReleaseLineFromBranch(%q) = %s, want %s", tt.branch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseLine_Contains(t *testing.T) {
+	major := ReleaseLine{Major: 1}
+	minor := ReleaseLine{Major: 1, Minor: 3, HasMinor: true}
+
+	tests := []struct {
+		version   string
+		wantMajor bool
+		wantMinor bool
+	}{
+		{"1.3.5", true, true},
+		{"1.3.0-rc.1", true, true},
+		{"1.4.0", true, false},
+		{"2.0.0", false, false},
+		{"0.3.1", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v := mustParse(t, tt.version)
+			if got := major.Contains(v); got != tt.wantMajor {
+				t.Errorf("1.x Contains(%s) = %v, want %v", tt.version, got, tt.wantMajor)
+			}
+			if got := minor.Contains(v); got != tt.wantMinor {
+				t.Errorf("1.3.x Contains(%s) = %v, want %v", tt.version, got, tt.wantMinor)
+			}
+		})
+	}
+}
+
+func TestReleaseLine_TagPattern(t *testing.T) {
+	if got := (ReleaseLine{Major: 1}).TagPattern("v"); got != "v1.*" {
+		t.Errorf("TagPattern() = %q, want %q", got, "v1.*")
+	}
+	if got := (ReleaseLine{Major: 1, Minor: 3, HasMinor: true}).TagPattern("api/v"); got != "api/v1.3.*" {
+		t.Errorf("TagPattern() = %q, want %q", got, "api/v1.3.*")
+	}
+}
+
+func TestLatestInLine(t *testing.T) {
+	tags := []string{"v2.1.0", "v1.3.4", "v1.3.10", "v1.4.0", "v1", "latest", "v1.3.11-rc.1"}
+
+	got, ok := LatestInLine(tags, "v", ReleaseLine{Major: 1, Minor: 3, HasMinor: true})
+	if !ok || got != "v1.3.11-rc.1" {
+		t.Errorf("LatestInLine(1.3.x) = %q, %v, want %q", got, ok, "v1.3.11-rc.1")
+	}
+
+	got, ok = LatestInLine(tags, "v", ReleaseLine{Major: 1})
+	if !ok || got != "v1.4.0" {
+		t.Errorf("LatestInLine(1.x) = %q, %v, want %q", got, ok, "v1.4.0")
+	}
+
+	if _, ok := LatestInLine(tags, "v", ReleaseLine{Major: 3}); ok {
+		t.Error("LatestInLine(3.x) expected no match")
+	}
+}
@@ -13,8 +13,9 @@ import (
 // VersionManager handles version file operations with injected dependencies.
 // This enables proper testing without global state mutation.
 type VersionManager struct {
-	fs  core.FileSystem
-	git GitTagReader
+	fs   core.FileSystem
+	git  GitTagReader
+	tags *TagSource
 }
 
 // GitTagReader abstracts git tag reading for testability.
@@ -28,17 +29,52 @@ func NewVersionManager(fs core.FileSystem, git GitTagReader) *VersionManager {
 	return &VersionManager{fs: fs, git: git}
 }
 
+// NewSourcedVersionManager creates a VersionManager on the given file system
+// that follows the package-level version source, so it reads git tags when
+// the default manager has a tag source.
+func NewSourcedVersionManager(fs core.FileSystem) *VersionManager {
+	return &VersionManager{fs: fs, tags: defaultManager.tags}
+}
+
 // DefaultVersionManager returns a VersionManager using real OS and git.
 func DefaultVersionManager() *VersionManager {
 	return NewVersionManager(core.NewOSFileSystem(), &realGitClient{})
 }
 
+// WithTagSource returns a copy of the manager that derives versions from git
+// tags. Read resolves the newest tag for the module's prefix, while Save and
+// Initialize become no-ops because the tag created on release is the record.
+func (m *VersionManager) WithTagSource(src *TagSource) *VersionManager {
+	return &VersionManager{fs: m.fs, git: m.git, tags: src}
+}
+
+// UsesGitTags reports whether versions are derived from git tags.
+func (m *VersionManager) UsesGitTags() bool {
+	return m.tags != nil
+}
+
+// CheckRecordable returns an error when Save cannot record version. With a
+// tag source Save is a no-op, so commands that do not create the release tag
+// themselves must refuse to run instead of reporting a change that never
+// happened.
+func (m *VersionManager) CheckRecordable(version SemVersion) error {
+	if m.tags == nil {
+		return nil
+	}
+	return fmt.Errorf("cannot record version %s: source is git-tags and this command does not create tags (use sley tag create)", version)
+}
+
 // Read reads a version from the given path.
+// When a tag source is configured, the path only identifies the module and
+// the version is resolved from its newest git tag.
 //
 // Returns an error if:
 //   - The file cannot be read (not found, permission denied, etc.)
 //   - The file content is not a valid semantic version
 func (m *VersionManager) Read(ctx context.Context, path string) (SemVersion, error) {
+	if m.tags != nil {
+		return m.tags.Latest(ctx, path)
+	}
 	data, err := m.fs.ReadFile(ctx, path)
 	if err != nil {
 		return SemVersion{}, err
@@ -46,13 +82,35 @@ func (m *VersionManager) Read(ctx context.Context, path string) (SemVersion, err
 	return ParseVersion(string(data))
 }
 
+// ReadStrict reads a version like Read but requires it to conform exactly to
+// the SemVer 2.0.0 grammar (see ParseStrict). Whitespace around the version
+// is file formatting and is ignored, as in Read.
+func (m *VersionManager) ReadStrict(ctx context.Context, path string) (SemVersion, error) {
+	if m.tags != nil {
+		v, err := m.tags.Latest(ctx, path)
+		if err != nil {
+			return SemVersion{}, err
+		}
+		return ParseStrict(v.String())
+	}
+	data, err := m.fs.ReadFile(ctx, path)
+	if err != nil {
+		return SemVersion{}, err
+	}
+	return ParseStrict(strings.TrimSpace(string(data)))
+}
+
 // Save writes a version to the given path.
 // Creates parent directories if they don't exist.
+// Save is a no-op when versions are derived from git tags.
 //
 // Returns an error if:
 //   - Parent directory cannot be created
 //   - File cannot be written (permission denied, disk full, etc.)
 func (m *VersionManager) Save(ctx context.Context, path string, version SemVersion) error {
+	if m.tags != nil {
+		return nil
+	}
 	// Ensure parent directory exists
 	if err := m.fs.MkdirAll(ctx, filepath.Dir(path), core.PermDirDefault); err != nil {
 		return err
@@ -63,6 +121,9 @@ func (m *VersionManager) Save(ctx context.Context, path string, version SemVersi
 // Initialize creates a version file if it doesn't exist.
 // It tries to use the latest git tag, or falls back to 0.0.0.
 func (m *VersionManager) Initialize(ctx context.Context, path string) error {
+	if m.tags != nil {
+		return nil // No version file when versions come from tags
+	}
 	if _, err := m.fs.Stat(ctx, path); err == nil {
 		return nil // Already exists
 	}
@@ -85,6 +146,9 @@ func (m *VersionManager) Initialize(ctx context.Context, path string) error {
 
 // InitializeWithFeedback initializes the version file and returns whether it was created.
 func (m *VersionManager) InitializeWithFeedback(ctx context.Context, path string) (created bool, err error) {
+	if m.tags != nil {
+		return false, nil
+	}
 	if _, err := m.fs.Stat(ctx, path); err == nil {
 		return false, nil
 	}
@@ -107,6 +171,7 @@ func (m *VersionManager) InitializeWithFeedback(ctx context.Context, path string
 // Returns an error if:
 //   - Version file cannot be read or parsed
 //   - bumpType is not one of: patch, minor, major
+//   - The bumped component would overflow
 //   - Version file cannot be saved
 func (m *VersionManager) Update(ctx context.Context, path string, bumpType string, pre string, meta string, preserve bool) error {
 	version, err := m.Read(ctx, path)
@@ -114,29 +179,30 @@ func (m *VersionManager) Update(ctx context.Context, path string, bumpType strin
 		return err
 	}
 
-	switch bumpType {
-	case "patch":
-		version.Patch++
-	case "minor":
-		version.Minor++
-		version.Patch = 0
-	case "major":
-		version.Major++
-		version.Minor = 0
-		version.Patch = 0
-	default:
-		return fmt.Errorf("invalid bump type: %s", bumpType)
+	next, err := BumpByLabel(version, bumpType)
+	if err != nil {
+		return err
 	}
 
-	version.PreRelease = pre
+	next.PreRelease = pre
 
 	if meta != "" {
-		version.Build = meta
-	} else if !preserve {
-		version.Build = ""
+		next.Build = meta
+	} else if preserve {
+		next.Build = version.Build
 	}
 
-	return m.Save(ctx, path, version)
+	return m.Save(ctx, path, next)
+}
+
+// Describe returns the version to display for the current commit. With a
+// tag source and describe enabled, untagged commits get a describe-style
+// version (e.g. 1.4.0-dev.5+g1a2b3c4); otherwise it is the same as Read.
+func (m *VersionManager) Describe(ctx context.Context, path string) (SemVersion, error) {
+	if m.tags != nil {
+		return m.tags.DescribeVersion(ctx, path)
+	}
+	return m.Read(ctx, path)
 }
 
 // UpdatePreRelease updates only the pre-release portion of the version.
@@ -162,7 +228,9 @@ func (m *VersionManager) UpdatePreRelease(ctx context.Context, path string, labe
 
 	if label != "" {
 		// Switch to new label or increment if same base label
-		version.PreRelease = IncrementPreRelease(version.PreRelease, label)
+		if version.PreRelease, err = IncrementPreRelease(version.PreRelease, label); err != nil {
+			return err
+		}
 	} else {
 		// Increment existing pre-release
 		if version.PreRelease == "" {
@@ -170,7 +238,9 @@ func (m *VersionManager) UpdatePreRelease(ctx context.Context, path string, labe
 		}
 		// Extract base label and increment
 		base := ExtractPreReleaseBase(version.PreRelease)
-		version.PreRelease = IncrementPreRelease(version.PreRelease, base)
+		if version.PreRelease, err = IncrementPreRelease(version.PreRelease, base); err != nil {
+			return err
+		}
 	}
 
 	if meta != "" {
@@ -257,3 +327,8 @@ func SetDefaultManager(m *VersionManager) func() {
 	defaultManager = m
 	return func() { defaultManager = old }
 }
+
+// CurrentManager returns the manager used by the package-level functions.
+func CurrentManager() *VersionManager {
+	return defaultManager
+}
@@ -3,11 +3,13 @@ package semver
 import (
 	"context"
 	"errors"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/indaco/sley/internal/apperrors"
 	"github.com/indaco/sley/internal/core"
 	"github.com/indaco/sley/internal/testutils"
 )
@@ -43,69 +45,39 @@ func TestSemVersion_String_WithBuildOnly(t *testing.T) {
 /* HELPER FUNCTIONS FOR COMPARISON                                           */
 /* ------------------------------------------------------------------------- */
 
-func Test_compareInt(t *testing.T) {
+func Test_isNumericIdentifier(t *testing.T) {
 
 	tests := []struct {
-		a, b int
-		want int
-	}{
-		{0, 0, 0},
-		{1, 1, 0},
-		{1, 2, -1},
-		{2, 1, 1},
-		{0, 1, -1},
-		{1, 0, 1},
-		{-1, 1, -1},
-		{1, -1, 1},
-		{100, 99, 1},
-		{99, 100, -1},
-	}
-
-	for _, tt := range tests {
-		got := compareInt(tt.a, tt.b)
-		if got != tt.want {
-			t.Errorf("compareInt(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
-		}
-	}
-}
-
-func Test_parseNumericIdentifier(t *testing.T) {
-
-	tests := []struct {
-		input   string
-		wantNum int
-		wantOk  bool
+		input  string
+		wantOk bool
 	}{
 		// Valid numeric identifiers
-		{"0", 0, true},
-		{"1", 1, true},
-		{"10", 10, true},
-		{"123", 123, true},
+		{"0", true},
+		{"1", true},
+		{"10", true},
+		{"123", true},
+		{"99999999999999999999", true},
 
 		// Invalid: empty string
-		{"", 0, false},
+		{"", false},
 
 		// Invalid: leading zeros (per semver spec)
-		{"00", 0, false},
-		{"01", 0, false},
-		{"007", 0, false},
+		{"00", false},
+		{"01", false},
+		{"007", false},
 
 		// Invalid: non-numeric
-		{"a", 0, false},
-		{"1a", 0, false},
-		{"a1", 0, false},
-		{"alpha", 0, false},
-		{"1.0", 0, false},
-		{"-1", 0, false},
+		{"a", false},
+		{"1a", false},
+		{"a1", false},
+		{"alpha", false},
+		{"1.0", false},
+		{"-1", false},
 	}
 
 	for _, tt := range tests {
-		num, ok := parseNumericIdentifier(tt.input)
-		if ok != tt.wantOk {
-			t.Errorf("parseNumericIdentifier(%q) ok = %v, want %v", tt.input, ok, tt.wantOk)
-		}
-		if ok && num != tt.wantNum {
-			t.Errorf("parseNumericIdentifier(%q) = %d, want %d", tt.input, num, tt.wantNum)
+		if ok := isNumericIdentifier(tt.input); ok != tt.wantOk {
+			t.Errorf("isNumericIdentifier(%q) = %v, want %v", tt.input, ok, tt.wantOk)
 		}
 	}
 }
@@ -124,6 +96,8 @@ func Test_compareIdentifier(t *testing.T) {
 		{"10", "2", 1},  // numeric: 10 > 2
 		{"10", "10", 0},
 		{"99", "100", -1},
+		{"99999999999999999999", "100000000000000000000", -1}, // beyond uint64
+		{"100000000000000000000", "18446744073709551615", 1},
 
 		// Numeric vs alphanumeric: numeric has lower precedence
 		{"1", "alpha", -1},
@@ -138,6 +112,7 @@ func Test_compareIdentifier(t *testing.T) {
 		{"rc", "rc", 0},
 		{"a", "b", -1},
 		{"z", "a", 1},
+		{"01", "1", 1}, // leading zero makes it alphanumeric
 	}
 
 	for _, tt := range tests {
@@ -545,6 +520,8 @@ func TestParseVersion_NumberConversionErrors(t *testing.T) {
 		{"a.2.3", "invalid major version"},
 		{"1.b.3", "invalid minor version"},
 		{"1.2.c", "invalid patch version"},
+		{"18446744073709551616.0.0", "invalid major version"},
+		{"1.0.99999999999999999999", "invalid patch version"},
 	}
 
 	for _, tt := range tests {
@@ -699,7 +676,10 @@ func TestIncrementPreRelease(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		got := IncrementPreRelease(c.current, c.base)
+		got, err := IncrementPreRelease(c.current, c.base)
+		if err != nil {
+			t.Errorf("IncrementPreRelease(%q, %q) unexpected error: %v", c.current, c.base, err)
+		}
 		if got != c.want {
 			t.Errorf("incrementPreRelease(%q, %q) = %q, want %q", c.current, c.base, got, c.want)
 		}
@@ -929,6 +909,28 @@ func TestBumpNext(t *testing.T) {
 /* BUMP BY LABEL                                                             */
 /* ------------------------------------------------------------------------- */
 
+func TestBumpOverflowErrors(t *testing.T) {
+	maxed := SemVersion{Major: 1, Minor: 2, Patch: math.MaxUint64}
+
+	_, errLabel := BumpByLabel(maxed, "patch")
+	_, errNext := BumpNext(maxed)
+	_, errPre := IncrementPreRelease("rc.18446744073709551615", "rc")
+	_, errPreHuge := IncrementPreRelease("rc.99999999999999999999", "rc")
+
+	for name, err := range map[string]error{"BumpByLabel": errLabel, "BumpNext": errNext, "IncrementPreRelease": errPre, "IncrementPreRelease (beyond uint64)": errPreHuge} {
+		var overflow *apperrors.VersionOverflowError
+		if !errors.As(err, &overflow) {
+			t.Errorf("%s: expected *apperrors.VersionOverflowError, got %v", name, err)
+		}
+	}
+
+	// Promoting a pre-release does not touch the maxed component.
+	promoted, err := BumpNext(SemVersion{Major: 1, Minor: 2, Patch: math.MaxUint64, PreRelease: "rc.1"})
+	if err != nil || promoted.Patch != math.MaxUint64 {
+		t.Errorf("BumpNext promotion = %v, %v", promoted, err)
+	}
+}
+
 func TestBumpByLabel(t *testing.T) {
 
 	tests := []struct {
@@ -942,6 +944,10 @@ func TestBumpByLabel(t *testing.T) {
 		{"minor bump", SemVersion{1, 2, 3, "", ""}, "minor", "1.3.0", false},
 		{"major bump", SemVersion{1, 2, 3, "", ""}, "major", "2.0.0", false},
 		{"invalid label", SemVersion{1, 2, 3, "", ""}, "foobar", "", true},
+		{"large patch", SemVersion{1, 2, math.MaxUint64 - 1, "", ""}, "patch", "1.2.18446744073709551615", false},
+		{"patch overflow", SemVersion{1, 2, math.MaxUint64, "", ""}, "patch", "", true},
+		{"minor overflow", SemVersion{1, math.MaxUint64, 3, "", ""}, "minor", "", true},
+		{"major overflow", SemVersion{math.MaxUint64, 2, 3, "", ""}, "major", "", true},
 	}
 
 	for _, tt := range tests {
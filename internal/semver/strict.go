@@ -0,0 +1,254 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError reports where a version string departs from the SemVer 2.0.0
+// grammar. Pos is the zero-based byte offset of the offending character.
+type SyntaxError struct {
+	Input string
+	Pos   int
+	Msg   string
+}
+
+// Error returns the message with a one-based column, e.g.
+// `invalid version format: "01.2.3" at column 1: leading zero in major version`.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s: %q at column %d: %s", errInvalidVersion, e.Input, e.Pos+1, e.Msg)
+}
+
+// Unwrap lets errors.Is match syntax errors against errInvalidVersion.
+func (e *SyntaxError) Unwrap() error {
+	return errInvalidVersion
+}
+
+// ParseStrict parses a version that conforms exactly to the SemVer 2.0.0
+// grammar. Unlike ParseVersion it rejects a "v" prefix, surrounding
+// whitespace, leading zeros in major, minor, patch and numeric pre-release
+// identifiers, and empty identifiers such as "1.0.0-rc..1".
+//
+// Errors are *SyntaxError values pointing at the first offending character.
+func ParseStrict(s string) (SemVersion, error) {
+	if len(s) > maxVersionLength {
+		return SemVersion{}, &SyntaxError{Input: s, Pos: maxVersionLength, Msg: fmt.Sprintf("version string exceeds maximum length of %d", maxVersionLength)}
+	}
+
+	p := strictParser{input: s}
+	var v SemVersion
+	var err error
+	if v.Major, err = p.number("major"); err != nil {
+		return SemVersion{}, err
+	}
+	if err = p.dot("minor"); err != nil {
+		return SemVersion{}, err
+	}
+	if v.Minor, err = p.number("minor"); err != nil {
+		return SemVersion{}, err
+	}
+	if err = p.dot("patch"); err != nil {
+		return SemVersion{}, err
+	}
+	if v.Patch, err = p.number("patch"); err != nil {
+		return SemVersion{}, err
+	}
+
+	if p.accept('-') {
+		if v.PreRelease, err = p.identifiers("pre-release", true); err != nil {
+			return SemVersion{}, err
+		}
+	}
+	if p.accept('+') {
+		if v.Build, err = p.identifiers("build metadata", false); err != nil {
+			return SemVersion{}, err
+		}
+	}
+	if p.pos < len(s) {
+		return SemVersion{}, p.errorf(p.pos, "unexpected character %q", s[p.pos])
+	}
+	return v, nil
+}
+
+// strictParser scans a version string for ParseStrict.
+type strictParser struct {
+	input string
+	pos   int
+}
+
+// errorf returns a syntax error at the given offset.
+func (p *strictParser) errorf(pos int, format string, args ...any) error {
+	return &SyntaxError{Input: p.input, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// accept consumes c if it is the next character.
+func (p *strictParser) accept(c byte) bool {
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// dot consumes the "." that precedes the named component.
+func (p *strictParser) dot(next string) error {
+	switch {
+	case p.pos >= len(p.input):
+		return p.errorf(p.pos, "missing %s version", next)
+	case !p.accept('.'):
+		return p.errorf(p.pos, "expected '.' before %s version, found %q", next, p.input[p.pos])
+	}
+	return nil
+}
+
+// number consumes a numeric identifier for the named core component.
+func (p *strictParser) number(component string) (uint64, error) {
+	start := p.pos
+	for p.pos < len(p.input) && isDigit(p.input[p.pos]) {
+		p.pos++
+	}
+
+	digits := p.input[start:p.pos]
+	switch {
+	case digits == "" && start >= len(p.input):
+		return 0, p.errorf(start, "missing %s version", component)
+	case digits == "" && start == 0 && (p.input[0] == 'v' || p.input[0] == 'V'):
+		return 0, p.errorf(start, "%q prefix is not allowed", p.input[0])
+	case digits == "":
+		return 0, p.errorf(start, "expected digit in %s version, found %q", component, p.input[start])
+	case len(digits) > 1 && digits[0] == '0':
+		return 0, p.errorf(start, "leading zero in %s version", component)
+	}
+
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, p.errorf(start, "%s version %s is out of range", component, digits)
+	}
+	return n, nil
+}
+
+// identifiers consumes dot-separated identifiers for a pre-release or build
+// metadata section. Numeric pre-release identifiers must not have leading
+// zeros; build identifiers may.
+func (p *strictParser) identifiers(section string, numeric bool) (string, error) {
+	begin := p.pos
+	for {
+		start := p.pos
+		for p.pos < len(p.input) && isIdentifierChar(p.input[p.pos]) {
+			p.pos++
+		}
+
+		id := p.input[start:p.pos]
+		if id == "" {
+			if p.pos < len(p.input) && p.input[p.pos] != '.' && p.input[p.pos] != '+' {
+				return "", p.errorf(p.pos, "invalid character %q in %s", p.input[p.pos], section)
+			}
+			return "", p.errorf(start, "empty %s identifier", section)
+		}
+		if numeric && len(id) > 1 && id[0] == '0' && isAllDigits(id) {
+			return "", p.errorf(start, "leading zero in numeric %s identifier %q", section, id)
+		}
+
+		if p.accept('.') {
+			continue
+		}
+		if p.pos < len(p.input) && (!numeric || p.input[p.pos] != '+') {
+			return "", p.errorf(p.pos, "invalid character %q in %s", p.input[p.pos], section)
+		}
+		return p.input[begin:p.pos], nil
+	}
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isIdentifierChar reports whether c may appear in a pre-release or build
+// identifier: [0-9A-Za-z-].
+func isIdentifierChar(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-'
+}
+
+// ParseLenient parses a version copied from another ecosystem's manifest and
+// normalises it to SemVer, for imports such as "init --migrate" and "sync".
+//
+// Normalisation rules:
+//   - Surrounding whitespace and a "v" or "V" prefix are removed.
+//   - Missing minor and patch components become zero ("v1.2" -> "1.2.0").
+//   - Leading zeros are dropped from numeric components and numeric
+//     pre-release identifiers ("01.2.3-rc.01" -> "1.2.3-rc.1").
+//   - Empty pre-release and build identifiers are removed ("1.0.0-rc..1" -> "1.0.0-rc.1").
+//   - A fourth component, such as a .NET revision or a Python micro release,
+//     becomes build metadata so it is kept without affecting precedence
+//     ("1.2.3.4" -> "1.2.3+4"); a zero fourth component is dropped.
+//
+// The result is always accepted by ParseStrict.
+func ParseLenient(s string) (SemVersion, error) {
+	trimmed := strings.TrimSpace(s)
+	if len(trimmed) > maxVersionLength {
+		return SemVersion{}, fmt.Errorf("%w: version string exceeds maximum length of %d", errInvalidVersion, maxVersionLength)
+	}
+	trimmed = strings.TrimPrefix(strings.TrimPrefix(trimmed, "v"), "V")
+
+	core, pre, build := trimmed, "", ""
+	if i := strings.IndexByte(core, '+'); i >= 0 {
+		core, build = core[:i], core[i+1:]
+	}
+	if i := strings.IndexByte(core, '-'); i >= 0 {
+		core, pre = core[:i], core[i+1:]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 4 {
+		return SemVersion{}, fmt.Errorf("%w: cannot normalise %q: too many version components", errInvalidVersion, s)
+	}
+	var nums [4]uint64
+	for i, part := range parts {
+		if part == "" || !isAllDigits(part) {
+			return SemVersion{}, fmt.Errorf("%w: cannot normalise %q: %q is not a number", errInvalidVersion, s, part)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return SemVersion{}, fmt.Errorf("%w: cannot normalise %q: %s is out of range", errInvalidVersion, s, part)
+		}
+		nums[i] = n
+	}
+
+	v := SemVersion{
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
+		PreRelease: normaliseIdentifiers(pre, true),
+		Build:      normaliseIdentifiers(build, false),
+	}
+	if nums[3] != 0 {
+		v.Build = strings.TrimSuffix(strconv.FormatUint(nums[3], 10)+"."+v.Build, ".")
+	}
+
+	if _, err := ParseStrict(v.String()); err != nil {
+		return SemVersion{}, fmt.Errorf("%w: cannot normalise %q: %w", errInvalidVersion, s, err)
+	}
+	return v, nil
+}
+
+// normaliseIdentifiers drops empty identifiers and, when numeric is set,
+// leading zeros of numeric identifiers.
+func normaliseIdentifiers(s string, numeric bool) string {
+	var ids []string
+	for id := range strings.SplitSeq(s, ".") {
+		if id == "" {
+			continue
+		}
+		if numeric && isAllDigits(id) {
+			if trimmed := strings.TrimLeft(id, "0"); trimmed != "" {
+				id = trimmed
+			} else {
+				id = "0"
+			}
+		}
+		ids = append(ids, id)
+	}
+	return strings.Join(ids, ".")
+}
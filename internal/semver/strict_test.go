@@ -0,0 +1,191 @@
+package semver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+func TestParseStrict_Valid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  SemVersion
+	}{
+		{"0.0.0", SemVersion{}},
+		{"1.2.3", SemVersion{Major: 1, Minor: 2, Patch: 3}},
+		{"10.20.30", SemVersion{Major: 10, Minor: 20, Patch: 30}},
+		{"1.0.0-alpha", SemVersion{Major: 1, PreRelease: "alpha"}},
+		{"1.0.0-0.3.7", SemVersion{Major: 1, PreRelease: "0.3.7"}},
+		{"1.0.0-x.7.z.92", SemVersion{Major: 1, PreRelease: "x.7.z.92"}},
+		{"1.0.0-x-y-z.--", SemVersion{Major: 1, PreRelease: "x-y-z.--"}},
+		{"1.0.0-0a.01b", SemVersion{Major: 1, PreRelease: "0a.01b"}},
+		{"1.0.0+20130313144700", SemVersion{Major: 1, Build: "20130313144700"}},
+		{"1.0.0-beta+exp.sha.5114f85", SemVersion{Major: 1, PreRelease: "beta", Build: "exp.sha.5114f85"}},
+		{"1.0.0+0.build.1-rc.10000aaa-kk-0.1", SemVersion{Major: 1, Build: "0.build.1-rc.10000aaa-kk-0.1"}},
+		{"1.0.0+001", SemVersion{Major: 1, Build: "001"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseStrict(tt.input)
+			if err != nil {
+				t.Fatalf("ParseStrict(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseStrict(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStrict_Invalid(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{"", 0, "missing major version"},
+		{"v1.2.3", 0, `'v' prefix is not allowed`},
+		{" 1.2.3", 0, "expected digit in major version"},
+		{"01.2.3", 0, "leading zero in major version"},
+		{"1.02.3", 2, "leading zero in minor version"},
+		{"1.2.03", 4, "leading zero in patch version"},
+		{"1.2", 3, "missing patch version"},
+		{"1.2.", 4, "missing patch version"},
+		{"1.2.3.4", 5, "unexpected character '.'"},
+		{"1.2-3", 3, "expected '.' before patch version"},
+		{"1.2.3\n", 5, `unexpected character '\n'`},
+		{"1.2.3-", 6, "empty pre-release identifier"},
+		{"1.2.3-rc..1", 9, "empty pre-release identifier"},
+		{"1.2.3-rc.", 9, "empty pre-release identifier"},
+		{"1.2.3-rc.01", 9, `leading zero in numeric pre-release identifier "01"`},
+		{"1.2.3-00", 6, `leading zero in numeric pre-release identifier "00"`},
+		{"1.2.3-rc_1", 8, "invalid character '_' in pre-release"},
+		{"1.2.3+", 6, "empty build metadata identifier"},
+		{"1.2.3+a..b", 8, "empty build metadata identifier"},
+		{"1.2.3+a+b", 7, "invalid character '+' in build metadata"},
+		{"1.2.3-rc+", 9, "empty build metadata identifier"},
+		{"99999999999999999999.0.0", 0, "major version 99999999999999999999 is out of range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseStrict(tt.input)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseStrict(%q) error = %v, want *SyntaxError", tt.input, err)
+			}
+			if !errors.Is(err, errInvalidVersion) {
+				t.Errorf("expected error to wrap errInvalidVersion")
+			}
+			if syntaxErr.Pos != tt.pos {
+				t.Errorf("Pos = %d, want %d (%v)", syntaxErr.Pos, tt.pos, err)
+			}
+			if !strings.Contains(syntaxErr.Msg, tt.msg) {
+				t.Errorf("Msg = %q, want it to contain %q", syntaxErr.Msg, tt.msg)
+			}
+		})
+	}
+}
+
+func TestParseStrict_TooLong(t *testing.T) {
+	_, err := ParseStrict("1.0.0-" + strings.Repeat("a", maxVersionLength))
+	if err == nil || !strings.Contains(err.Error(), "maximum length") {
+		t.Errorf("expected maximum length error, got %v", err)
+	}
+}
+
+func TestSyntaxError_Error(t *testing.T) {
+	_, err := ParseStrict("1.02.3")
+	want := `invalid version format: "1.02.3" at column 3: leading zero in minor version`
+	if err == nil || err.Error() != want {
+		t.Errorf("Error() = %v, want %q", err, want)
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"v1.2", "1.2.0"},
+		{"V1.2.3", "1.2.3"},
+		{"1.2", "1.2.0"},
+		{"1", "1.0.0"},
+		{"  1.2.3\n", "1.2.3"},
+		{"1.2.3.4", "1.2.3+4"},
+		{"1.2.3.0", "1.2.3"},
+		{"1.2.3.4+build", "1.2.3+4.build"},
+		{"01.02.03", "1.2.3"},
+		{"1.2.3-rc.01", "1.2.3-rc.1"},
+		{"1.2.3-rc..1", "1.2.3-rc.1"},
+		{"1.2.3-00", "1.2.3-0"},
+		{"1.2-beta", "1.2.0-beta"},
+		{"1.0.0+a..b", "1.0.0+a.b"},
+		{"1.2.3-", "1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseLenient(tt.input)
+			if err != nil {
+				t.Fatalf("ParseLenient(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseLenient(%q) = %q, want %q", tt.input, got.String(), tt.want)
+			}
+			if _, err := ParseStrict(got.String()); err != nil {
+				t.Errorf("normalised version %q is not strict: %v", got.String(), err)
+			}
+		})
+	}
+}
+
+func TestParseLenient_Invalid(t *testing.T) {
+	for _, input := range []string{"", "v", "1.2.3.4.5", "1..2", "1.2.3rc1", "abc", "1.2.3-rc_1"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseLenient(input); !errors.Is(err, errInvalidVersion) {
+				t.Errorf("ParseLenient(%q) error = %v, want errInvalidVersion", input, err)
+			}
+		})
+	}
+}
+
+func TestVersionManager_ReadStrict(t *testing.T) {
+	tests := []struct {
+		content string
+		wantErr bool
+	}{
+		{"1.2.3\n", false},
+		{"1.2.3\r\n", false},
+		{"1.2.3", false},
+		{"v1.2.3\n", true},
+		{"  1.2.3  \n", false},
+		{"01.2.3\n", true},
+		{"1.2.3-rc.01\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.TrimSpace(tt.content), func(t *testing.T) {
+			fs := core.NewMockFileSystem()
+			fs.SetFile("/test/.version", []byte(tt.content))
+			_, err := NewVersionManager(fs, nil).ReadStrict(context.Background(), "/test/.version")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadStrict(%q) error = %v, wantErr %v", tt.content, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersionManager_ReadStrict_GitTags(t *testing.T) {
+	mgr := NewVersionManager(core.NewMockFileSystem(), nil).WithTagSource(
+		NewTagSource("v", &MockVersionTagLister{Tags: []string{"v1.2.3-rc..1"}}),
+	)
+	if _, err := mgr.ReadStrict(context.Background(), ".version"); err == nil {
+		t.Error("expected strict error for a non-conforming tag")
+	}
+}
@@ -0,0 +1,249 @@
+package semver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// VersionTagLister abstracts the git commands used to derive versions from tags.
+type VersionTagLister interface {
+	// ListTags returns the tag names matching a glob pattern.
+	ListTags(ctx context.Context, pattern string) ([]string, error)
+	// DescribeLong returns "git describe --tags --long" output for HEAD,
+	// restricted to tags matching the pattern (e.g. "v1.3.0-5-g1a2b3c4").
+	DescribeLong(ctx context.Context, pattern string) (string, error)
+}
+
+// TagSource derives the current version from git tags instead of a version file.
+// The version of a module is its newest semver tag with the module's prefix.
+type TagSource struct {
+	// Prefix is the tag prefix. It may contain {module_path}, which is
+	// resolved from the directory of the version path being read.
+	Prefix string
+
+	// Describe enables describe-style versions for untagged commits.
+	Describe bool
+
+	// DescribeLabel is the pre-release label of describe-style versions (default "dev").
+	DescribeLabel string
+
+	// DescribeBump is the bump applied to the nearest tag for describe-style
+	// versions: "patch" (default), "minor" or "major".
+	DescribeBump string
+
+	// Line restricts the tags considered to a maintenance release line
+	// (e.g. 1.3.x on a release/1.3.x branch). Nil considers every tag.
+	Line *ReleaseLine
+
+	git VersionTagLister
+}
+
+// NewTagSource creates a TagSource that reads tags with the given lister.
+func NewTagSource(prefix string, git VersionTagLister) *TagSource {
+	return &TagSource{Prefix: prefix, git: git}
+}
+
+// DefaultTagSource creates a TagSource using real git commands.
+func DefaultTagSource(prefix string) *TagSource {
+	return NewTagSource(prefix, &realTagLister{})
+}
+
+// prefixFor resolves the tag prefix for the module owning the version path.
+// For the root module a prefix like "{module_path}/v" becomes "v".
+func (s *TagSource) prefixFor(path string) string {
+	modulePath := filepath.Dir(path)
+	if filepath.IsAbs(modulePath) {
+		if cwd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(cwd, modulePath); err == nil {
+				modulePath = rel
+			}
+		}
+	}
+	if modulePath == "." {
+		modulePath = ""
+	}
+	prefix := strings.ReplaceAll(s.Prefix, "{module_path}", filepath.ToSlash(modulePath))
+	return strings.TrimPrefix(prefix, "/")
+}
+
+// tagPattern returns the tag glob for a prefix, narrowed to the release line when set.
+func (s *TagSource) tagPattern(prefix string) string {
+	if s.Line != nil {
+		return s.Line.TagPattern(prefix)
+	}
+	return prefix + "*"
+}
+
+// Latest returns the newest semver tag of the module owning the version path.
+// Tags that are not semver versions (e.g. moving alias tags) and, with Line
+// set, tags outside the release line are ignored.
+// Returns 0.0.0 when the module has no version tags yet, or an error when
+// the release line has none.
+func (s *TagSource) Latest(ctx context.Context, path string) (SemVersion, error) {
+	prefix := s.prefixFor(path)
+	tags, err := s.git.ListTags(ctx, s.tagPattern(prefix))
+	if err != nil {
+		return SemVersion{}, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	if s.Line != nil {
+		tag, ok := LatestInLine(tags, prefix, *s.Line)
+		if !ok {
+			return SemVersion{}, fmt.Errorf("no version tags found in release line %s", s.Line)
+		}
+		return ParseVersion(strings.TrimPrefix(tag, prefix))
+	}
+
+	var latest SemVersion
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, prefix) {
+			continue
+		}
+		version, err := ParseVersion(strings.TrimPrefix(tag, prefix))
+		if err != nil {
+			continue
+		}
+		if version.Compare(latest) > 0 {
+			latest = version
+		}
+	}
+	return latest, nil
+}
+
+// DescribeVersion returns a describe-style version for the current commit.
+// On a tagged commit it is the tag's version. Otherwise the nearest tag is
+// bumped and marked with the commit distance and hash, e.g. 1.4.0-dev.5+g1a2b3c4
+// for five commits after v1.3.0 with a minor describe bump. A pre-release
+// tag keeps its pre-release, so five commits after v1.4.0-rc.1 give
+// 1.4.0-rc.1.dev.5+g1a2b3c4, which sorts between rc.1 and rc.2.
+// Falls back to Latest when describe is disabled or no tag is reachable.
+func (s *TagSource) DescribeVersion(ctx context.Context, path string) (SemVersion, error) {
+	if !s.Describe {
+		return s.Latest(ctx, path)
+	}
+
+	prefix := s.prefixFor(path)
+	out, err := s.git.DescribeLong(ctx, s.describePattern(prefix))
+	if err != nil {
+		return s.Latest(ctx, path)
+	}
+
+	base, distance, hash, err := parseDescribe(strings.TrimSpace(out), prefix)
+	if err != nil {
+		return SemVersion{}, err
+	}
+	if distance == 0 {
+		return base, nil
+	}
+
+	label := s.DescribeLabel
+	if label == "" {
+		label = "dev"
+	}
+	label += "." + strconv.Itoa(distance)
+
+	next := SemVersion{Major: base.Major, Minor: base.Minor, Patch: base.Patch}
+	if base.PreRelease != "" {
+		next.PreRelease = base.PreRelease + "." + label
+	} else {
+		bump := s.DescribeBump
+		if bump != "major" && bump != "minor" {
+			bump = "patch"
+		}
+		if next, err = BumpByLabel(base, bump); err != nil {
+			return SemVersion{}, err
+		}
+		next.PreRelease = label
+	}
+	next.Build = hash
+	return next, nil
+}
+
+// describePattern returns the tag glob for git describe. It requires a full
+// major.minor.patch version so moving alias tags such as "v1" or "v1.4",
+// which may sit on a closer commit, are never picked as the nearest tag.
+func (s *TagSource) describePattern(prefix string) string {
+	switch {
+	case s.Line == nil:
+		return prefix + "*.*.*"
+	case s.Line.HasMinor:
+		return s.Line.TagPattern(prefix)
+	default:
+		return fmt.Sprintf("%s%d.*.*", prefix, s.Line.Major)
+	}
+}
+
+// parseDescribe splits "git describe --long" output ("<tag>-<distance>-g<hash>")
+// into the tag's version, the commit distance and the "g"-prefixed hash.
+func parseDescribe(out, prefix string) (SemVersion, int, string, error) {
+	hashIdx := strings.LastIndex(out, "-")
+	if hashIdx < 0 {
+		return SemVersion{}, 0, "", fmt.Errorf("unexpected describe output %q", out)
+	}
+	hash := out[hashIdx+1:]
+	distIdx := strings.LastIndex(out[:hashIdx], "-")
+	if distIdx < 0 {
+		return SemVersion{}, 0, "", fmt.Errorf("unexpected describe output %q", out)
+	}
+	distance, err := strconv.Atoi(out[distIdx+1 : hashIdx])
+	if err != nil {
+		return SemVersion{}, 0, "", fmt.Errorf("unexpected describe output %q", out)
+	}
+
+	version, err := ParseVersion(strings.TrimPrefix(out[:distIdx], prefix))
+	if err != nil {
+		return SemVersion{}, 0, "", fmt.Errorf("describe tag %q is not a version: %w", out[:distIdx], err)
+	}
+	return version, distance, hash, nil
+}
+
+// realTagLister implements VersionTagLister using actual git commands.
+type realTagLister struct{}
+
+func (g *realTagLister) ListTags(ctx context.Context, pattern string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "tag", "--list", pattern)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(output)), nil
+}
+
+func (g *realTagLister) DescribeLong(ctx context.Context, pattern string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "describe", "--tags", "--long", "--match", pattern)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// MockVersionTagLister is a test helper for mocking tag-sourced versions.
+type MockVersionTagLister struct {
+	Tags        []string
+	ListErr     error
+	Describe    string
+	DescribeErr error
+
+	// DescribePattern records the pattern of the last DescribeLong call.
+	DescribePattern string
+}
+
+func (m *MockVersionTagLister) ListTags(ctx context.Context, pattern string) ([]string, error) {
+	return m.Tags, m.ListErr
+}
+
+func (m *MockVersionTagLister) DescribeLong(ctx context.Context, pattern string) (string, error) {
+	m.DescribePattern = pattern
+	return m.Describe, m.DescribeErr
+}
+
+var (
+	_ VersionTagLister = (*realTagLister)(nil)
+	_ VersionTagLister = (*MockVersionTagLister)(nil)
+)
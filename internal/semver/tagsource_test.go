@@ -0,0 +1,207 @@
+package semver
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+func TestTagSource_Latest(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		path   string
+		tags   []string
+		want   string
+	}{
+		{name: "newest version", prefix: "v", path: ".version", tags: []string{"v1.2.0", "v1.10.0", "v1.9.3"}, want: "1.10.0"},
+		{name: "pre-release is newest", prefix: "v", path: ".version", tags: []string{"v1.2.0", "v1.3.0-rc.1"}, want: "1.3.0-rc.1"},
+		{name: "ignores aliases and other tags", prefix: "v", path: ".version", tags: []string{"v1", "v1.4", "latest", "v1.4.2"}, want: "1.4.2"},
+		{name: "no tags", prefix: "v", path: ".version", want: "0.0.0"},
+		{name: "module prefix", prefix: "{module_path}/v", path: "services/api/.version", tags: []string{"services/api/v2.0.1", "v9.0.0"}, want: "2.0.1"},
+		{name: "root module prefix", prefix: "{module_path}/v", path: ".version", tags: []string{"v0.3.0"}, want: "0.3.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := NewTagSource(tt.prefix, &MockVersionTagLister{Tags: tt.tags})
+			got, err := src.Latest(context.Background(), tt.path)
+			if err != nil {
+				t.Fatalf("Latest() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Latest() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagSource_Latest_ReleaseLine(t *testing.T) {
+	tags := []string{"v2.1.0", "v1.3.4", "v1.3.5", "v1.4.0"}
+	src := NewTagSource("v", &MockVersionTagLister{Tags: tags})
+	src.Line = &ReleaseLine{Major: 1, Minor: 3, HasMinor: true}
+
+	got, err := src.Latest(context.Background(), ".version")
+	if err != nil {
+		t.Fatalf("Latest() error = %v", err)
+	}
+	if got.String() != "1.3.5" {
+		t.Errorf("Latest() = %s, want 1.3.5", got)
+	}
+
+	src.Line = &ReleaseLine{Major: 3}
+	if _, err := src.Latest(context.Background(), ".version"); err == nil {
+		t.Fatal("expected error for a release line without tags")
+	}
+}
+
+func TestTagSource_Latest_ListError(t *testing.T) {
+	src := NewTagSource("v", &MockVersionTagLister{ListErr: errors.New("not a git repository")})
+	if _, err := src.Latest(context.Background(), ".version"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTagSource_DescribeVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		describe bool
+		bump     string
+		label    string
+		output   string
+		want     string
+	}{
+		{name: "disabled uses latest tag", output: "v1.3.0-5-g1a2b3c4", want: "1.3.0"},
+		{name: "tagged commit", describe: true, output: "v1.3.0-0-g1a2b3c4\n", want: "1.3.0"},
+		{name: "patch by default", describe: true, output: "v1.3.0-5-g1a2b3c4", want: "1.3.1-dev.5+g1a2b3c4"},
+		{name: "minor bump", describe: true, bump: "minor", output: "v1.3.2-5-g1a2b3c", want: "1.4.0-dev.5+g1a2b3c"},
+		{name: "major bump and label", describe: true, bump: "major", label: "snapshot", output: "v1.3.2-12-gabc", want: "2.0.0-snapshot.12+gabc"},
+		{name: "pre-release tag keeps pre-release", describe: true, bump: "minor", output: "v1.4.0-rc.1-3-gabc", want: "1.4.0-rc.1.dev.3+gabc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := NewTagSource("v", &MockVersionTagLister{Tags: []string{"v1.3.0"}, Describe: tt.output})
+			src.Describe = tt.describe
+			src.DescribeBump = tt.bump
+			src.DescribeLabel = tt.label
+
+			got, err := src.DescribeVersion(context.Background(), ".version")
+			if err != nil {
+				t.Fatalf("DescribeVersion() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("DescribeVersion() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagSource_DescribeVersion_PreReleaseMonotonic(t *testing.T) {
+	src := NewTagSource("v", &MockVersionTagLister{Describe: "v1.4.0-rc.1-3-gabc"})
+	src.Describe = true
+
+	got, err := src.DescribeVersion(context.Background(), ".version")
+	if err != nil {
+		t.Fatalf("DescribeVersion() error = %v", err)
+	}
+	rc1 := SemVersion{Major: 1, Minor: 4, PreRelease: "rc.1"}
+	rc2 := SemVersion{Major: 1, Minor: 4, PreRelease: "rc.2"}
+	if got.Compare(rc1) <= 0 || got.Compare(rc2) >= 0 {
+		t.Errorf("DescribeVersion() = %s, want between %s and %s", got, rc1, rc2)
+	}
+}
+
+func TestTagSource_DescribeVersion_ExcludesAliasTags(t *testing.T) {
+	tests := []struct {
+		name string
+		line *ReleaseLine
+		want string
+	}{
+		{"all tags", nil, "v*.*.*"},
+		{"major line", &ReleaseLine{Major: 1}, "v1.*.*"},
+		{"minor line", &ReleaseLine{Major: 1, Minor: 3, HasMinor: true}, "v1.3.*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lister := &MockVersionTagLister{Describe: "v1.3.0-2-gabc"}
+			src := NewTagSource("v", lister)
+			src.Describe = true
+			src.Line = tt.line
+
+			if _, err := src.DescribeVersion(context.Background(), ".version"); err != nil {
+				t.Fatalf("DescribeVersion() error = %v", err)
+			}
+			if lister.DescribePattern != tt.want {
+				t.Errorf("describe pattern = %q, want %q", lister.DescribePattern, tt.want)
+			}
+			for _, alias := range []string{"v1", "v1.3"} {
+				if ok, _ := path.Match(lister.DescribePattern, alias); ok {
+					t.Errorf("describe pattern %q matches alias tag %q", lister.DescribePattern, alias)
+				}
+			}
+		})
+	}
+}
+
+func TestTagSource_DescribeVersion_NoReachableTag(t *testing.T) {
+	src := NewTagSource("v", &MockVersionTagLister{DescribeErr: errors.New("no names found")})
+	src.Describe = true
+
+	got, err := src.DescribeVersion(context.Background(), ".version")
+	if err != nil {
+		t.Fatalf("DescribeVersion() error = %v", err)
+	}
+	if got.String() != "0.0.0" {
+		t.Errorf("DescribeVersion() = %s, want 0.0.0", got)
+	}
+}
+
+func TestTagSource_DescribeVersion_InvalidOutput(t *testing.T) {
+	for _, output := range []string{"garbage", "v1.3.0-x-gabc", "release-5-gabc"} {
+		src := NewTagSource("v", &MockVersionTagLister{Describe: output})
+		src.Describe = true
+		if _, err := src.DescribeVersion(context.Background(), ".version"); err == nil {
+			t.Errorf("expected error for %q", output)
+		}
+	}
+}
+
+func TestVersionManager_WithTagSource(t *testing.T) {
+	ctx := context.Background()
+	mockFS := core.NewMockFileSystem()
+	mockFS.SetFile(".version", []byte("0.1.0\n"))
+
+	mgr := NewVersionManager(mockFS, nil).WithTagSource(NewTagSource("v", &MockVersionTagLister{Tags: []string{"v1.2.3"}}))
+	if !mgr.UsesGitTags() {
+		t.Fatal("expected UsesGitTags() to be true")
+	}
+
+	v, err := mgr.Read(ctx, ".version")
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if v.String() != "1.2.3" {
+		t.Errorf("Read() = %s, want 1.2.3 from tags", v)
+	}
+
+	if err := mgr.Update(ctx, ".version", "minor", "", "", false); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	data, _ := mockFS.ReadFile(ctx, ".version")
+	if string(data) != "0.1.0\n" {
+		t.Errorf("Save() should be a no-op, file now contains %q", data)
+	}
+
+	created, err := mgr.InitializeWithFeedback(ctx, "missing/.version")
+	if err != nil || created {
+		t.Errorf("InitializeWithFeedback() = %v, %v; want false, nil", created, err)
+	}
+	if _, err := mockFS.Stat(ctx, "missing/.version"); err == nil {
+		t.Error("expected no version file to be created")
+	}
+}
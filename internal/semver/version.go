@@ -1,8 +1,10 @@
 package semver
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -12,9 +14,9 @@ import (
 
 // SemVersion represents a semantic version (major.minor.patch-preRelease+build).
 type SemVersion struct {
-	Major      int
-	Minor      int
-	Patch      int
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
 	PreRelease string
 	Build      string
 }
@@ -43,11 +45,11 @@ var (
 func (v SemVersion) String() string {
 	var sb strings.Builder
 	sb.Grow(20) // Pre-allocate for typical version string length
-	sb.WriteString(strconv.Itoa(v.Major))
+	sb.WriteString(strconv.FormatUint(v.Major, 10))
 	sb.WriteByte('.')
-	sb.WriteString(strconv.Itoa(v.Minor))
+	sb.WriteString(strconv.FormatUint(v.Minor, 10))
 	sb.WriteByte('.')
-	sb.WriteString(strconv.Itoa(v.Patch))
+	sb.WriteString(strconv.FormatUint(v.Patch, 10))
 	if v.PreRelease != "" {
 		sb.WriteByte('-')
 		sb.WriteString(v.PreRelease)
@@ -75,7 +77,7 @@ const maxVersionLength = 128
 // Returns errInvalidVersion (wrapped) when:
 //   - Input exceeds maxVersionLength (128 characters)
 //   - Format doesn't match major.minor.patch pattern
-//   - Major, minor, or patch cannot be parsed as integers
+//   - Major, minor, or patch is not a number or exceeds math.MaxUint64
 func ParseVersion(s string) (SemVersion, error) {
 	trimmed := strings.TrimSpace(s)
 	if len(trimmed) > maxVersionLength {
@@ -87,15 +89,15 @@ func ParseVersion(s string) (SemVersion, error) {
 		return SemVersion{}, errInvalidVersion
 	}
 
-	major, err := strconv.Atoi(matches[1])
+	major, err := strconv.ParseUint(matches[1], 10, 64)
 	if err != nil {
 		return SemVersion{}, fmt.Errorf("%w: invalid major version: %s", errInvalidVersion, err.Error())
 	}
-	minor, err := strconv.Atoi(matches[2])
+	minor, err := strconv.ParseUint(matches[2], 10, 64)
 	if err != nil {
 		return SemVersion{}, fmt.Errorf("%w: invalid minor version: %s", errInvalidVersion, err.Error())
 	}
-	patch, err := strconv.Atoi(matches[3])
+	patch, err := strconv.ParseUint(matches[3], 10, 64)
 	if err != nil {
 		return SemVersion{}, fmt.Errorf("%w: invalid patch version: %s", errInvalidVersion, err.Error())
 	}
@@ -111,13 +113,13 @@ func ParseVersion(s string) (SemVersion, error) {
 // Pre-release versions have lower precedence than the associated normal version
 // (e.g., 1.0.0-alpha < 1.0.0). Build metadata is ignored for comparison purposes.
 func (v SemVersion) Compare(other SemVersion) int {
-	if c := compareInt(v.Major, other.Major); c != 0 {
+	if c := cmp.Compare(v.Major, other.Major); c != 0 {
 		return c
 	}
-	if c := compareInt(v.Minor, other.Minor); c != 0 {
+	if c := cmp.Compare(v.Minor, other.Minor); c != 0 {
 		return c
 	}
-	if c := compareInt(v.Patch, other.Patch); c != 0 {
+	if c := cmp.Compare(v.Patch, other.Patch); c != 0 {
 		return c
 	}
 
@@ -139,6 +141,8 @@ func (v SemVersion) Compare(other SemVersion) int {
 // BumpNext applies heuristic-based smart bump logic.
 // - If it's a pre-release (e.g., alpha.1, rc.1), it promotes to final version.
 // - If it's a final release, it bumps patch by default.
+//
+// Returns a *apperrors.VersionOverflowError if the patch is already math.MaxUint64.
 func BumpNext(v SemVersion) (SemVersion, error) {
 	// If the version has a pre-release label, strip it (promote to final)
 	if v.PreRelease != "" {
@@ -152,7 +156,7 @@ func BumpNext(v SemVersion) (SemVersion, error) {
 	}
 
 	// Default case: bump patch
-	return SemVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, nil
+	return BumpByLabel(v, "patch")
 }
 
 // BumpByLabel bumps the version using an explicit label.
@@ -162,18 +166,32 @@ func BumpNext(v SemVersion) (SemVersion, error) {
 //   - "minor": increments minor, resets patch (1.2.3 -> 1.3.0)
 //   - "major": increments major, resets minor and patch (1.2.3 -> 2.0.0)
 //
-// Returns an error if label is not one of: patch, minor, major.
+// Returns an error if label is not one of: patch, minor, major, or a
+// *apperrors.VersionOverflowError if the bumped component is already math.MaxUint64.
 func BumpByLabel(v SemVersion, label string) (SemVersion, error) {
+	var component uint64
 	switch label {
 	case "patch":
-		return SemVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, nil
+		component = v.Patch
 	case "minor":
-		return SemVersion{Major: v.Major, Minor: v.Minor + 1, Patch: 0}, nil
+		component = v.Minor
 	case "major":
-		return SemVersion{Major: v.Major + 1, Minor: 0, Patch: 0}, nil
+		component = v.Major
 	default:
 		return SemVersion{}, &apperrors.InvalidBumpTypeError{BumpType: label}
 	}
+	if component == math.MaxUint64 {
+		return SemVersion{}, &apperrors.VersionOverflowError{Component: label, Version: v.String()}
+	}
+
+	switch label {
+	case "patch":
+		return SemVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, nil
+	case "minor":
+		return SemVersion{Major: v.Major, Minor: v.Minor + 1, Patch: 0}, nil
+	default:
+		return SemVersion{Major: v.Major + 1, Minor: 0, Patch: 0}, nil
+	}
 }
 
 // IncrementPreRelease increments the numeric suffix of a pre-release label.
@@ -183,20 +201,23 @@ func BumpByLabel(v SemVersion, label string) (SemVersion, error) {
 // - "rc1" -> "rc2" (no separator)
 // - "rc" -> "rc.1" (no number, defaults to dot)
 // If current doesn't match base, returns base.1.
-func IncrementPreRelease(current, base string) string {
+//
+// Returns a *apperrors.VersionOverflowError if the number is already
+// math.MaxUint64 (or larger), since it cannot be incremented.
+func IncrementPreRelease(current, base string) (string, error) {
 	if current == base {
-		return formatPreReleaseWithSep(base, 1, ".")
+		return formatPreReleaseWithSep(base, 1, "."), nil
 	}
 
 	// Check if current starts with base
 	if !strings.HasPrefix(current, base) {
-		return formatPreReleaseWithSep(base, 1, ".")
+		return formatPreReleaseWithSep(base, 1, "."), nil
 	}
 
 	// Get the suffix after base
 	suffix := current[len(base):]
 	if suffix == "" {
-		return formatPreReleaseWithSep(base, 1, ".")
+		return formatPreReleaseWithSep(base, 1, "."), nil
 	}
 
 	// Determine separator and parse number
@@ -218,15 +239,15 @@ func IncrementPreRelease(current, base string) string {
 
 	// Validate numStr is all digits
 	if numStr == "" || !isAllDigits(numStr) {
-		return formatPreReleaseWithSep(base, 1, ".")
+		return formatPreReleaseWithSep(base, 1, "."), nil
 	}
 
-	n, err := strconv.Atoi(numStr)
-	if err != nil {
-		return formatPreReleaseWithSep(base, 1, ".")
+	n, err := strconv.ParseUint(numStr, 10, 64)
+	if err != nil || n == math.MaxUint64 {
+		return "", &apperrors.VersionOverflowError{Component: "pre-release", Version: current}
 	}
 
-	return formatPreReleaseWithSep(base, n+1, sep)
+	return formatPreReleaseWithSep(base, n+1, sep), nil
 }
 
 // isAllDigits returns true if s consists entirely of ASCII digits.
@@ -239,21 +260,10 @@ func isAllDigits(s string) bool {
 	return true
 }
 
-func formatPreReleaseWithSep(base string, num int, sep string) string {
+func formatPreReleaseWithSep(base string, num uint64, sep string) string {
 	return fmt.Sprintf("%s%s%d", base, sep, num)
 }
 
-func compareInt(a, b int) int {
-	switch {
-	case a < b:
-		return -1
-	case a > b:
-		return 1
-	default:
-		return 0
-	}
-}
-
 func comparePreRelease(a, b string) int {
 	aIDs := strings.Split(a, ".")
 	bIDs := strings.Split(b, ".")
@@ -277,45 +287,32 @@ func comparePreRelease(a, b string) int {
 }
 
 func compareIdentifier(a, b string) int {
-	aNum, aIsNum := parseNumericIdentifier(a)
-	bNum, bIsNum := parseNumericIdentifier(b)
+	aIsNum := isNumericIdentifier(a)
+	bIsNum := isNumericIdentifier(b)
 
 	switch {
 	case aIsNum && bIsNum:
-		return compareInt(aNum, bNum)
+		// Without leading zeros, a longer number is larger, so numeric
+		// identifiers of any size compare correctly.
+		if c := cmp.Compare(len(a), len(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
 	case aIsNum && !bIsNum:
 		return -1 // numeric < non-numeric
 	case !aIsNum && bIsNum:
 		return 1
 	default:
 		// ASCII lexicographic
-		switch {
-		case a < b:
-			return -1
-		case a > b:
-			return 1
-		default:
-			return 0
-		}
+		return strings.Compare(a, b)
 	}
 }
 
-// SemVer numeric identifiers: only digits, no leading zeros unless exactly "0".
-func parseNumericIdentifier(s string) (int, bool) {
-	if s == "" {
-		return 0, false
-	}
-	if len(s) > 1 && s[0] == '0' {
-		return 0, false
-	}
-	for i := 0; i < len(s); i++ {
-		if s[i] < '0' || s[i] > '9' {
-			return 0, false
-		}
-	}
-	n, err := strconv.Atoi(s)
-	if err != nil {
-		return 0, false
+// isNumericIdentifier reports whether s is a SemVer numeric identifier:
+// only digits, no leading zeros unless exactly "0".
+func isNumericIdentifier(s string) bool {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return false
 	}
-	return n, true
+	return isAllDigits(s)
 }
@@ -259,7 +259,7 @@ func (d *Detector) createModule(ctx context.Context, versionPath string, root st
 	name := filepath.Base(dir)
 
 	// Load current version
-	vm := semver.NewVersionManager(d.fs, nil)
+	vm := semver.NewSourcedVersionManager(d.fs)
 	version, err := vm.Read(ctx, versionPath)
 	if err != nil {
 		// If we can't read the version, use empty string
@@ -305,8 +305,8 @@ func (d *Detector) loadExplicitModules(ctx context.Context, root string) ([]*Mod
 			versionPath = filepath.Join(root, versionPath)
 		}
 
-		// Check if file exists
-		if _, err := d.fs.Stat(ctx, versionPath); err != nil {
+		// Check if file exists (there is none when versions come from git tags)
+		if _, err := d.fs.Stat(ctx, versionPath); err != nil && !semver.UsesGitTags() {
 			return nil, fmt.Errorf("module %s: version file not found at %s", moduleConfig.Name, versionPath)
 		}
 
@@ -333,7 +333,7 @@ func (d *Detector) createModuleFromConfig(ctx context.Context, moduleConfig conf
 	dir := filepath.Dir(versionPath)
 
 	// Load current version
-	vm := semver.NewVersionManager(d.fs, nil)
+	vm := semver.NewSourcedVersionManager(d.fs)
 	version, err := vm.Read(ctx, versionPath)
 	if err != nil {
 		// If we can't read the version, use empty string
@@ -0,0 +1,180 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// HandlerFunc serves an incoming request and returns its result.
+// Returning an *Error sets the JSON-RPC error code of the response.
+type HandlerFunc func(ctx context.Context, method string, params json.RawMessage) (any, error)
+
+// ErrClosed is returned by Call once the connection has stopped reading.
+var ErrClosed = errors.New("rpc connection closed")
+
+// Conn is a bidirectional JSON-RPC 2.0 connection over newline-delimited
+// JSON. Both peers can send requests: sley sends hook events and the
+// extension calls back into sley while handling them.
+type Conn struct {
+	dec     *json.Decoder
+	handler HandlerFunc
+
+	wmu sync.Mutex
+	enc *json.Encoder
+
+	mu      sync.Mutex
+	nextID  int64
+	pending map[int64]chan *Message
+	done    chan struct{}
+	err     error
+}
+
+// NewConn creates a connection reading from r and writing to w. Incoming
+// requests are passed to handler; a nil handler answers "method not found".
+func NewConn(r io.Reader, w io.Writer, handler HandlerFunc) *Conn {
+	return &Conn{
+		dec:     json.NewDecoder(r),
+		enc:     json.NewEncoder(w),
+		handler: handler,
+		pending: make(map[int64]chan *Message),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run reads messages until the reader is exhausted or fails. Responses are
+// delivered to the pending Call and requests are served concurrently, so a
+// handler may itself Call the peer. It returns nil on a clean EOF.
+func (c *Conn) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer func() {
+		// Unblock handlers still waiting on a Call before waiting for them.
+		close(c.done)
+		wg.Wait()
+	}()
+
+	for {
+		var msg Message
+		if err := c.dec.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			c.fail(err)
+			return err
+		}
+
+		switch {
+		case msg.IsResponse():
+			c.mu.Lock()
+			ch, ok := c.pending[*msg.ID]
+			delete(c.pending, *msg.ID)
+			c.mu.Unlock()
+			if ok {
+				ch <- &msg
+			}
+		case msg.IsRequest():
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.serve(ctx, &msg)
+			}()
+		}
+	}
+}
+
+// Done is closed once the connection stops reading, when Run is about to return.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Call sends a request and decodes the result into result (unless nil).
+func (c *Conn) Call(ctx context.Context, method string, params, result any) error {
+	c.mu.Lock()
+	if c.err != nil || c.isDone() {
+		c.mu.Unlock()
+		return ErrClosed
+	}
+	c.nextID++
+	id := c.nextID
+	ch := make(chan *Message, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	req, err := NewRequest(id, method, params)
+	if err != nil {
+		c.forget(id)
+		return err
+	}
+	if err := c.write(req); err != nil {
+		c.forget(id)
+		return err
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.Error != nil {
+			return resp.Error
+		}
+		if result == nil || len(resp.Result) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(resp.Result, result); err != nil {
+			return fmt.Errorf("failed to decode %s result: %w", method, err)
+		}
+		return nil
+	case <-ctx.Done():
+		c.forget(id)
+		return ctx.Err()
+	case <-c.done:
+		return ErrClosed
+	}
+}
+
+// serve handles a single incoming request and writes its response.
+func (c *Conn) serve(ctx context.Context, msg *Message) {
+	var result any
+	var err error
+	if c.handler == nil {
+		err = &Error{Code: CodeMethodNotFound, Message: "method not found: " + msg.Method}
+	} else {
+		result, err = c.handler(ctx, msg.Method, msg.Params)
+	}
+	_ = c.write(NewResponse(msg.ID, result, err))
+}
+
+func (c *Conn) write(msg *Message) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if err := c.enc.Encode(msg); err != nil {
+		return fmt.Errorf("failed to write rpc message: %w", err)
+	}
+	return nil
+}
+
+func (c *Conn) forget(id int64) {
+	c.mu.Lock()
+	delete(c.pending, id)
+	c.mu.Unlock()
+}
+
+// fail records the read error so later calls fail fast.
+func (c *Conn) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		err = ErrClosed
+	}
+	c.err = err
+}
+
+func (c *Conn) isDone() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,34 @@
+// Package sdk lets sley extensions be written as typed Go binaries that speak
+// the long-running JSON-RPC extension protocol.
+//
+// An extension declares the protocol in its extension.yaml:
+//
+//	name: my-extension
+//	entry: my-extension
+//	protocol: rpc
+//	hooks: [pre-bump, post-bump]
+//
+// sley starts the binary once per run and exchanges newline-delimited
+// JSON-RPC 2.0 messages over stdin/stdout. Hook events arrive as "hook"
+// requests; while handling one, the extension can query sley through Host:
+//
+//	func main() {
+//	    sdk.Run(&sdk.Extension{
+//	        Hooks: map[string]sdk.Handler{
+//	            "post-bump": func(ctx context.Context, host *sdk.Host, in sdk.HookInput) (sdk.HookOutput, error) {
+//	                commits, err := host.Commits(ctx, "", "")
+//	                if err != nil {
+//	                    return sdk.HookOutput{}, err
+//	                }
+//	                return sdk.HookOutput{
+//	                    Success: true,
+//	                    Message: fmt.Sprintf("released %s with %d commits", in.Version, len(commits)),
+//	                }, nil
+//	            },
+//	        },
+//	    })
+//	}
+//
+// Anything written to stdout other than protocol messages corrupts the
+// stream; use stderr for diagnostics.
+package sdk
@@ -0,0 +1,151 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+)
+
+// Handler handles one hook event. Returning an error reports the hook as
+// failed with the error message.
+type Handler func(ctx context.Context, host *Host, input HookInput) (HookOutput, error)
+
+// Extension is a long-running sley extension.
+type Extension struct {
+	// Hooks maps hook names (e.g. "pre-bump", "post-bump") to handlers.
+	Hooks map[string]Handler
+
+	// OnInitialize is called once when sley starts the extension (optional).
+	OnInitialize func(ctx context.Context, host *Host, params InitializeParams) error
+
+	// OnShutdown is called before sley stops the extension (optional).
+	OnShutdown func(ctx context.Context) error
+}
+
+// Run serves the extension on stdin/stdout and exits the process when sley
+// closes the connection. It exits with status 1 if the connection fails.
+func Run(ext *Extension) {
+	if err := ext.Serve(context.Background(), os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "extension: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// Serve speaks the extension protocol over r and w until r is closed.
+func (e *Extension) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	host := &Host{}
+	conn := NewConn(r, w, func(ctx context.Context, method string, params json.RawMessage) (any, error) {
+		return e.handle(ctx, host, method, params)
+	})
+	host.conn = conn
+	return conn.Run(ctx)
+}
+
+func (e *Extension) handle(ctx context.Context, host *Host, method string, params json.RawMessage) (any, error) {
+	switch method {
+	case MethodInitialize:
+		var p InitializeParams
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		if p.ProtocolVersion > ProtocolVersion {
+			return nil, fmt.Errorf("unsupported protocol version %d (max %d)", p.ProtocolVersion, ProtocolVersion)
+		}
+		if e.OnInitialize != nil {
+			if err := e.OnInitialize(ctx, host, p); err != nil {
+				return nil, err
+			}
+		}
+		return InitializeResult{ProtocolVersion: ProtocolVersion, Hooks: e.hookNames()}, nil
+
+	case MethodHook:
+		var in HookInput
+		if err := decodeParams(params, &in); err != nil {
+			return nil, err
+		}
+		handler, ok := e.Hooks[in.Hook]
+		if !ok {
+			return HookOutput{Success: false, Message: "unsupported hook: " + in.Hook}, nil
+		}
+		out, err := handler(ctx, host, in)
+		if err != nil {
+			return HookOutput{Success: false, Message: err.Error()}, nil
+		}
+		return out, nil
+
+	case MethodShutdown:
+		if e.OnShutdown != nil {
+			if err := e.OnShutdown(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+
+	default:
+		return nil, &Error{Code: CodeMethodNotFound, Message: "method not found: " + method}
+	}
+}
+
+func (e *Extension) hookNames() []string {
+	return slices.Sorted(maps.Keys(e.Hooks))
+}
+
+func decodeParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return &Error{Code: CodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// Host gives an extension access to sley while it handles a request.
+type Host struct {
+	conn *Conn
+}
+
+// Version returns the current version of the given module, or of the
+// version file sley is operating on when module is empty.
+func (h *Host) Version(ctx context.Context, module string) (string, error) {
+	var res VersionResult
+	if err := h.conn.Call(ctx, MethodVersion, VersionParams{Module: module}, &res); err != nil {
+		return "", err
+	}
+	return res.Version, nil
+}
+
+// Modules returns the versioned modules discovered in the workspace.
+func (h *Host) Modules(ctx context.Context) ([]Module, error) {
+	var res ModulesResult
+	if err := h.conn.Call(ctx, MethodModules, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Modules, nil
+}
+
+// Config returns the sley configuration as a generic map keyed like .sley.yaml.
+// When the manifest declares permissions, only the top-level keys listed in
+// permissions.config are included.
+func (h *Host) Config(ctx context.Context) (map[string]any, error) {
+	var res ConfigResult
+	if err := h.conn.Call(ctx, MethodConfig, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Config, nil
+}
+
+// Commits returns the commit subjects between since and until.
+// Empty since means the latest tag and empty until means HEAD.
+func (h *Host) Commits(ctx context.Context, since, until string) ([]string, error) {
+	var res CommitsResult
+	if err := h.conn.Call(ctx, MethodCommits, CommitsParams{Since: since, Until: until}, &res); err != nil {
+		return nil, err
+	}
+	return res.Commits, nil
+}
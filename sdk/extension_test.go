@@ -0,0 +1,178 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// pipePair connects an Extension served in the background to a host-side
+// Conn answering callbacks with hostHandler.
+func pipePair(t *testing.T, ext *Extension, hostHandler HandlerFunc) *Conn {
+	t.Helper()
+
+	hostToExtR, hostToExtW := io.Pipe()
+	extToHostR, extToHostW := io.Pipe()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		err := ext.Serve(context.Background(), hostToExtR, extToHostW)
+		_ = extToHostW.Close()
+		serveErr <- err
+	}()
+
+	host := NewConn(extToHostR, hostToExtW, hostHandler)
+	go func() { _ = host.Run(context.Background()) }()
+
+	t.Cleanup(func() {
+		_ = hostToExtW.Close()
+		if err := <-serveErr; err != nil {
+			t.Errorf("Serve() error = %v", err)
+		}
+	})
+	return host
+}
+
+func TestExtension_InitializeAndHook(t *testing.T) {
+	var initialized InitializeParams
+	ext := &Extension{
+		OnInitialize: func(_ context.Context, _ *Host, p InitializeParams) error {
+			initialized = p
+			return nil
+		},
+		Hooks: map[string]Handler{
+			"post-bump": func(ctx context.Context, host *Host, in HookInput) (HookOutput, error) {
+				current, err := host.Version(ctx, "")
+				if err != nil {
+					return HookOutput{}, err
+				}
+				commits, err := host.Commits(ctx, "v1.0.0", "")
+				if err != nil {
+					return HookOutput{}, err
+				}
+				return HookOutput{
+					Success: true,
+					Message: fmt.Sprintf("%s->%s (%s) %d commits", in.PreviousVersion, in.Version, current, len(commits)),
+				}, nil
+			},
+			"pre-bump": func(context.Context, *Host, HookInput) (HookOutput, error) {
+				return HookOutput{}, errors.New("not allowed")
+			},
+		},
+	}
+
+	host := pipePair(t, ext, func(_ context.Context, method string, params json.RawMessage) (any, error) {
+		switch method {
+		case MethodVersion:
+			return VersionResult{Version: "1.1.0"}, nil
+		case MethodCommits:
+			var p CommitsParams
+			if err := json.Unmarshal(params, &p); err != nil || p.Since != "v1.0.0" {
+				return nil, &Error{Code: CodeInvalidParams, Message: "bad params"}
+			}
+			return CommitsResult{Commits: []string{"feat: a", "fix: b"}}, nil
+		}
+		return nil, &Error{Code: CodeMethodNotFound, Message: method}
+	})
+
+	ctx := context.Background()
+
+	var initResult InitializeResult
+	if err := host.Call(ctx, MethodInitialize, InitializeParams{ProtocolVersion: ProtocolVersion, SleyVersion: "1.0.0"}, &initResult); err != nil {
+		t.Fatalf("initialize error = %v", err)
+	}
+	if initialized.SleyVersion != "1.0.0" {
+		t.Errorf("OnInitialize not called with params, got %+v", initialized)
+	}
+	if strings.Join(initResult.Hooks, ",") != "post-bump,pre-bump" {
+		t.Errorf("expected sorted hooks, got %v", initResult.Hooks)
+	}
+
+	var out HookOutput
+	if err := host.Call(ctx, MethodHook, HookInput{Hook: "post-bump", Version: "1.1.0", PreviousVersion: "1.0.0"}, &out); err != nil {
+		t.Fatalf("hook error = %v", err)
+	}
+	if !out.Success || out.Message != "1.0.0->1.1.0 (1.1.0) 2 commits" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+
+	out = HookOutput{}
+	if err := host.Call(ctx, MethodHook, HookInput{Hook: "pre-bump"}, &out); err != nil {
+		t.Fatalf("hook error = %v", err)
+	}
+	if out.Success || out.Message != "not allowed" {
+		t.Errorf("expected handler error as failed output, got %+v", out)
+	}
+
+	out = HookOutput{}
+	if err := host.Call(ctx, MethodHook, HookInput{Hook: "validate"}, &out); err != nil {
+		t.Fatalf("hook error = %v", err)
+	}
+	if out.Success || !strings.Contains(out.Message, "unsupported hook") {
+		t.Errorf("expected unsupported hook output, got %+v", out)
+	}
+
+	if err := host.Call(ctx, MethodShutdown, nil, nil); err != nil {
+		t.Errorf("shutdown error = %v", err)
+	}
+}
+
+func TestExtension_Errors(t *testing.T) {
+	host := pipePair(t, &Extension{}, nil)
+	ctx := context.Background()
+
+	var rpcErr *Error
+	err := host.Call(ctx, "unknown", nil, nil)
+	if !errors.As(err, &rpcErr) || rpcErr.Code != CodeMethodNotFound {
+		t.Errorf("expected method not found, got %v", err)
+	}
+
+	err = host.Call(ctx, MethodInitialize, InitializeParams{ProtocolVersion: ProtocolVersion + 1}, nil)
+	if err == nil || !strings.Contains(err.Error(), "unsupported protocol version") {
+		t.Errorf("expected protocol version error, got %v", err)
+	}
+
+	err = host.Call(ctx, MethodHook, json.RawMessage(`"not an object"`), nil)
+	if !errors.As(err, &rpcErr) || rpcErr.Code != CodeInvalidParams {
+		t.Errorf("expected invalid params, got %v", err)
+	}
+}
+
+func TestConn_CallAfterClose(t *testing.T) {
+	r, w := io.Pipe()
+	conn := NewConn(r, io.Discard, nil)
+	done := make(chan error, 1)
+	go func() { done <- conn.Run(context.Background()) }()
+
+	_ = w.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v, want nil on EOF", err)
+	}
+
+	if err := conn.Call(context.Background(), MethodVersion, nil, nil); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestNewResponse(t *testing.T) {
+	id := int64(7)
+
+	resp := NewResponse(&id, VersionResult{Version: "1.2.3"}, nil)
+	if resp.Error != nil || string(resp.Result) != `{"version":"1.2.3"}` {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	resp = NewResponse(&id, nil, errors.New("boom"))
+	if resp.Error == nil || resp.Error.Code != CodeInternalError || resp.Error.Message != "boom" {
+		t.Errorf("unexpected error response: %+v", resp.Error)
+	}
+
+	resp = NewResponse(&id, nil, &Error{Code: CodeInvalidParams, Message: "bad"})
+	if resp.Error.Code != CodeInvalidParams {
+		t.Errorf("expected error code to be preserved, got %d", resp.Error.Code)
+	}
+}
@@ -0,0 +1,177 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ProtocolVersion is the version of the JSON-RPC extension protocol.
+const ProtocolVersion = 1
+
+// Methods sent by sley to the extension.
+const (
+	MethodInitialize = "initialize"
+	MethodHook       = "hook"
+	MethodShutdown   = "shutdown"
+)
+
+// Methods the extension can call back into sley.
+const (
+	MethodVersion = "sley/version"
+	MethodModules = "sley/modules"
+	MethodConfig  = "sley/config"
+	MethodCommits = "sley/commits"
+)
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+)
+
+// Message is a JSON-RPC 2.0 request, notification or response.
+// Requests carry a Method and an ID, notifications a Method only, and
+// responses an ID with either a Result or an Error.
+type Message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *int64          `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// IsRequest reports whether the message is a request expecting a response.
+func (m *Message) IsRequest() bool {
+	return m.Method != "" && m.ID != nil
+}
+
+// IsResponse reports whether the message is a response to a request.
+func (m *Message) IsResponse() bool {
+	return m.Method == "" && m.ID != nil
+}
+
+// Error is a JSON-RPC 2.0 error object.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// NewRequest builds a request message with the given id and params.
+func NewRequest(id int64, method string, params any) (*Message, error) {
+	msg := &Message{JSONRPC: "2.0", ID: &id, Method: method}
+	if params != nil {
+		raw, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s params: %w", method, err)
+		}
+		msg.Params = raw
+	}
+	return msg, nil
+}
+
+// NewResponse builds a response message for the given request id. A non-nil
+// err produces an error response; *Error values keep their code.
+func NewResponse(id *int64, result any, err error) *Message {
+	msg := &Message{JSONRPC: "2.0", ID: id}
+	if err != nil {
+		rpcErr, ok := err.(*Error)
+		if !ok {
+			rpcErr = &Error{Code: CodeInternalError, Message: err.Error()}
+		}
+		msg.Error = rpcErr
+		return msg
+	}
+
+	raw, mErr := json.Marshal(result)
+	if mErr != nil {
+		msg.Error = &Error{Code: CodeInternalError, Message: mErr.Error()}
+		return msg
+	}
+	msg.Result = raw
+	return msg
+}
+
+// HookInput is the hook event sent with every "hook" request.
+// It mirrors the JSON document passed on stdin to one-shot extensions.
+type HookInput struct {
+	Hook            string         `json:"hook"`
+	Version         string         `json:"version"`
+	PreviousVersion string         `json:"previous_version,omitempty"`
+	BumpType        string         `json:"bump_type,omitempty"`
+	Prerelease      *string        `json:"prerelease,omitempty"`
+	Metadata        *string        `json:"metadata,omitempty"`
+	ProjectRoot     string         `json:"project_root"`
+	ModuleDir       string         `json:"module_dir,omitempty"`
+	ModuleName      string         `json:"module_name,omitempty"`
+	Config          map[string]any `json:"config,omitempty"`
+}
+
+// HookOutput is the result of a "hook" request.
+type HookOutput struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message,omitempty"`
+	Data    map[string]any `json:"data,omitempty"`
+}
+
+// InitializeParams is sent once, right after sley starts the extension.
+type InitializeParams struct {
+	ProtocolVersion int            `json:"protocol_version"`
+	SleyVersion     string         `json:"sley_version"`
+	ProjectRoot     string         `json:"project_root"`
+	Config          map[string]any `json:"config,omitempty"`
+}
+
+// InitializeResult is returned by the extension in response to "initialize".
+type InitializeResult struct {
+	ProtocolVersion int      `json:"protocol_version"`
+	Hooks           []string `json:"hooks,omitempty"`
+}
+
+// VersionParams selects the module whose version is requested.
+// An empty Module selects the version file sley is operating on.
+type VersionParams struct {
+	Module string `json:"module,omitempty"`
+}
+
+// VersionResult is returned by "sley/version".
+type VersionResult struct {
+	Version string `json:"version"`
+}
+
+// Module describes a versioned module in the workspace.
+type Module struct {
+	Name    string `json:"name"`
+	Dir     string `json:"dir"`
+	Path    string `json:"path"`
+	Version string `json:"version,omitempty"`
+}
+
+// ModulesResult is returned by "sley/modules".
+type ModulesResult struct {
+	Modules []Module `json:"modules"`
+}
+
+// ConfigResult is returned by "sley/config".
+type ConfigResult struct {
+	Config map[string]any `json:"config"`
+}
+
+// CommitsParams selects a commit range. Empty Since means the latest tag
+// and empty Until means HEAD.
+type CommitsParams struct {
+	Since string `json:"since,omitempty"`
+	Until string `json:"until,omitempty"`
+}
+
+// CommitsResult is returned by "sley/commits".
+type CommitsResult struct {
+	Commits []string `json:"commits"`
+}